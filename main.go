@@ -31,6 +31,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/tui"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/updater"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/version"
@@ -118,6 +119,135 @@ var (
 	gitlabScanIncludePaths = gitlabScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
 	gitlabScanExcludePaths = gitlabScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
 
+	artifactoryScan                 = cli.Command("artifactory", "Find credentials in Artifactory repositories.")
+	artifactoryScanEndpoint         = artifactoryScan.Flag("endpoint", "Artifactory endpoint, including the context path. Example: https://acme.jfrog.io/artifactory").Required().String()
+	artifactoryScanToken            = artifactoryScan.Flag("token", "Artifactory access token. Can be provided with environment variable ARTIFACTORY_TOKEN.").Envar("ARTIFACTORY_TOKEN").String()
+	artifactoryScanUsername         = artifactoryScan.Flag("username", "Artifactory username. Can be provided with environment variable ARTIFACTORY_USERNAME.").Envar("ARTIFACTORY_USERNAME").String()
+	artifactoryScanPassword         = artifactoryScan.Flag("password", "Artifactory password or API key. Can be provided with environment variable ARTIFACTORY_PASSWORD.").Envar("ARTIFACTORY_PASSWORD").String()
+	artifactoryScanRepos            = artifactoryScan.Flag("repo", "Artifactory repository key to scan. You can repeat this flag. Leave empty to scan all visible repositories.").Strings()
+	artifactoryScanIncludeRepos     = artifactoryScan.Flag("include-repos", `Repositories to include in the scan. This can also be a glob pattern. You can repeat this flag. Example: "libs-release-local", "libs-*"`).Strings()
+	artifactoryScanExcludeRepos     = artifactoryScan.Flag("exclude-repos", `Repositories to exclude from the scan. This can also be a glob pattern. You can repeat this flag. Example: "libs-release-local", "libs-*"`).Strings()
+	artifactoryScanIncludeArtifacts = artifactoryScan.Flag("include-artifacts", `Artifact paths to include in the scan. This can also be a glob pattern. You can repeat this flag. Example: "com/acme/**"`).Strings()
+	artifactoryScanExcludeArtifacts = artifactoryScan.Flag("exclude-artifacts", `Artifact paths to exclude from the scan. This can also be a glob pattern. You can repeat this flag. Example: "**.iso"`).Strings()
+	artifactoryScanMaxArtifactSize  = artifactoryScan.Flag("max-artifact-size", "Maximum size of artifacts to scan. Artifacts larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+
+	bitbucketScan             = cli.Command("bitbucket", "Find credentials in Bitbucket Cloud or Bitbucket Data Center repositories.")
+	bitbucketScanEndpoint     = bitbucketScan.Flag("endpoint", "Bitbucket endpoint. Use the base URL of your instance for Bitbucket Data Center.").Default("https://bitbucket.org").String()
+	bitbucketScanToken        = bitbucketScan.Flag("token", "Bitbucket access token. Can be provided with environment variable BITBUCKET_TOKEN.").Envar("BITBUCKET_TOKEN").String()
+	bitbucketScanUsername     = bitbucketScan.Flag("username", "Bitbucket username, used with --app-password. Can be provided with environment variable BITBUCKET_USERNAME.").Envar("BITBUCKET_USERNAME").String()
+	bitbucketScanAppPassword  = bitbucketScan.Flag("app-password", "Bitbucket app password. Can be provided with environment variable BITBUCKET_APP_PASSWORD.").Envar("BITBUCKET_APP_PASSWORD").String()
+	bitbucketScanWorkspaces   = bitbucketScan.Flag("workspace", "Bitbucket Cloud workspace to scan. You can repeat this flag. Leave empty to scan all accessible workspaces.").Strings()
+	bitbucketScanProjects     = bitbucketScan.Flag("project", "Bitbucket Data Center project key to scan. You can repeat this flag. Leave empty to scan all accessible projects.").Strings()
+	bitbucketScanRepos        = bitbucketScan.Flag("repo", "Bitbucket repo url. You can repeat this flag. Example: https://bitbucket.org/workspace/repo.git").Strings()
+	bitbucketScanIncludeRepos = bitbucketScan.Flag("include-repos", `Repositories to include in a workspace or project scan. This can also be a glob pattern. You can repeat this flag. Example: "workspace/repo", "workspace/r*"`).Strings()
+	bitbucketScanExcludeRepos = bitbucketScan.Flag("exclude-repos", `Repositories to exclude in a workspace or project scan. This can also be a glob pattern. You can repeat this flag. Example: "workspace/repo", "workspace/r*"`).Strings()
+	bitbucketScanIncludePaths = bitbucketScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	bitbucketScanExcludePaths = bitbucketScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	gerritScan                = cli.Command("gerrit", "Find credentials in Gerrit projects and open changes.")
+	gerritScanEndpoint        = gerritScan.Flag("endpoint", "Gerrit endpoint, e.g. https://gerrit.example.com.").Required().String()
+	gerritScanUsername        = gerritScan.Flag("username", "Gerrit username. Can be provided with environment variable GERRIT_USERNAME.").Envar("GERRIT_USERNAME").String()
+	gerritScanPassword        = gerritScan.Flag("password", "Gerrit HTTP password. Can be provided with environment variable GERRIT_PASSWORD.").Envar("GERRIT_PASSWORD").String()
+	gerritScanProjects        = gerritScan.Flag("project", "Gerrit project to scan. You can repeat this flag. Leave empty to scan all visible projects.").Strings()
+	gerritScanIncludeChanges  = gerritScan.Flag("include-changes", "Scan the patchsets of open changes.").Bool()
+	gerritScanIncludeComments = gerritScan.Flag("include-comments", "Scan the review comments and messages of open changes.").Bool()
+	gerritScanIncludePaths    = gerritScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	gerritScanExcludePaths    = gerritScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	azureReposScan                = cli.Command("azure-repos", "Find credentials in Azure DevOps repositories.")
+	azureReposScanEndpoint        = azureReposScan.Flag("endpoint", "Azure DevOps endpoint. Use the base URL of your instance for Azure DevOps Server.").Default("https://dev.azure.com").String()
+	azureReposScanToken           = azureReposScan.Flag("token", "Azure DevOps personal access token. Can be provided with environment variable AZURE_DEVOPS_TOKEN.").Envar("AZURE_DEVOPS_TOKEN").String()
+	azureReposScanOrganizations   = azureReposScan.Flag("organization", "Azure DevOps organization (or Azure DevOps Server collection) to scan. You can repeat this flag.").Strings()
+	azureReposScanProjects        = azureReposScan.Flag("project", "Azure DevOps project to scan. You can repeat this flag. Leave empty to scan all accessible projects.").Strings()
+	azureReposScanRepos           = azureReposScan.Flag("repo", "Azure Repos repo url. You can repeat this flag. Example: https://dev.azure.com/org/project/_git/repo").Strings()
+	azureReposScanIncludeProjects = azureReposScan.Flag("include-projects", `Projects to include in an organization scan. This can also be a glob pattern. You can repeat this flag. Example: "project", "p*"`).Strings()
+	azureReposScanExcludeProjects = azureReposScan.Flag("exclude-projects", `Projects to exclude in an organization scan. This can also be a glob pattern. You can repeat this flag. Example: "project", "p*"`).Strings()
+	azureReposScanIncludeRepos    = azureReposScan.Flag("include-repos", `Repositories to include in an organization scan. This can also be a glob pattern. You can repeat this flag. Example: "project/repo", "project/r*"`).Strings()
+	azureReposScanExcludeRepos    = azureReposScan.Flag("exclude-repos", `Repositories to exclude in an organization scan. This can also be a glob pattern. You can repeat this flag. Example: "project/repo", "project/r*"`).Strings()
+	azureReposScanIncludeForks    = azureReposScan.Flag("include-forks", "Include forked repositories in an organization scan.").Bool()
+	azureReposScanIncludePaths    = azureReposScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	azureReposScanExcludePaths    = azureReposScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	giteaScan              = cli.Command("gitea", "Find credentials in Gitea and Forgejo repositories.")
+	giteaScanEndpoint      = giteaScan.Flag("endpoint", "Gitea endpoint. Example: https://gitea.example.com").Required().String()
+	giteaScanToken         = giteaScan.Flag("token", "Gitea access token. Can be provided with environment variable GITEA_TOKEN. Public repositories are scanned without authentication when empty.").Envar("GITEA_TOKEN").String()
+	giteaScanRepos         = giteaScan.Flag("repo", "Gitea repo url. You can repeat this flag. Example: https://gitea.example.com/owner/repo").Strings()
+	giteaScanOrgs          = giteaScan.Flag("org", "Gitea organization to scan. You can repeat this flag.").Strings()
+	giteaScanUsers         = giteaScan.Flag("user", "Gitea user whose repositories to scan. You can repeat this flag.").Strings()
+	giteaScanIncludeRepos  = giteaScan.Flag("include-repos", `Repositories to include in an organization or user scan. This can also be a glob pattern. You can repeat this flag. Example: "owner/repo", "owner/r*"`).Strings()
+	giteaScanExcludeRepos  = giteaScan.Flag("exclude-repos", `Repositories to exclude in an organization or user scan. This can also be a glob pattern. You can repeat this flag. Example: "owner/repo", "owner/r*"`).Strings()
+	giteaScanIncludeForks  = giteaScan.Flag("include-forks", "Include forked repositories in an organization or user scan.").Bool()
+	giteaScanIssueComments = giteaScan.Flag("issue-comments", "Include issue descriptions and comments in scan.").Bool()
+	giteaScanPRComments    = giteaScan.Flag("pr-comments", "Include pull request descriptions and comments in scan.").Bool()
+	giteaScanIncludeWikis  = giteaScan.Flag("include-wikis", "Include repository wikis in scan.").Bool()
+	giteaScanIncludePaths  = giteaScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	giteaScanExcludePaths  = giteaScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	confluenceScan                  = cli.Command("confluence", "Find credentials in Confluence pages, blog posts, comments and attachments.")
+	confluenceScanEndpoint          = confluenceScan.Flag("endpoint", "Confluence endpoint, including the context path. Example: https://acme.atlassian.net/wiki").Required().String()
+	confluenceScanUsername          = confluenceScan.Flag("username", "Confluence username, or account email for Confluence Cloud. Can be provided with environment variable CONFLUENCE_USERNAME.").Envar("CONFLUENCE_USERNAME").String()
+	confluenceScanPassword          = confluenceScan.Flag("password", "Confluence password, or API token for Confluence Cloud. Can be provided with environment variable CONFLUENCE_PASSWORD.").Envar("CONFLUENCE_PASSWORD").String()
+	confluenceScanToken             = confluenceScan.Flag("token", "Confluence Data Center personal access token. Can be provided with environment variable CONFLUENCE_TOKEN.").Envar("CONFLUENCE_TOKEN").String()
+	confluenceScanSpaces            = confluenceScan.Flag("space", "Key of the Confluence space to scan. You can repeat this flag. Leave empty to scan all visible spaces.").Strings()
+	confluenceScanIgnoreSpaces      = confluenceScan.Flag("ignore-space", `Key of a Confluence space to skip. This can also be a glob pattern. You can repeat this flag. Example: "ARCHIVE", "~*"`).Strings()
+	confluenceScanSpacesScope       = confluenceScan.Flag("spaces-scope", "Type of spaces to scan when no space is given.").Default("all").Enum("all", "global", "personal")
+	confluenceScanIncludeAttachment = confluenceScan.Flag("include-attachments", "Scan the attachments of pages and blog posts.").Bool()
+	confluenceScanSkipHistory       = confluenceScan.Flag("skip-history", "Skip scanning previous versions of pages and blog posts.").Bool()
+	confluenceScanInsecureSkipTLS   = confluenceScan.Flag("insecure-skip-verify-tls", "Skip TLS certificate verification.").Bool()
+
+	jiraScan                  = cli.Command("jira", "Find credentials in Jira issues, comments and attachments.")
+	jiraScanEndpoint          = jiraScan.Flag("endpoint", "Jira endpoint. Example: https://acme.atlassian.net").Required().String()
+	jiraScanUsername          = jiraScan.Flag("username", "Jira username, or account email for Jira Cloud. Can be provided with environment variable JIRA_USERNAME.").Envar("JIRA_USERNAME").String()
+	jiraScanPassword          = jiraScan.Flag("password", "Jira password, or API token for Jira Cloud. Can be provided with environment variable JIRA_PASSWORD.").Envar("JIRA_PASSWORD").String()
+	jiraScanToken             = jiraScan.Flag("token", "Jira Data Center personal access token. Can be provided with environment variable JIRA_TOKEN.").Envar("JIRA_TOKEN").String()
+	jiraScanJQL               = jiraScan.Flag("jql", `JQL query selecting the issues to scan. Leave empty to scan all accessible issues. Example: "project = OPS AND created >= -30d"`).String()
+	jiraScanProjects          = jiraScan.Flag("project", "Key of the Jira project to scan. You can repeat this flag.").Strings()
+	jiraScanIgnoreProjects    = jiraScan.Flag("ignore-project", "Key of a Jira project to skip. You can repeat this flag.").Strings()
+	jiraScanSkipAttachments   = jiraScan.Flag("skip-attachments", "Skip scanning issue attachments.").Bool()
+	jiraScanMaxAttachmentSize = jiraScan.Flag("max-attachment-size", "Maximum size of attachments to scan. Attachments larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+	jiraScanInsecureSkipTLS   = jiraScan.Flag("insecure-skip-verify-tls", "Skip TLS certificate verification.").Bool()
+
+	slackScan               = cli.Command("slack", "Find credentials in Slack messages and files.")
+	slackScanToken          = slackScan.Flag("token", "Slack bot or user token. Can be provided with environment variable SLACK_TOKEN.").Envar("SLACK_TOKEN").String()
+	slackScanAppToken       = slackScan.Flag("app-token", "Slack app-level token, required with --realtime. Can be provided with environment variable SLACK_APP_TOKEN.").Envar("SLACK_APP_TOKEN").String()
+	slackScanChannels       = slackScan.Flag("channel", "ID or name of the Slack channel to scan. You can repeat this flag. Leave empty to scan all visible conversations.").Strings()
+	slackScanIgnoreChannels = slackScan.Flag("ignore-channel", `ID or name of a Slack channel to skip. This can also be a glob pattern. You can repeat this flag. Example: "random", "ext-*"`).Strings()
+	slackScanRealtime       = slackScan.Flag("realtime", "Scan new messages as they are posted, using Socket Mode, instead of the workspace history.").Bool()
+
+	teamsScan               = cli.Command("teams", "Find credentials in Microsoft Teams channel messages and shared files.")
+	teamsScanTenantID       = teamsScan.Flag("tenant-id", "Microsoft Entra tenant ID of the app registration. Can be provided with environment variable AZURE_TENANT_ID.").Envar("AZURE_TENANT_ID").String()
+	teamsScanClientID       = teamsScan.Flag("client-id", "Client ID of the app registration. Can be provided with environment variable AZURE_CLIENT_ID.").Envar("AZURE_CLIENT_ID").String()
+	teamsScanClientSecret   = teamsScan.Flag("client-secret", "Client secret of the app registration. Can be provided with environment variable AZURE_CLIENT_SECRET.").Envar("AZURE_CLIENT_SECRET").String()
+	teamsScanToken          = teamsScan.Flag("token", "Microsoft Graph access token, used instead of an app registration. Can be provided with environment variable MICROSOFT_GRAPH_TOKEN.").Envar("MICROSOFT_GRAPH_TOKEN").String()
+	teamsScanTeamIDs        = teamsScan.Flag("team-id", "ID of the team to scan. You can repeat this flag. Leave empty to scan all the teams of the tenant.").Strings()
+	teamsScanChannels       = teamsScan.Flag("channel", "ID or name of the channel to scan. You can repeat this flag.").Strings()
+	teamsScanIgnoreChannels = teamsScan.Flag("ignore-channel", `ID or name of a channel to skip. This can also be a glob pattern. You can repeat this flag. Example: "Random", "ext-*"`).Strings()
+
+	npmScan              = cli.Command("npm", "Find credentials in the published versions of npm packages.")
+	npmScanRegistry      = npmScan.Flag("registry", "URL of the npm registry.").Default("https://registry.npmjs.org").String()
+	npmScanPackages      = npmScan.Flag("package", "Name of a package to scan. You can repeat this flag.").Strings()
+	npmScanMaintainers   = npmScan.Flag("maintainer", "npm user whose packages are scanned. You can repeat this flag.").Strings()
+	npmScanOrganizations = npmScan.Flag("org", "Organization (scope) whose packages are scanned. You can repeat this flag.").Strings()
+
+	pypiScan              = cli.Command("pypi", "Find credentials in the published releases of PyPI packages.")
+	pypiScanRegistry      = pypiScan.Flag("registry", "URL of the PyPI registry.").Default("https://pypi.org").String()
+	pypiScanPackages      = pypiScan.Flag("package", "Name of a package to scan. You can repeat this flag.").Strings()
+	pypiScanMaintainers   = pypiScan.Flag("maintainer", "PyPI user whose packages are scanned. You can repeat this flag.").Strings()
+	pypiScanOrganizations = pypiScan.Flag("org", "Organization whose packages are scanned. You can repeat this flag.").Strings()
+
+	googleDriveScan                 = cli.Command("google-drive", "Find credentials in Google Drive files, including Google Docs, Sheets and Slides.")
+	googleDriveScanServiceAccount   = googleDriveScan.Flag("service-account", "Path to the JSON key of the service account to authenticate as. Can be provided with environment variable GOOGLE_APPLICATION_CREDENTIALS.").Envar("GOOGLE_APPLICATION_CREDENTIALS").Required().ExistingFile()
+	googleDriveScanUsers            = googleDriveScan.Flag("user", "Email of a user to impersonate, using domain-wide delegation. Their drive and shared drives are scanned. You can repeat this flag.").Strings()
+	googleDriveScanIncludeRevisions = googleDriveScan.Flag("include-revisions", "Also scan the previous revisions of the files.").Bool()
+
+	sharepointScan                = cli.Command("sharepoint", "Find credentials in SharePoint document libraries and OneDrive.")
+	sharepointScanTenantID        = sharepointScan.Flag("tenant-id", "Microsoft Entra tenant ID of the app registration. Can be provided with environment variable AZURE_TENANT_ID.").Envar("AZURE_TENANT_ID").String()
+	sharepointScanClientID        = sharepointScan.Flag("client-id", "Client ID of the app registration. Can be provided with environment variable AZURE_CLIENT_ID.").Envar("AZURE_CLIENT_ID").String()
+	sharepointScanClientSecret    = sharepointScan.Flag("client-secret", "Client secret of the app registration. Can be provided with environment variable AZURE_CLIENT_SECRET.").Envar("AZURE_CLIENT_SECRET").String()
+	sharepointScanToken           = sharepointScan.Flag("token", "Microsoft Graph access token, used instead of an app registration. Can be provided with environment variable MICROSOFT_GRAPH_TOKEN.").Envar("MICROSOFT_GRAPH_TOKEN").String()
+	sharepointScanSiteURL         = sharepointScan.Flag("site-url", "URL of the SharePoint site to scan. Leave empty to scan all the sites of the tenant. Example: https://contoso.sharepoint.com/sites/Engineering").String()
+	sharepointScanIncludeOneDrive = sharepointScan.Flag("include-onedrive", "Also scan the OneDrive of every user.").Bool()
+
 	filesystemScan  = cli.Command("filesystem", "Find credentials in a filesystem.")
 	filesystemPaths = filesystemScan.Arg("path", "Path to file or directory to scan.").Strings()
 	// DEPRECATED: --directory is deprecated in favor of arguments.
@@ -127,6 +257,9 @@ var (
 	filesystemScanIncludePaths = filesystemScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
 	filesystemScanExcludePaths = filesystemScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
 
+	stdinScan     = cli.Command("stdin", "Find credentials in data piped to the standard input.")
+	stdinScanName = stdinScan.Flag("name", "Label for the piped data, reported with the results.").String()
+
 	s3Scan              = cli.Command("s3", "Find credentials in S3 buckets.")
 	s3ScanKey           = s3Scan.Flag("key", "S3 key used to authenticate. Can be provided with environment variable AWS_ACCESS_KEY_ID.").Envar("AWS_ACCESS_KEY_ID").String()
 	s3ScanRoleArns      = s3Scan.Flag("role-arn", "Specify the ARN of an IAM role to assume for scanning. You can repeat this flag.").Strings()
@@ -136,6 +269,27 @@ var (
 	s3ScanBuckets       = s3Scan.Flag("bucket", "Name of S3 bucket to scan. You can repeat this flag. Incompatible with --ignore-bucket.").Strings()
 	s3ScanIgnoreBuckets = s3Scan.Flag("ignore-bucket", "Name of S3 bucket to ignore. You can repeat this flag. Incompatible with --bucket.").Strings()
 	s3ScanMaxObjectSize = s3Scan.Flag("max-object-size", "Maximum size of objects to scan. Objects larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+	s3ScanEndpoint      = s3Scan.Flag("endpoint", "Endpoint of an S3-compatible service such as MinIO, Ceph RGW or Cloudflare R2. Example: https://minio.example.com:9000").String()
+	s3ScanRegion        = s3Scan.Flag("region", "Region to sign requests for. Defaults to us-east-1.").String()
+	s3ScanPathStyle     = s3Scan.Flag("force-path-style", "Address buckets in the URL path instead of the hostname, as most S3-compatible services require.").Bool()
+	s3ScanInsecureTLS   = s3Scan.Flag("insecure-skip-verify-tls", "Skip verification of the endpoint's TLS certificate.").Bool()
+
+	azureStorageScan              = cli.Command("azure-storage", "Find credentials in Azure Blob Storage containers.")
+	azureStorageConnectionString  = azureStorageScan.Flag("connection-string", "Storage account connection string. Can be provided with environment variable AZURE_STORAGE_CONNECTION_STRING.").Envar("AZURE_STORAGE_CONNECTION_STRING").String()
+	azureStorageAccountName       = azureStorageScan.Flag("account-name", "Storage account name. Can be provided with environment variable AZURE_STORAGE_ACCOUNT.").Envar("AZURE_STORAGE_ACCOUNT").String()
+	azureStorageAccountKey        = azureStorageScan.Flag("account-key", "Storage account shared key. Can be provided with environment variable AZURE_STORAGE_KEY.").Envar("AZURE_STORAGE_KEY").String()
+	azureStorageSASToken          = azureStorageScan.Flag("sas-token", "Shared access signature token. Can be provided with environment variable AZURE_STORAGE_SAS_TOKEN.").Envar("AZURE_STORAGE_SAS_TOKEN").String()
+	azureStorageTenantID          = azureStorageScan.Flag("tenant-id", "Service principal tenant ID. Can be provided with environment variable AZURE_TENANT_ID.").Envar("AZURE_TENANT_ID").String()
+	azureStorageClientID          = azureStorageScan.Flag("client-id", "Service principal client ID. Can be provided with environment variable AZURE_CLIENT_ID.").Envar("AZURE_CLIENT_ID").String()
+	azureStorageClientSecret      = azureStorageScan.Flag("client-secret", "Service principal client secret. Can be provided with environment variable AZURE_CLIENT_SECRET.").Envar("AZURE_CLIENT_SECRET").String()
+	azureStorageWithoutAuth       = azureStorageScan.Flag("without-auth", "Scan Azure Storage containers without authentication. This will only work for public containers").Bool()
+	azureStorageEndpoint          = azureStorageScan.Flag("endpoint", "Blob service URL of the storage account. Defaults to https://<account-name>.blob.core.windows.net/").String()
+	azureStorageContainers        = azureStorageScan.Flag("container", "Container to scan. You can repeat this flag. Leave empty to scan all containers.").Strings()
+	azureStorageIncludeContainers = azureStorageScan.Flag("include-containers", "Containers to scan. Comma separated list of containers. You can repeat this flag. Globs are supported").Short('I').Strings()
+	azureStorageExcludeContainers = azureStorageScan.Flag("exclude-containers", "Containers to exclude from scan. Comma separated list of containers. Globs are supported").Short('X').Strings()
+	azureStorageIncludeBlobs      = azureStorageScan.Flag("include-blobs", "Blobs to scan. Comma separated list of blobs. You can repeat this flag. Globs are supported").Short('i').Strings()
+	azureStorageExcludeBlobs      = azureStorageScan.Flag("exclude-blobs", "Blobs to exclude from scan. Comma separated list of blobs. You can repeat this flag. Globs are supported").Short('x').Strings()
+	azureStorageMaxObjectSize     = azureStorageScan.Flag("max-object-size", "Maximum size of blobs to scan. Blobs larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
 
 	gcsScan           = cli.Command("gcs", "Find credentials in GCS buckets.")
 	gcsProjectID      = gcsScan.Flag("project-id", "GCS project ID used to authenticate. Can NOT be used with unauth scan. Can be provided with environment variable GOOGLE_CLOUD_PROJECT.").Envar("GOOGLE_CLOUD_PROJECT").String()
@@ -156,6 +310,14 @@ var (
 	syslogTLSKey   = syslogScan.Flag("key", "Path to TLS key.").String()
 	syslogFormat   = syslogScan.Flag("format", "Log format. Can be rfc3164 or rfc5424").String()
 
+	webhookScan             = cli.Command("webhook", "Find credentials in the payloads posted to an HTTP server.")
+	webhookScanListen       = webhookScan.Flag("listen", "Address and port to listen on. Example: 127.0.0.1:8080").Default(":8080").String()
+	webhookScanSecretHeader = webhookScan.Flag("secret-header", "Name of the header holding the shared secret.").Default("X-Trufflehog-Secret").String()
+	webhookScanSecret       = webhookScan.Flag("secret", "Shared secret requests must provide in the secret header. Can also be provided with environment variable").Envar("TRUFFLEHOG_WEBHOOK_SECRET").String()
+	webhookScanHMACSecret   = webhookScan.Flag("hmac-secret", "Secret requests must sign their body with, providing the hex HMAC-SHA256 in the X-Trufflehog-Signature header as sha256=<signature>. Can also be provided with environment variable").Envar("TRUFFLEHOG_WEBHOOK_HMAC_SECRET").String()
+	webhookScanSync         = webhookScan.Flag("sync", "Respond to requests with the results found in their payload, once none is found for 3 seconds.").Bool()
+	webhookScanMaxBodySize  = webhookScan.Flag("max-body-size", "Maximum size of a request body, at most 5MB for requests signed with an HMAC secret. (Byte units eg. 512B, 2KB, 4MB)").Default("50MB").Bytes()
+
 	circleCiScan      = cli.Command("circleci", "Scan CircleCI")
 	circleCiScanToken = circleCiScan.Flag("token", "CircleCI token. Can also be provided with environment variable").Envar("CIRCLECI_TOKEN").Required().String()
 
@@ -163,9 +325,34 @@ var (
 	dockerScanImages = dockerScan.Flag("image", "Docker image to scan. Use the file:// prefix to point to a local tarball, otherwise a image registry is assumed.").Required().Strings()
 	dockerScanToken  = dockerScan.Flag("token", "Docker bearer token. Can also be provided with environment variable").Envar("DOCKER_TOKEN").String()
 
+	ecrScan                    = cli.Command("ecr", "Find credentials in the images of Amazon ECR registries.")
+	ecrScanKey                 = ecrScan.Flag("key", "AWS access key ID used to authenticate. Can be provided with environment variable AWS_ACCESS_KEY_ID. The AWS credential chain is used when empty.").Envar("AWS_ACCESS_KEY_ID").String()
+	ecrScanSecret              = ecrScan.Flag("secret", "AWS secret access key used to authenticate. Can be provided with environment variable AWS_SECRET_ACCESS_KEY.").Envar("AWS_SECRET_ACCESS_KEY").String()
+	ecrScanSessionToken        = ecrScan.Flag("session-token", "AWS session token used to authenticate temporary credentials. Can be provided with environment variable AWS_SESSION_TOKEN.").Envar("AWS_SESSION_TOKEN").String()
+	ecrScanRoleArns            = ecrScan.Flag("role-arn", "Specify the ARN of an IAM role to assume for scanning. You can repeat this flag.").Strings()
+	ecrScanRegions             = ecrScan.Flag("region", "Region of the registries to scan. You can repeat this flag. Defaults to the region of the AWS configuration.").Strings()
+	ecrScanRegistries          = ecrScan.Flag("registry-id", "ID of the registry (AWS account) to scan. You can repeat this flag. Defaults to the registry of the authenticated account.").Strings()
+	ecrScanIncludeRepositories = ecrScan.Flag("include-repo", `Glob pattern of the repositories to scan. You can repeat this flag. Example: "backend/*"`).Strings()
+	ecrScanExcludeRepositories = ecrScan.Flag("exclude-repo", "Glob pattern of the repositories to skip. You can repeat this flag.").Strings()
+	ecrScanMaxTags             = ecrScan.Flag("max-tags", "Only scan the images of the latest N tags of each repository. All images are scanned when 0.").Default("0").Int64()
+
+	kubernetesScan                  = cli.Command("kubernetes", "Scan the ConfigMaps, workloads and Secrets of a Kubernetes cluster.")
+	kubernetesScanKubeconfig        = kubernetesScan.Flag("kubeconfig", "Path to the kubeconfig file. Defaults to $HOME/.kube/config.").Envar("KUBECONFIG").String()
+	kubernetesScanContext           = kubernetesScan.Flag("context", "Kubeconfig context to use. Defaults to the current context.").String()
+	kubernetesScanNamespaces        = kubernetesScan.Flag("namespace", "Namespace to scan. You can repeat this flag. All namespaces are scanned by default.").Strings()
+	kubernetesScanExcludeNamespaces = kubernetesScan.Flag("exclude-namespace", "Namespace glob pattern to skip. You can repeat this flag.").Strings()
+	kubernetesScanIncludeSecrets    = kubernetesScan.Flag("include-secrets", "Scan the values of Secrets.").Bool()
+	kubernetesScanInCluster         = kubernetesScan.Flag("in-cluster", "Use the service account of the pod TruffleHog runs in.").Bool()
+
 	travisCiScan      = cli.Command("travisci", "Scan TravisCI")
 	travisCiScanToken = travisCiScan.Flag("token", "TravisCI token. Can also be provided with environment variable").Envar("TRAVISCI_TOKEN").Required().String()
 
+	buildkiteScan                 = cli.Command("buildkite", "Scan Buildkite job logs and artifacts.")
+	buildkiteScanToken            = buildkiteScan.Flag("token", "Buildkite API access token. Can also be provided with environment variable").Envar("BUILDKITE_TOKEN").Required().String()
+	buildkiteScanOrganizations    = buildkiteScan.Flag("org", "Slug of an organization to scan. You can repeat this flag. Leave empty to scan all the organizations the token can access.").Strings()
+	buildkiteScanPipelines        = buildkiteScan.Flag("pipeline", `Slug of a pipeline to scan, optionally prefixed by its organization. You can repeat this flag. Example: "deploy", "acme/release"`).Strings()
+	buildkiteScanIncludeArtifacts = buildkiteScan.Flag("include-artifacts", "Also scan the artifacts of the builds.").Bool()
+
 	// Postman is hidden for now until we get more feedback from the community.
 	postmanScan  = cli.Command("postman", "Scan Postman")
 	postmanToken = postmanScan.Flag("token", "Postman token. Can also be provided with environment variable").Envar("POSTMAN_TOKEN").String()
@@ -204,10 +391,13 @@ var (
 	elasticsearchBestEffortScan = elasticsearchScan.Flag("best-effort-scan", "Attempts to continuously scan a cluster").Envar("ELASTICSEARCH_BEST_EFFORT_SCAN").Bool()
 
 	jenkinsScan                  = cli.Command("jenkins", "Scan Jenkins")
-	jenkinsURL                   = jenkinsScan.Flag("url", "Jenkins URL").Envar("JENKINS_URL").Required().String()
+	jenkinsURL                   = jenkinsScan.Flag("url", "Jenkins URL").Envar("JENKINS_URL").String()
 	jenkinsUsername              = jenkinsScan.Flag("username", "Jenkins username").Envar("JENKINS_USERNAME").String()
 	jenkinsPassword              = jenkinsScan.Flag("password", "Jenkins password").Envar("JENKINS_PASSWORD").String()
 	jenkinsInsecureSkipVerifyTLS = jenkinsScan.Flag("insecure-skip-verify-tls", "Skip TLS verification").Envar("JENKINS_INSECURE_SKIP_VERIFY_TLS").Bool()
+	jenkinsHome                  = jenkinsScan.Flag("home", "Path to a local JENKINS_HOME, such as a backup, to scan instead of --url. Secrets are decrypted with its master key.").String()
+	jenkinsIncludeConfigs        = jenkinsScan.Flag("include-configs", "Scan the config.xml of jobs and folders, and the Jenkinsfile of pipeline builds.").Bool()
+	jenkinsIncludeBuildParams    = jenkinsScan.Flag("include-build-parameters", "Scan the parameters and injected environment variables of builds.").Bool()
 
 	huggingfaceScan     = cli.Command("huggingface", "Find credentials in HuggingFace datasets, models and spaces.")
 	huggingfaceEndpoint = huggingfaceScan.Flag("endpoint", "HuggingFace endpoint.").Default("https://huggingface.co").String()
@@ -575,6 +765,11 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 
 	cfg.SourceManager = sources.NewManager(opts...)
 
+	if cmd == webhookScan.FullCommand() && *webhookScanSync {
+		// Collect the results of the requests to respond with them.
+		cfg.Dispatcher = webhook.NewDispatcher(cfg.Dispatcher)
+	}
+
 	eng, err := engine.NewEngine(ctx, &cfg)
 	if err != nil {
 		return scanMetrics, fmt.Errorf("error initializing engine: %v", err)
@@ -657,6 +852,216 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		if err := eng.ScanGitLab(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan GitLab: %v", err)
 		}
+	case artifactoryScan.FullCommand():
+		cfg := sources.ArtifactoryConfig{
+			Endpoint:        *artifactoryScanEndpoint,
+			Token:           *artifactoryScanToken,
+			Username:        *artifactoryScanUsername,
+			Password:        *artifactoryScanPassword,
+			Repos:           *artifactoryScanRepos,
+			IncludeRepos:    *artifactoryScanIncludeRepos,
+			ExcludeRepos:    *artifactoryScanExcludeRepos,
+			IncludePaths:    *artifactoryScanIncludeArtifacts,
+			ExcludePaths:    *artifactoryScanExcludeArtifacts,
+			MaxArtifactSize: int64(*artifactoryScanMaxArtifactSize),
+			Concurrency:     *concurrency,
+		}
+		if err := eng.ScanArtifactory(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Artifactory: %v", err)
+		}
+	case bitbucketScan.FullCommand():
+		filter, err := common.FilterFromFiles(*bitbucketScanIncludePaths, *bitbucketScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.BitbucketConfig{
+			Endpoint:     *bitbucketScanEndpoint,
+			Token:        *bitbucketScanToken,
+			Username:     *bitbucketScanUsername,
+			Password:     *bitbucketScanAppPassword,
+			Repos:        *bitbucketScanRepos,
+			Workspaces:   *bitbucketScanWorkspaces,
+			Projects:     *bitbucketScanProjects,
+			IncludeRepos: *bitbucketScanIncludeRepos,
+			ExcludeRepos: *bitbucketScanExcludeRepos,
+			Filter:       filter,
+			Concurrency:  *concurrency,
+		}
+		if err := eng.ScanBitbucket(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Bitbucket: %v", err)
+		}
+	case gerritScan.FullCommand():
+		filter, err := common.FilterFromFiles(*gerritScanIncludePaths, *gerritScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.GerritConfig{
+			Endpoint:        *gerritScanEndpoint,
+			Username:        *gerritScanUsername,
+			Password:        *gerritScanPassword,
+			Projects:        *gerritScanProjects,
+			IncludeChanges:  *gerritScanIncludeChanges,
+			IncludeComments: *gerritScanIncludeComments,
+			Filter:          filter,
+			Concurrency:     *concurrency,
+		}
+		if err := eng.ScanGerrit(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Gerrit: %v", err)
+		}
+	case azureReposScan.FullCommand():
+		filter, err := common.FilterFromFiles(*azureReposScanIncludePaths, *azureReposScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.AzureReposConfig{
+			Endpoint:        *azureReposScanEndpoint,
+			Token:           *azureReposScanToken,
+			Organizations:   *azureReposScanOrganizations,
+			Projects:        *azureReposScanProjects,
+			Repos:           *azureReposScanRepos,
+			IncludeProjects: *azureReposScanIncludeProjects,
+			ExcludeProjects: *azureReposScanExcludeProjects,
+			IncludeRepos:    *azureReposScanIncludeRepos,
+			ExcludeRepos:    *azureReposScanExcludeRepos,
+			IncludeForks:    *azureReposScanIncludeForks,
+			Filter:          filter,
+			Concurrency:     *concurrency,
+		}
+		if err := eng.ScanAzureRepos(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Azure Repos: %v", err)
+		}
+	case giteaScan.FullCommand():
+		filter, err := common.FilterFromFiles(*giteaScanIncludePaths, *giteaScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.GiteaConfig{
+			Endpoint:             *giteaScanEndpoint,
+			Token:                *giteaScanToken,
+			Repos:                *giteaScanRepos,
+			Organizations:        *giteaScanOrgs,
+			Users:                *giteaScanUsers,
+			IncludeRepos:         *giteaScanIncludeRepos,
+			ExcludeRepos:         *giteaScanExcludeRepos,
+			IncludeForks:         *giteaScanIncludeForks,
+			IncludeIssueComments: *giteaScanIssueComments,
+			IncludePRComments:    *giteaScanPRComments,
+			IncludeWikis:         *giteaScanIncludeWikis,
+			Filter:               filter,
+			Concurrency:          *concurrency,
+		}
+		if err := eng.ScanGitea(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Gitea: %v", err)
+		}
+	case confluenceScan.FullCommand():
+		cfg := sources.ConfluenceConfig{
+			Endpoint:              *confluenceScanEndpoint,
+			Username:              *confluenceScanUsername,
+			Password:              *confluenceScanPassword,
+			Token:                 *confluenceScanToken,
+			Spaces:                *confluenceScanSpaces,
+			IgnoreSpaces:          *confluenceScanIgnoreSpaces,
+			SpacesScope:           *confluenceScanSpacesScope,
+			IncludeAttachments:    *confluenceScanIncludeAttachment,
+			SkipHistory:           *confluenceScanSkipHistory,
+			InsecureSkipVerifyTLS: *confluenceScanInsecureSkipTLS,
+			Concurrency:           *concurrency,
+		}
+		if err := eng.ScanConfluence(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Confluence: %v", err)
+		}
+	case jiraScan.FullCommand():
+		cfg := sources.JiraConfig{
+			Endpoint:              *jiraScanEndpoint,
+			Username:              *jiraScanUsername,
+			Password:              *jiraScanPassword,
+			Token:                 *jiraScanToken,
+			JQL:                   *jiraScanJQL,
+			Projects:              *jiraScanProjects,
+			IgnoreProjects:        *jiraScanIgnoreProjects,
+			SkipAttachments:       *jiraScanSkipAttachments,
+			MaxAttachmentSize:     int64(*jiraScanMaxAttachmentSize),
+			InsecureSkipVerifyTLS: *jiraScanInsecureSkipTLS,
+			Concurrency:           *concurrency,
+		}
+		if err := eng.ScanJira(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Jira: %v", err)
+		}
+	case slackScan.FullCommand():
+		cfg := sources.SlackConfig{
+			Token:          *slackScanToken,
+			AppToken:       *slackScanAppToken,
+			Channels:       *slackScanChannels,
+			IgnoreChannels: *slackScanIgnoreChannels,
+			Realtime:       *slackScanRealtime,
+			Concurrency:    *concurrency,
+		}
+		if err := eng.ScanSlack(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Slack: %v", err)
+		}
+	case teamsScan.FullCommand():
+		cfg := sources.TeamsConfig{
+			TenantID:       *teamsScanTenantID,
+			ClientID:       *teamsScanClientID,
+			ClientSecret:   *teamsScanClientSecret,
+			Token:          *teamsScanToken,
+			TeamIDs:        *teamsScanTeamIDs,
+			Channels:       *teamsScanChannels,
+			IgnoreChannels: *teamsScanIgnoreChannels,
+			Concurrency:    *concurrency,
+		}
+		if err := eng.ScanTeams(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Teams: %v", err)
+		}
+	case npmScan.FullCommand():
+		cfg := sources.NPMConfig{
+			Registry:      *npmScanRegistry,
+			Packages:      *npmScanPackages,
+			Maintainers:   *npmScanMaintainers,
+			Organizations: *npmScanOrganizations,
+			Concurrency:   *concurrency,
+		}
+		if err := eng.ScanNPM(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan npm: %v", err)
+		}
+	case pypiScan.FullCommand():
+		cfg := sources.PyPIConfig{
+			Registry:      *pypiScanRegistry,
+			Packages:      *pypiScanPackages,
+			Maintainers:   *pypiScanMaintainers,
+			Organizations: *pypiScanOrganizations,
+			Concurrency:   *concurrency,
+		}
+		if err := eng.ScanPyPI(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan PyPI: %v", err)
+		}
+	case googleDriveScan.FullCommand():
+		cfg := sources.GoogleDriveConfig{
+			ServiceAccount:   *googleDriveScanServiceAccount,
+			Users:            *googleDriveScanUsers,
+			IncludeRevisions: *googleDriveScanIncludeRevisions,
+			Concurrency:      *concurrency,
+		}
+		if err := eng.ScanGoogleDrive(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Google Drive: %v", err)
+		}
+	case sharepointScan.FullCommand():
+		cfg := sources.SharePointConfig{
+			TenantID:        *sharepointScanTenantID,
+			ClientID:        *sharepointScanClientID,
+			ClientSecret:    *sharepointScanClientSecret,
+			Token:           *sharepointScanToken,
+			SiteURL:         *sharepointScanSiteURL,
+			IncludeOneDrive: *sharepointScanIncludeOneDrive,
+			Concurrency:     *concurrency,
+		}
+		if err := eng.ScanSharePoint(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan SharePoint: %v", err)
+		}
 	case filesystemScan.FullCommand():
 		if len(*filesystemDirectories) > 0 {
 			ctx.Logger().Info("--directory flag is deprecated, please pass directories as arguments")
@@ -672,16 +1077,25 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		if err = eng.ScanFileSystem(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan filesystem: %v", err)
 		}
+	case stdinScan.FullCommand():
+		cfg := sources.StdinConfig{Name: *stdinScanName}
+		if err := eng.ScanStdin(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan stdin: %v", err)
+		}
 	case s3Scan.FullCommand():
 		cfg := sources.S3Config{
-			Key:           *s3ScanKey,
-			Secret:        *s3ScanSecret,
-			SessionToken:  *s3ScanSessionToken,
-			Buckets:       *s3ScanBuckets,
-			IgnoreBuckets: *s3ScanIgnoreBuckets,
-			Roles:         *s3ScanRoleArns,
-			CloudCred:     *s3ScanCloudEnv,
-			MaxObjectSize: int64(*s3ScanMaxObjectSize),
+			Key:                   *s3ScanKey,
+			Secret:                *s3ScanSecret,
+			SessionToken:          *s3ScanSessionToken,
+			Buckets:               *s3ScanBuckets,
+			IgnoreBuckets:         *s3ScanIgnoreBuckets,
+			Roles:                 *s3ScanRoleArns,
+			CloudCred:             *s3ScanCloudEnv,
+			MaxObjectSize:         int64(*s3ScanMaxObjectSize),
+			Endpoint:              *s3ScanEndpoint,
+			Region:                *s3ScanRegion,
+			ForcePathStyle:        *s3ScanPathStyle,
+			InsecureSkipVerifyTLS: *s3ScanInsecureTLS,
 		}
 		if err := eng.ScanS3(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan S3: %v", err)
@@ -698,6 +1112,18 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		if err := eng.ScanSyslog(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan syslog: %v", err)
 		}
+	case webhookScan.FullCommand():
+		cfg := sources.WebhookConfig{
+			ListenAddress: *webhookScanListen,
+			HeaderName:    *webhookScanSecretHeader,
+			HeaderValue:   *webhookScanSecret,
+			HMACSecret:    *webhookScanHMACSecret,
+			SyncResponse:  *webhookScanSync,
+			MaxBodySize:   int64(*webhookScanMaxBodySize),
+		}
+		if err := eng.ScanWebhook(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan webhook: %v", err)
+		}
 	case circleCiScan.FullCommand():
 		if err := eng.ScanCircleCI(ctx, *circleCiScanToken); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan CircleCI: %v", err)
@@ -706,6 +1132,39 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		if err := eng.ScanTravisCI(ctx, *travisCiScanToken); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan TravisCI: %v", err)
 		}
+	case buildkiteScan.FullCommand():
+		cfg := sources.BuildkiteConfig{
+			Token:            *buildkiteScanToken,
+			Organizations:    *buildkiteScanOrganizations,
+			Pipelines:        *buildkiteScanPipelines,
+			IncludeArtifacts: *buildkiteScanIncludeArtifacts,
+			Concurrency:      *concurrency,
+		}
+		if err := eng.ScanBuildkite(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Buildkite: %v", err)
+		}
+	case azureStorageScan.FullCommand():
+		cfg := sources.AzureStorageConfig{
+			ConnectionString:  *azureStorageConnectionString,
+			AccountName:       *azureStorageAccountName,
+			AccountKey:        *azureStorageAccountKey,
+			SASToken:          *azureStorageSASToken,
+			TenantID:          *azureStorageTenantID,
+			ClientID:          *azureStorageClientID,
+			ClientSecret:      *azureStorageClientSecret,
+			WithoutAuth:       *azureStorageWithoutAuth,
+			Endpoint:          *azureStorageEndpoint,
+			Containers:        *azureStorageContainers,
+			IncludeContainers: commaSeparatedToSlice(*azureStorageIncludeContainers),
+			ExcludeContainers: commaSeparatedToSlice(*azureStorageExcludeContainers),
+			IncludeBlobs:      commaSeparatedToSlice(*azureStorageIncludeBlobs),
+			ExcludeBlobs:      commaSeparatedToSlice(*azureStorageExcludeBlobs),
+			MaxObjectSize:     int64(*azureStorageMaxObjectSize),
+			Concurrency:       *concurrency,
+		}
+		if err := eng.ScanAzureStorage(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Azure Storage: %v", err)
+		}
 	case gcsScan.FullCommand():
 		cfg := sources.GCSConfig{
 			ProjectID:      *gcsProjectID,
@@ -732,6 +1191,35 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		if err := eng.ScanDocker(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan Docker: %v", err)
 		}
+	case ecrScan.FullCommand():
+		cfg := sources.ECRConfig{
+			Key:                 *ecrScanKey,
+			Secret:              *ecrScanSecret,
+			SessionToken:        *ecrScanSessionToken,
+			Regions:             *ecrScanRegions,
+			Registries:          *ecrScanRegistries,
+			Roles:               *ecrScanRoleArns,
+			IncludeRepositories: *ecrScanIncludeRepositories,
+			ExcludeRepositories: *ecrScanExcludeRepositories,
+			MaxTags:             *ecrScanMaxTags,
+			Concurrency:         *concurrency,
+		}
+		if err := eng.ScanECR(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan ECR: %v", err)
+		}
+	case kubernetesScan.FullCommand():
+		cfg := sources.KubernetesConfig{
+			Kubeconfig:        *kubernetesScanKubeconfig,
+			Context:           *kubernetesScanContext,
+			Namespaces:        *kubernetesScanNamespaces,
+			ExcludeNamespaces: *kubernetesScanExcludeNamespaces,
+			IncludeSecrets:    *kubernetesScanIncludeSecrets,
+			InCluster:         *kubernetesScanInCluster,
+			Concurrency:       *concurrency,
+		}
+		if err := eng.ScanKubernetes(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Kubernetes: %v", err)
+		}
 	case postmanScan.FullCommand():
 		// handle deprecated flag
 		workspaceIDs := make([]string, 0, len(*postmanWorkspaceIDs)+len(*postmanWorkspaces))
@@ -786,11 +1274,17 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			return scanMetrics, fmt.Errorf("failed to scan Elasticsearch: %v", err)
 		}
 	case jenkinsScan.FullCommand():
+		if *jenkinsURL == "" && *jenkinsHome == "" {
+			return scanMetrics, fmt.Errorf("invalid config: you must specify either --url or --home")
+		}
 		cfg := engine.JenkinsConfig{
-			Endpoint:              *jenkinsURL,
-			InsecureSkipVerifyTLS: *jenkinsInsecureSkipVerifyTLS,
-			Username:              *jenkinsUsername,
-			Password:              *jenkinsPassword,
+			Endpoint:               *jenkinsURL,
+			InsecureSkipVerifyTLS:  *jenkinsInsecureSkipVerifyTLS,
+			Username:               *jenkinsUsername,
+			Password:               *jenkinsPassword,
+			Home:                   *jenkinsHome,
+			IncludeConfigs:         *jenkinsIncludeConfigs,
+			IncludeBuildParameters: *jenkinsIncludeBuildParams,
 		}
 		if err := eng.ScanJenkins(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan Jenkins: %v", err)
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/artifactory"
+)
+
+// ScanArtifactory scans Artifactory repositories with the provided configuration.
+func (e *Engine) ScanArtifactory(ctx context.Context, c sources.ArtifactoryConfig) error {
+	connection := &sourcespb.Artifactory{
+		Endpoint:            c.Endpoint,
+		Repositories:        c.Repos,
+		IncludeRepositories: c.IncludeRepos,
+		IgnoreRepositories:  c.ExcludeRepos,
+		IncludePaths:        c.IncludePaths,
+		IgnorePaths:         c.ExcludePaths,
+		MaxArtifactSize:     c.MaxArtifactSize,
+		SkipArchives:        c.SkipArchives,
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Artifactory_AccessToken{
+			AccessToken: c.Token,
+		}
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Artifactory_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	default:
+		connection.Credential = &sourcespb.Artifactory_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal artifactory connection")
+		return err
+	}
+
+	sourceName := "trufflehog - artifactory"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, artifactory.SourceType)
+
+	artifactorySource := &artifactory.Source{}
+	if err := artifactorySource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, artifactorySource)
+	return err
+}
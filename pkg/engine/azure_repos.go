@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"fmt"
+
+	gogit "github.com/go-git/go-git/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurerepos"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanAzureRepos scans Azure DevOps Services or Azure DevOps Server repositories with the provided configuration.
+func (e *Engine) ScanAzureRepos(ctx context.Context, c sources.AzureReposConfig) error {
+	if len(c.Token) == 0 {
+		return fmt.Errorf("must provide a personal access token")
+	}
+
+	logOptions := &gogit.LogOptions{}
+	opts := []git.ScanOption{
+		git.ScanOptionFilter(c.Filter),
+		git.ScanOptionLogOptions(logOptions),
+	}
+	scanOptions := git.NewScanOptions(opts...)
+
+	connection := &sourcespb.AzureRepos{
+		Endpoint:        c.Endpoint,
+		Credential:      &sourcespb.AzureRepos_Token{Token: c.Token},
+		Repositories:    c.Repos,
+		Organizations:   c.Organizations,
+		Projects:        c.Projects,
+		IncludeForks:    c.IncludeForks,
+		IncludeRepos:    c.IncludeRepos,
+		IgnoreRepos:     c.ExcludeRepos,
+		IncludeProjects: c.IncludeProjects,
+		IgnoreProjects:  c.ExcludeProjects,
+		SkipBinaries:    c.SkipBinaries,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal azure repos connection")
+		return err
+	}
+
+	sourceName := "trufflehog - azure repos"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, azurerepos.SourceType)
+
+	azureReposSource := &azurerepos.Source{}
+	if err := azureReposSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	azureReposSource.WithScanOptions(scanOptions)
+	_, err = e.sourceManager.Run(ctx, sourceName, azureReposSource)
+	return err
+}
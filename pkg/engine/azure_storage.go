@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurestorage"
+)
+
+// ScanAzureStorage scans Azure Blob Storage containers with the provided options.
+func (e *Engine) ScanAzureStorage(ctx context.Context, c sources.AzureStorageConfig) error {
+	connection := &sourcespb.AzureStorage{
+		AccountName:       c.AccountName,
+		Endpoint:          c.Endpoint,
+		StorageContainers: c.Containers,
+		IncludeContainers: c.IncludeContainers,
+		ExcludeContainers: c.ExcludeContainers,
+		IncludeBlobs:      c.IncludeBlobs,
+		ExcludeBlobs:      c.ExcludeBlobs,
+		MaxObjectSize:     c.MaxObjectSize,
+	}
+
+	var selected int
+	if c.ConnectionString != "" {
+		selected++
+		connection.Credential = &sourcespb.AzureStorage_ConnectionString{
+			ConnectionString: c.ConnectionString,
+		}
+	}
+	if c.AccountKey != "" {
+		selected++
+		connection.Credential = &sourcespb.AzureStorage_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.AccountName,
+				Password: c.AccountKey,
+			},
+		}
+	}
+	if c.SASToken != "" {
+		selected++
+		connection.Credential = &sourcespb.AzureStorage_SasToken{
+			SasToken: c.SASToken,
+		}
+	}
+	if c.ClientSecret != "" {
+		selected++
+		connection.Credential = &sourcespb.AzureStorage_ClientCredentials{
+			ClientCredentials: &credentialspb.ClientCredentials{
+				TenantId:     c.TenantID,
+				ClientId:     c.ClientID,
+				ClientSecret: c.ClientSecret,
+			},
+		}
+	}
+	if c.WithoutAuth {
+		selected++
+		connection.Credential = &sourcespb.AzureStorage_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+	switch {
+	case selected == 0:
+		return fmt.Errorf("no auth method selected, provide a connection string, account key, SAS token, service principal, or use --without-auth")
+	case selected > 1:
+		return fmt.Errorf("multiple auth methods selected, please select only one")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to marshal Azure Storage connection: %w", err)
+	}
+
+	sourceName := "trufflehog - azure storage"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, azurestorage.SourceType)
+
+	azureSource := &azurestorage.Source{}
+	if err := azureSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, azureSource)
+	return err
+}
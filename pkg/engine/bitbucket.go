@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"fmt"
+
+	gogit "github.com/go-git/go-git/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/bitbucket"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanBitbucket scans Bitbucket Cloud or Bitbucket Data Center with the provided configuration.
+func (e *Engine) ScanBitbucket(ctx context.Context, c sources.BitbucketConfig) error {
+	logOptions := &gogit.LogOptions{}
+	opts := []git.ScanOption{
+		git.ScanOptionFilter(c.Filter),
+		git.ScanOptionLogOptions(logOptions),
+	}
+	scanOptions := git.NewScanOptions(opts...)
+
+	connection := &sourcespb.Bitbucket{
+		Endpoint:     c.Endpoint,
+		Repositories: c.Repos,
+		Workspaces:   c.Workspaces,
+		Projects:     c.Projects,
+		IncludeRepos: c.IncludeRepos,
+		IgnoreRepos:  c.ExcludeRepos,
+		SkipBinaries: c.SkipBinaries,
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Bitbucket_Token{
+			Token: c.Token,
+		}
+	case len(c.Username) > 0 && len(c.Password) > 0:
+		connection.Credential = &sourcespb.Bitbucket_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	default:
+		return fmt.Errorf("must provide a token or a username and app password")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal bitbucket connection")
+		return err
+	}
+
+	sourceName := "trufflehog - bitbucket"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, bitbucket.SourceType)
+
+	bitbucketSource := &bitbucket.Source{}
+	if err := bitbucketSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	bitbucketSource.WithScanOptions(scanOptions)
+	_, err = e.sourceManager.Run(ctx, sourceName, bitbucketSource)
+	return err
+}
@@ -0,0 +1,38 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/buildkite"
+)
+
+// ScanBuildkite scans Buildkite job logs and artifacts with the provided configuration.
+func (e *Engine) ScanBuildkite(ctx context.Context, c sources.BuildkiteConfig) error {
+	connection := &sourcespb.Buildkite{
+		Credential:       &sourcespb.Buildkite_Token{Token: c.Token},
+		Organizations:    c.Organizations,
+		Pipelines:        c.Pipelines,
+		IncludeArtifacts: c.IncludeArtifacts,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal buildkite connection")
+		return err
+	}
+
+	sourceName := "trufflehog - buildkite"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, buildkite.SourceType)
+
+	buildkiteSource := &buildkite.Source{}
+	if err := buildkiteSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, buildkiteSource)
+	return err
+}
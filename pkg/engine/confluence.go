@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/confluence"
+)
+
+// ScanConfluence scans Confluence Cloud or Confluence Data Center with the provided configuration.
+func (e *Engine) ScanConfluence(ctx context.Context, c sources.ConfluenceConfig) error {
+	connection := &sourcespb.Confluence{
+		Endpoint:              c.Endpoint,
+		Spaces:                c.Spaces,
+		IgnoreSpaces:          c.IgnoreSpaces,
+		IncludeAttachments:    c.IncludeAttachments,
+		SkipHistory:           c.SkipHistory,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+
+	if c.SpacesScope != "" {
+		scope, ok := sourcespb.Confluence_GetAllSpacesScope_value[strings.ToUpper(c.SpacesScope)]
+		if !ok {
+			return fmt.Errorf("invalid spaces scope %q", c.SpacesScope)
+		}
+		connection.SpacesScope = sourcespb.Confluence_GetAllSpacesScope(scope)
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Confluence_Token{
+			Token: c.Token,
+		}
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Confluence_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	default:
+		connection.Credential = &sourcespb.Confluence_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal confluence connection")
+		return err
+	}
+
+	sourceName := "trufflehog - confluence"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, confluence.SourceType)
+
+	confluenceSource := &confluence.Source{}
+	if err := confluenceSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, confluenceSource)
+	return err
+}
@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/ecr"
+)
+
+// ScanECR scans the images of Amazon ECR registries.
+func (e *Engine) ScanECR(ctx context.Context, c sources.ECRConfig) error {
+	connection := &sourcespb.ECR{
+		// Without static credentials, the AWS SDK credential chain is used.
+		Credential:          &sourcespb.ECR_CloudEnvironment{},
+		Regions:             c.Regions,
+		Registries:          c.Registries,
+		Roles:               c.Roles,
+		IncludeRepositories: c.IncludeRepositories,
+		ExcludeRepositories: c.ExcludeRepositories,
+		MaxTags:             c.MaxTags,
+	}
+	if len(c.Key) > 0 || len(c.Secret) > 0 {
+		if len(c.Key) == 0 || len(c.Secret) == 0 {
+			return fmt.Errorf("both a key and a secret are required to use static credentials")
+		}
+		if len(c.SessionToken) > 0 {
+			connection.Credential = &sourcespb.ECR_SessionToken{
+				SessionToken: &credentialspb.AWSSessionTokenSecret{
+					Key:          c.Key,
+					Secret:       c.Secret,
+					SessionToken: c.SessionToken,
+				},
+			}
+		} else {
+			connection.Credential = &sourcespb.ECR_AccessKey{
+				AccessKey: &credentialspb.KeySecret{
+					Key:    c.Key,
+					Secret: c.Secret,
+				},
+			}
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal ECR connection")
+		return err
+	}
+
+	sourceName := "trufflehog - ecr"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, ecr.SourceType)
+
+	ecrSource := &ecr.Source{}
+	if err := ecrSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, ecrSource)
+	return err
+}
@@ -1191,7 +1191,8 @@ func SupportsLineNumbers(sourceType sourcespb.SourceType) bool {
 		sourcespb.SourceType_SOURCE_TYPE_GITHUB_UNAUTHENTICATED_ORG,
 		sourcespb.SourceType_SOURCE_TYPE_PUBLIC_GIT,
 		sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM,
-		sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS:
+		sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS,
+		sourcespb.SourceType_SOURCE_TYPE_GITEA:
 		return true
 	default:
 		return false
@@ -1251,6 +1252,9 @@ func FragmentFirstLineAndLink(chunk *sources.Chunk) (int64, *int64, string) {
 	case *source_metadatapb.MetaData_AzureRepos:
 		fragmentStart = &metadata.AzureRepos.Line
 		link = metadata.AzureRepos.Link
+	case *source_metadatapb.MetaData_Gitea:
+		fragmentStart = &metadata.Gitea.Line
+		link = metadata.Gitea.Link
 	default:
 		return 0, nil, ""
 	}
@@ -1288,6 +1292,8 @@ func UpdateLink(ctx context.Context, metadata *source_metadatapb.MetaData, link
 		meta.Filesystem.Link = newLink
 	case *source_metadatapb.MetaData_AzureRepos:
 		meta.AzureRepos.Link = newLink
+	case *source_metadatapb.MetaData_Gitea:
+		meta.Gitea.Link = newLink
 	default:
 		return fmt.Errorf("unsupported metadata type")
 	}
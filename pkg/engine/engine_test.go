@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -216,6 +217,7 @@ func TestSupportsLineNumbers(t *testing.T) {
 		{"Public Git source", sourcespb.SourceType_SOURCE_TYPE_PUBLIC_GIT, true},
 		{"Filesystem source", sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM, true},
 		{"Azure Repos source", sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS, true},
+		{"Gitea source", sourcespb.SourceType_SOURCE_TYPE_GITEA, true},
 		{"Unsupported type", sourcespb.SourceType_SOURCE_TYPE_BUILDKITE, false},
 	}
 
@@ -277,6 +279,100 @@ func TestEngine_DuplicateSecrets(t *testing.T) {
 	assert.Equal(t, want, e.GetMetrics().UnverifiedSecretsFound)
 }
 
+// collectingPrinter is a Printer collecting the results it prints.
+type collectingPrinter struct {
+	mu      sync.Mutex
+	results []*detectors.ResultWithMetadata
+}
+
+func (p *collectingPrinter) Print(_ context.Context, result *detectors.ResultWithMetadata) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.results = append(p.results, result)
+	return nil
+}
+
+func TestEngine_ExtractedTextLineNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		data     string
+		wantLine int64
+	}{
+		{
+			name: "notebook cell",
+			file: "deploy.ipynb",
+			data: `{
+ "cells": [
+  {
+   "cell_type": "code",
+   "metadata": {},
+   "outputs": [],
+   "source": [
+    "import os\n",
+    "\n",
+    "password = \"hunter2\"\n"
+   ]
+  }
+ ],
+ "nbformat": 4
+}`,
+			// The line of the secret in the notebook file, rather than in the source of the cell.
+			wantLine: 9,
+		},
+		{
+			name: "pdf page",
+			file: "deploy.pdf",
+			data: "%PDF-1.4\n" +
+				"1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n" +
+				"2 0 obj\n<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >>\nendobj\n" +
+				"3 0 obj\n<< /Type /Page /Parent 2 0 R /Contents 5 0 R >>\nendobj\n" +
+				"4 0 obj\n<< /Type /Page /Parent 2 0 R /Contents 6 0 R >>\nendobj\n" +
+				"5 0 obj\n<< /Length 44 >>\nstream\nBT (first page) Tj T* (second line) Tj ET\nendstream\nendobj\n" +
+				"6 0 obj\n<< /Length 30 >>\nstream\nBT (password: hunter2) Tj ET\nendstream\nendobj\n" +
+				"trailer\n<< /Root 1 0 R >>\n%%EOF\n",
+			// The line of the secret in the text of the document, after the two lines of the first page.
+			wantLine: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.data), 0o644))
+
+			detector, err := custom_detectors.NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+				Name:     "password",
+				Keywords: []string{"hunter"},
+				Regex:    map[string]string{"password": `hunter[0-9]`},
+			})
+			assert.NoError(t, err)
+
+			printer := new(collectingPrinter)
+			conf := Config{
+				Concurrency:   1,
+				Decoders:      decoders.DefaultDecoders(),
+				Detectors:     []detectors.Detector{detector},
+				SourceManager: sources.NewManager(sources.WithBufferedOutput(64)),
+				Dispatcher:    NewPrinterDispatcher(printer),
+			}
+			e, err := NewEngine(ctx, &conf)
+			assert.NoError(t, err)
+			e.Start(ctx)
+
+			assert.NoError(t, e.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{path}}))
+			assert.NoError(t, e.Finish(ctx))
+
+			if assert.Len(t, printer.results, 1) {
+				assert.Equal(t, tt.wantLine, printer.results[0].SourceMetadata.GetFilesystem().GetLine())
+			}
+		})
+	}
+}
+
 // TestEngine_VersionedDetectorsVerifiedSecrets is a test that detects ALL verified secrets across
 // versioned detectors.
 func TestEngine_VersionedDetectorsVerifiedSecrets(t *testing.T) {
@@ -629,6 +725,21 @@ func TestFragmentFirstLineAndLink(t *testing.T) {
 			expectedLine: 5,
 			expectedLink: "https://example.azure.com",
 		},
+		{
+			name: "Test Gitea Metadata",
+			chunk: &sources.Chunk{
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Gitea{
+						Gitea: &source_metadatapb.Gitea{
+							Line: 5,
+							Link: "https://gitea.example.com/acme/api/src/commit/abc123/main.go#L5",
+						},
+					},
+				},
+			},
+			expectedLine: 5,
+			expectedLink: "https://gitea.example.com/acme/api/src/commit/abc123/main.go#L5",
+		},
 		{
 			name:         "Unsupported Type",
 			chunk:        &sources.Chunk{},
@@ -714,6 +825,17 @@ func TestSetLink(t *testing.T) {
 			line:     3,
 			wantLink: "https://dev.azure.com/example?line=3",
 		},
+		{
+			name: "Gitea link set",
+			input: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gitea{
+					Gitea: &source_metadatapb.Gitea{},
+				},
+			},
+			link:     "https://gitea.example.com/acme/api/src/commit/abc123/main.go#L1",
+			line:     3,
+			wantLink: "https://gitea.example.com/acme/api/src/commit/abc123/main.go#L3",
+		},
 		{
 			name: "Unsupported metadata type",
 			input: &source_metadatapb.MetaData{
@@ -758,6 +880,8 @@ func TestSetLink(t *testing.T) {
 				assert.Equal(t, tt.wantLink, data.Filesystem.Link, "Filesystem link mismatch")
 			case *source_metadatapb.MetaData_AzureRepos:
 				assert.Equal(t, tt.wantLink, data.AzureRepos.Link, "Azure Repos link mismatch")
+			case *source_metadatapb.MetaData_Gitea:
+				assert.Equal(t, tt.wantLink, data.Gitea.Link, "Gitea link mismatch")
 			}
 		})
 	}
@@ -0,0 +1,63 @@
+package engine
+
+import (
+	gogit "github.com/go-git/go-git/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gerrit"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanGerrit scans Gerrit projects and open changes with the provided configuration.
+func (e *Engine) ScanGerrit(ctx context.Context, c sources.GerritConfig) error {
+	logOptions := &gogit.LogOptions{}
+	opts := []git.ScanOption{
+		git.ScanOptionFilter(c.Filter),
+		git.ScanOptionLogOptions(logOptions),
+	}
+	scanOptions := git.NewScanOptions(opts...)
+
+	connection := &sourcespb.Gerrit{
+		Endpoint:        c.Endpoint,
+		Projects:        c.Projects,
+		IncludeChanges:  c.IncludeChanges,
+		IncludeComments: c.IncludeComments,
+		SkipBinaries:    c.SkipBinaries,
+	}
+
+	if len(c.Username) > 0 {
+		connection.Credential = &sourcespb.Gerrit_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	} else {
+		connection.Credential = &sourcespb.Gerrit_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal gerrit connection")
+		return err
+	}
+
+	sourceName := "trufflehog - gerrit"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, gerrit.SourceType)
+
+	gerritSource := &gerrit.Source{}
+	if err := gerritSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	gerritSource.WithScanOptions(scanOptions)
+	_, err = e.sourceManager.Run(ctx, sourceName, gerritSource)
+	return err
+}
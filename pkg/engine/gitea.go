@@ -0,0 +1,60 @@
+package engine
+
+import (
+	gogit "github.com/go-git/go-git/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gitea"
+)
+
+// ScanGitea scans Gitea or Forgejo repositories with the provided configuration.
+func (e *Engine) ScanGitea(ctx context.Context, c sources.GiteaConfig) error {
+	logOptions := &gogit.LogOptions{}
+	opts := []git.ScanOption{
+		git.ScanOptionFilter(c.Filter),
+		git.ScanOptionLogOptions(logOptions),
+	}
+	scanOptions := git.NewScanOptions(opts...)
+
+	connection := &sourcespb.Gitea{
+		Endpoint:                   c.Endpoint,
+		Repositories:               c.Repos,
+		Organizations:              c.Organizations,
+		Users:                      c.Users,
+		IncludeForks:               c.IncludeForks,
+		IncludeRepos:               c.IncludeRepos,
+		IgnoreRepos:                c.ExcludeRepos,
+		IncludeIssueComments:       c.IncludeIssueComments,
+		IncludePullRequestComments: c.IncludePRComments,
+		IncludeWikis:               c.IncludeWikis,
+		SkipBinaries:               c.SkipBinaries,
+	}
+	if len(c.Token) > 0 {
+		connection.Credential = &sourcespb.Gitea_Token{Token: c.Token}
+	} else {
+		connection.Credential = &sourcespb.Gitea_Unauthenticated{}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal gitea connection")
+		return err
+	}
+
+	sourceName := "trufflehog - gitea"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, gitea.SourceType)
+
+	giteaSource := &gitea.Source{}
+	if err := giteaSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	giteaSource.WithScanOptions(scanOptions)
+	_, err = e.sourceManager.Run(ctx, sourceName, giteaSource)
+	return err
+}
@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/googledrive"
+)
+
+// ScanGoogleDrive scans Google Drive with the provided configuration.
+func (e *Engine) ScanGoogleDrive(ctx context.Context, c sources.GoogleDriveConfig) error {
+	connection := &sourcespb.GoogleDrive{
+		Credential:       &sourcespb.GoogleDrive_ServiceAccountFile{ServiceAccountFile: c.ServiceAccount},
+		Users:            c.Users,
+		IncludeRevisions: c.IncludeRevisions,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal google drive connection")
+		return err
+	}
+
+	sourceName := "trufflehog - google drive"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, googledrive.SourceType)
+
+	googleDriveSource := &googledrive.Source{}
+	if err := googleDriveSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, googleDriveSource)
+	return err
+}
@@ -20,9 +20,17 @@ type JenkinsConfig struct {
 	Password              string
 	Header                string
 	InsecureSkipVerifyTLS bool
+	// Home is a local JENKINS_HOME to scan instead of the Endpoint.
+	Home string
+	// IncludeConfigs enables scanning the config.xml of jobs and folders,
+	// and the pipeline scripts of builds.
+	IncludeConfigs bool
+	// IncludeBuildParameters enables scanning the parameters and injected
+	// environment variables of builds.
+	IncludeBuildParameters bool
 }
 
-// ScanJenkins scans Jenkins logs.
+// ScanJenkins scans Jenkins logs, or the files of a local JENKINS_HOME.
 func (e *Engine) ScanJenkins(ctx context.Context, jenkinsConfig JenkinsConfig) error {
 	var connection *sourcespb.Jenkins
 	switch {
@@ -61,6 +69,9 @@ func (e *Engine) ScanJenkins(ctx context.Context, jenkinsConfig JenkinsConfig) e
 
 	connection.Endpoint = jenkinsConfig.Endpoint
 	connection.InsecureSkipVerifyTls = jenkinsConfig.InsecureSkipVerifyTLS
+	connection.Home = jenkinsConfig.Home
+	connection.IncludeConfigs = jenkinsConfig.IncludeConfigs
+	connection.IncludeBuildParameters = jenkinsConfig.IncludeBuildParameters
 
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jira"
+)
+
+// ScanJira scans Jira Cloud or Jira Data Center issues with the provided configuration.
+func (e *Engine) ScanJira(ctx context.Context, c sources.JiraConfig) error {
+	connection := &sourcespb.JIRA{
+		Endpoint:              c.Endpoint,
+		Jql:                   c.JQL,
+		Projects:              c.Projects,
+		IgnoreProjects:        c.IgnoreProjects,
+		SkipAttachments:       c.SkipAttachments,
+		MaxAttachmentSize:     c.MaxAttachmentSize,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.JIRA_Token{
+			Token: c.Token,
+		}
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.JIRA_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	default:
+		connection.Credential = &sourcespb.JIRA_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal jira connection")
+		return err
+	}
+
+	sourceName := "trufflehog - jira"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, jira.SourceType)
+
+	jiraSource := &jira.Source{}
+	if err := jiraSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, jiraSource)
+	return err
+}
@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/kubernetes"
+)
+
+// ScanKubernetes scans the ConfigMaps, workloads and Secrets of a Kubernetes
+// cluster.
+func (e *Engine) ScanKubernetes(ctx context.Context, c sources.KubernetesConfig) error {
+	connection := &sourcespb.Kubernetes{
+		Context:           c.Context,
+		Namespaces:        c.Namespaces,
+		ExcludeNamespaces: c.ExcludeNamespaces,
+		IncludeSecrets:    c.IncludeSecrets,
+	}
+	if c.InCluster {
+		connection.Credential = &sourcespb.Kubernetes_InCluster{InCluster: &credentialspb.CloudEnvironment{}}
+	} else {
+		// Like kubectl, only the first file of a KUBECONFIG list is used.
+		path := ""
+		if paths := filepath.SplitList(c.Kubeconfig); len(paths) > 0 {
+			path = paths[0]
+		}
+		if path == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return err
+			}
+			path = filepath.Join(home, ".kube", "config")
+		}
+		connection.Credential = &sourcespb.Kubernetes_KubeconfigFile{KubeconfigFile: path}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal kubernetes connection")
+		return err
+	}
+
+	sourceName := "trufflehog - kubernetes"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, kubernetes.SourceType)
+
+	kubernetesSource := &kubernetes.Source{}
+	if err := kubernetesSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, kubernetesSource)
+	return err
+}
@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/npm"
+)
+
+// ScanNPM scans the published versions of npm packages with the provided configuration.
+func (e *Engine) ScanNPM(ctx context.Context, c sources.NPMConfig) error {
+	connection := &sourcespb.NPMUnauthenticatedPackage{
+		Credential:    &sourcespb.NPMUnauthenticatedPackage_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Registry:      c.Registry,
+		Packages:      c.Packages,
+		Maintainers:   c.Maintainers,
+		Organizations: c.Organizations,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal npm connection")
+		return err
+	}
+
+	sourceName := "trufflehog - npm"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, npm.SourceType)
+
+	npmSource := &npm.Source{}
+	if err := npmSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, npmSource)
+	return err
+}
@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/pypi"
+)
+
+// ScanPyPI scans the published versions of PyPI packages with the provided configuration.
+func (e *Engine) ScanPyPI(ctx context.Context, c sources.PyPIConfig) error {
+	connection := &sourcespb.PyPIUnauthenticatedPackage{
+		Credential:    &sourcespb.PyPIUnauthenticatedPackage_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Registry:      c.Registry,
+		Packages:      c.Packages,
+		Maintainers:   c.Maintainers,
+		Organizations: c.Organizations,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal pypi connection")
+		return err
+	}
+
+	sourceName := "trufflehog - pypi"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, pypi.SourceType)
+
+	pypiSource := &pypi.Source{}
+	if err := pypiSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, pypiSource)
+	return err
+}
@@ -17,7 +17,11 @@ import (
 // ScanS3 scans S3 buckets.
 func (e *Engine) ScanS3(ctx context.Context, c sources.S3Config) error {
 	connection := &sourcespb.S3{
-		Credential: &sourcespb.S3_Unauthenticated{},
+		Credential:            &sourcespb.S3_Unauthenticated{},
+		Endpoint:              c.Endpoint,
+		Region:                c.Region,
+		ForcePathStyle:        c.ForcePathStyle,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
 	}
 	if c.CloudCred {
 		if len(c.Key) > 0 || len(c.Secret) > 0 || len(c.SessionToken) > 0 {
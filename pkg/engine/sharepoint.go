@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sharepoint"
+)
+
+// ScanSharePoint scans SharePoint document libraries, and optionally OneDrive, with the provided configuration.
+func (e *Engine) ScanSharePoint(ctx context.Context, c sources.SharePointConfig) error {
+	connection := &sourcespb.Sharepoint{
+		SiteUrl:         c.SiteURL,
+		IncludeOnedrive: c.IncludeOneDrive,
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Sharepoint_Token{Token: c.Token}
+	case len(c.TenantID) > 0 && len(c.ClientID) > 0 && len(c.ClientSecret) > 0:
+		connection.Credential = &sourcespb.Sharepoint_Authenticated{
+			Authenticated: &credentialspb.ClientCredentials{
+				TenantId:     c.TenantID,
+				ClientId:     c.ClientID,
+				ClientSecret: c.ClientSecret,
+			},
+		}
+	default:
+		return fmt.Errorf("must provide a tenant ID, client ID and client secret, or a token")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal sharepoint connection")
+		return err
+	}
+
+	sourceName := "trufflehog - sharepoint"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, sharepoint.SourceType)
+
+	sharepointSource := &sharepoint.Source{}
+	if err := sharepointSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, sharepointSource)
+	return err
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
+)
+
+// ScanSlack scans the history of a Slack workspace, or its new messages in real-time mode, with the provided configuration.
+func (e *Engine) ScanSlack(ctx context.Context, c sources.SlackConfig) error {
+	if len(c.Token) == 0 {
+		return fmt.Errorf("must provide a Slack token")
+	}
+	if c.Realtime {
+		return e.scanSlackRealtime(ctx, c)
+	}
+
+	connection := &sourcespb.Slack{
+		Endpoint:   c.Endpoint,
+		Credential: &sourcespb.Slack_Token{Token: c.Token},
+		Channels:   c.Channels,
+		IgnoreList: c.IgnoreChannels,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal slack connection")
+		return err
+	}
+
+	sourceName := "trufflehog - slack"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, slack.SourceType)
+
+	slackSource := &slack.Source{}
+	if err := slackSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, slackSource)
+	return err
+}
+
+func (e *Engine) scanSlackRealtime(ctx context.Context, c sources.SlackConfig) error {
+	if len(c.AppToken) == 0 {
+		return fmt.Errorf("must provide a Slack app-level token in real-time mode")
+	}
+
+	connection := &sourcespb.SlackRealtime{
+		Credential: &sourcespb.SlackRealtime_Tokens{
+			Tokens: &credentialspb.SlackTokens{
+				AppToken: c.AppToken,
+				BotToken: c.Token,
+			},
+		},
+		Channels:   c.Channels,
+		IgnoreList: c.IgnoreChannels,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal slack realtime connection")
+		return err
+	}
+
+	sourceName := "trufflehog - slack realtime"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, slack.RealtimeSourceType)
+
+	slackSource := &slack.RealtimeSource{}
+	if err := slackSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, c.Concurrency); err != nil {
+		return err
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, slackSource)
+	return err
+}
@@ -0,0 +1,33 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/stdin"
+)
+
+// ScanStdin scans the data piped to the standard input.
+func (e *Engine) ScanStdin(ctx context.Context, c sources.StdinConfig) error {
+	connection := &sourcespb.Stdin{Name: c.Name}
+
+	var conn anypb.Any
+	if err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{}); err != nil {
+		return fmt.Errorf("failed to marshal stdin connection: %w", err)
+	}
+
+	sourceName := "trufflehog - stdin"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, stdin.SourceType)
+
+	stdinSource := &stdin.Source{}
+	if err := stdinSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, 1); err != nil {
+		return err
+	}
+	_, err := e.sourceManager.Run(ctx, sourceName, stdinSource)
+	return err
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
+)
+
+// ScanWebhook scans the payloads posted to an HTTP server until the context is cancelled.
+func (e *Engine) ScanWebhook(ctx context.Context, c sources.WebhookConfig) error {
+	connection := &sourcespb.Webhook{
+		ListenAddress: c.ListenAddress,
+		SyncResponse:  c.SyncResponse,
+		MaxBodySize:   c.MaxBodySize,
+	}
+	switch {
+	case c.HeaderValue != "" && c.HMACSecret != "":
+		return fmt.Errorf("cannot use a shared secret header and an HMAC secret together")
+	case c.HeaderValue != "":
+		connection.Credential = &sourcespb.Webhook_Header{
+			Header: &credentialspb.Header{Key: c.HeaderName, Value: c.HeaderValue},
+		}
+	case c.HMACSecret != "":
+		connection.Credential = &sourcespb.Webhook_HmacSecret{HmacSecret: c.HMACSecret}
+	default:
+		return fmt.Errorf("must provide a shared secret or an HMAC secret to authenticate requests")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal webhook connection")
+		return err
+	}
+
+	sourceName := "trufflehog - webhook"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, webhook.SourceType)
+
+	webhookSource := &webhook.Source{}
+	if err := webhookSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, 1); err != nil {
+		return err
+	}
+	if c.SyncResponse {
+		dispatcher, ok := e.dispatcher.(*webhook.Dispatcher)
+		if !ok {
+			return fmt.Errorf("responding synchronously requires the engine to dispatch results with a webhook.Dispatcher")
+		}
+		webhookSource.SetDispatcher(dispatcher)
+	}
+	_, err = e.sourceManager.Run(ctx, sourceName, webhookSource)
+	return err
+}
@@ -0,0 +1,691 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+var (
+	errDexFormat = errors.New("invalid DEX file")
+	errResFormat = errors.New("invalid Android resource file")
+
+	dexMagic = []byte("dex\n")
+	// axmlMagic is the header of a binary XML document followed by the header of its string pool.
+	axmlMagic = []byte{0x03, 0x00, 0x08, 0x00}
+	// arscMagic is the header of a resource table, whose string pool follows the package count.
+	arscMagic = []byte{0x02, 0x00, 0x0c, 0x00}
+	// resStringPoolMagic is the header of a string pool.
+	resStringPoolMagic = []byte{0x01, 0x00, 0x1c, 0x00}
+)
+
+func init() {
+	// Android build outputs have no MIME type of their own, so they are detected by their headers.
+	root := mimetype.Lookup("application/octet-stream")
+	root.Extend(isDex, string(dexMime), ".dex")
+	root.Extend(isAXML, string(axmlMime), ".xml")
+	root.Extend(isARSC, string(arscMime), ".arsc")
+}
+
+// isDex reports whether raw starts like a Dalvik executable, such as "dex\n035\0".
+func isDex(raw []byte, _ uint32) bool {
+	return len(raw) >= 8 && bytes.HasPrefix(raw, dexMagic) && raw[7] == 0
+}
+
+// isAXML reports whether raw starts like a binary XML document, such as a compiled AndroidManifest.xml.
+func isAXML(raw []byte, _ uint32) bool {
+	return len(raw) >= 12 && bytes.HasPrefix(raw, axmlMagic) && bytes.Equal(raw[8:12], resStringPoolMagic)
+}
+
+// isARSC reports whether raw starts like a resource table, such as resources.arsc.
+func isARSC(raw []byte, _ uint32) bool {
+	return len(raw) >= 16 && bytes.HasPrefix(raw, arscMagic) && bytes.Equal(raw[12:16], resStringPoolMagic)
+}
+
+// androidHandler handles the compiled files of Android packages: Dalvik executables (classes.dex), binary XML
+// documents (AndroidManifest.xml and layouts) and resource tables (resources.arsc). Their strings are stored in
+// tables, prefixed with their length, so they are read from them and sent as text.
+type androidHandler struct{ *defaultHandler }
+
+// newAndroidHandler creates an androidHandler.
+func newAndroidHandler() *androidHandler {
+	return &androidHandler{defaultHandler: newDefaultHandler(androidHandlerType)}
+}
+
+// HandleFile processes DEX, binary XML and resource table files, sending their strings.
+func (h *androidHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the file processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading Android file")
+			}
+		}()
+
+		if err = h.processFile(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing Android file")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+func (h *androidHandler) processFile(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	data, err := io.ReadAll(io.LimitReader(input, int64(maxSize)))
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+	h.metrics.observeFileSize(int64(len(data)))
+
+	var text string
+	switch mimeT := mimeType(input.mime.String()); mimeT {
+	case dexMime:
+		text, err = dexStrings(data)
+	case axmlMime:
+		text, err = decodeAXML(data)
+	case arscMime:
+		text, err = arscStrings(data)
+	default:
+		return fmt.Errorf("unexpected MIME type %s", mimeT)
+	}
+	if err != nil {
+		return fmt.Errorf("error parsing file: %w", err)
+	}
+	return h.handleText(ctx, text, dataChan)
+}
+
+// dexFile reads the little-endian values of DEX files. Reads out of the data return zero values and set err.
+type dexFile struct {
+	data []byte
+	err  error
+}
+
+func (d *dexFile) bytes(off, n int) []byte {
+	if d.err != nil || off < 0 || n < 0 || off+n > len(d.data) {
+		if d.err == nil {
+			d.err = fmt.Errorf("%w: offset %d out of file", errDexFormat, off)
+		}
+		return nil
+	}
+	return d.data[off : off+n]
+}
+
+func (d *dexFile) u32(off int) int {
+	if b := d.bytes(off, 4); b != nil {
+		return int(binary.LittleEndian.Uint32(b))
+	}
+	return 0
+}
+
+// uleb128 reads an unsigned LEB128 value at off, and returns it with the offset that follows it.
+func (d *dexFile) uleb128(off int) (int, int) {
+	v := 0
+	for i := 0; i < 5; i++ {
+		b := d.bytes(off+i, 1)
+		if b == nil {
+			return 0, off
+		}
+		v |= int(b[0]&0x7f) << (7 * i)
+		if b[0]&0x80 == 0 {
+			return v, off + i + 1
+		}
+	}
+	d.err = fmt.Errorf("%w: bad LEB128 value at offset %d", errDexFormat, off)
+	return 0, off
+}
+
+// table returns the offset of a table of the header, and its number of entries, checking that it is in the file.
+func (d *dexFile) table(headerOff, entrySize int) (int, int) {
+	size, off := d.u32(headerOff), d.u32(headerOff+4)
+	if size > len(d.data)/entrySize {
+		d.err = fmt.Errorf("%w: table at offset %d out of file", errDexFormat, headerOff)
+		return 0, 0
+	}
+	d.bytes(off, size*entrySize)
+	return off, size
+}
+
+// dexStrings returns the strings of a DEX file (https://source.android.com/docs/core/runtime/dex-format), one per
+// line. The values of static string fields come first, as name=value, followed by the other strings. The names of
+// types, fields and methods are left out.
+func dexStrings(data []byte) (string, error) {
+	d := &dexFile{data: data}
+	if len(data) < 0x70 || !isDex(data, 0) {
+		return "", fmt.Errorf("%w: bad header", errDexFormat)
+	}
+
+	stringsOff, stringCount := d.table(0x38, 4)
+	typesOff, typeCount := d.table(0x40, 4)
+	protosOff, protoCount := d.table(0x48, 12)
+	fieldsOff, fieldCount := d.table(0x50, 8)
+	methodsOff, methodCount := d.table(0x58, 8)
+	classesOff, classCount := d.table(0x60, 32)
+	if d.err != nil {
+		return "", d.err
+	}
+
+	str := func(i int) (string, bool) {
+		if i < 0 || i >= stringCount {
+			return "", false
+		}
+		// A string is its length in UTF-16 code units, in LEB128, followed by its NUL-terminated modified UTF-8
+		// bytes.
+		off := int(binary.LittleEndian.Uint32(data[stringsOff+4*i:]))
+		for off < len(data) && data[off]&0x80 != 0 {
+			off++
+		}
+		off++
+		if off >= len(data) {
+			return "", false
+		}
+		end := bytes.IndexByte(data[off:], 0)
+		if end < 0 {
+			return "", false
+		}
+		return decodeMUTF8(data[off : off+end]), true
+	}
+
+	identifiers := make([]bool, stringCount)
+	markIdentifier := func(i int) {
+		if i >= 0 && i < stringCount {
+			identifiers[i] = true
+		}
+	}
+	for i := 0; i < typeCount; i++ {
+		markIdentifier(d.u32(typesOff + 4*i))
+	}
+	for i := 0; i < protoCount; i++ {
+		markIdentifier(d.u32(protosOff + 12*i))
+	}
+	for i := 0; i < fieldCount; i++ {
+		markIdentifier(d.u32(fieldsOff + 8*i + 4))
+	}
+	for i := 0; i < methodCount; i++ {
+		markIdentifier(d.u32(methodsOff + 8*i + 4))
+	}
+	for i := 0; i < classCount; i++ {
+		markIdentifier(d.u32(classesOff + 32*i + 16)) // The source file.
+	}
+
+	var out strings.Builder
+	sent := make([]bool, stringCount)
+	for i := 0; i < classCount && d.err == nil; i++ {
+		classDataOff := d.u32(classesOff + 32*i + 24)
+		staticValuesOff := d.u32(classesOff + 32*i + 28)
+		if classDataOff == 0 || staticValuesOff == 0 {
+			continue
+		}
+
+		// The static values are the initial values of the first static fields of the class data.
+		staticCount, off := d.uleb128(classDataOff)
+		for range 3 {
+			_, off = d.uleb128(off) // The counts of instance fields, direct and virtual methods.
+		}
+		fields := make([]int, 0, min(staticCount, fieldCount))
+		fieldIdx := 0
+		for j := 0; j < staticCount && d.err == nil; j++ {
+			var diff int
+			diff, off = d.uleb128(off)
+			_, off = d.uleb128(off) // The access flags.
+			fieldIdx += diff
+			fields = append(fields, fieldIdx)
+		}
+
+		valueCount, off := d.uleb128(staticValuesOff)
+		for j := 0; j < valueCount && d.err == nil; j++ {
+			header := d.bytes(off, 1)
+			if header == nil {
+				break
+			}
+			// Strings are indexes into the string table, of as many bytes as the argument plus one.
+			valueType, valueArg := header[0]&0x1f, int(header[0]>>5)
+			if valueType == 0x17 && j < len(fields) && fields[j] < fieldCount {
+				index := 0
+				for k, b := range d.bytes(off+1, valueArg+1) {
+					index |= int(b) << (8 * k)
+				}
+				name, _ := str(d.u32(fieldsOff + 8*fields[j] + 4))
+				if value, ok := str(index); ok {
+					fmt.Fprintf(&out, "%s=%s\n", name, value)
+					sent[index] = true
+				}
+			}
+			off = d.skipValue(off, 0)
+		}
+	}
+	// The static values of damaged files are left out from the first bad one, but their strings are still sent.
+
+	for i := 0; i < stringCount; i++ {
+		if identifiers[i] || sent[i] {
+			continue
+		}
+		if value, ok := str(i); ok && value != "" {
+			out.WriteString(value)
+			out.WriteByte('\n')
+		}
+	}
+	return out.String(), nil
+}
+
+// dexMaxValueDepth limits the nesting of arrays and annotations in encoded values, which may be deep in malicious
+// files.
+const dexMaxValueDepth = 16
+
+// skipValue returns the offset that follows the encoded value at off.
+func (d *dexFile) skipValue(off, depth int) int {
+	header := d.bytes(off, 1)
+	if header == nil {
+		return off
+	}
+	if depth > dexMaxValueDepth {
+		d.err = fmt.Errorf("%w: encoded value nested too deep", errDexFormat)
+		return off
+	}
+	off++
+
+	switch valueType, valueArg := header[0]&0x1f, int(header[0]>>5); valueType {
+	case 0x1c: // An array.
+		var count int
+		count, off = d.uleb128(off)
+		for i := 0; i < count && d.err == nil; i++ {
+			off = d.skipValue(off, depth+1)
+		}
+		return off
+	case 0x1d: // An annotation, which is a type followed by name-value pairs.
+		var count int
+		_, off = d.uleb128(off)
+		count, off = d.uleb128(off)
+		for i := 0; i < count && d.err == nil; i++ {
+			_, off = d.uleb128(off)
+			off = d.skipValue(off, depth+1)
+		}
+		return off
+	case 0x1e, 0x1f: // Null and booleans, whose value is the argument.
+		return off
+	default:
+		return off + valueArg + 1
+	}
+}
+
+// resNoIndex marks the absence of a string or an entry in binary XML documents and resource tables.
+const resNoIndex = 0xffffffff
+
+// Types of the chunks of binary XML documents and resource tables.
+const (
+	resStringPoolType   = 0x0001
+	resTableType        = 0x0002
+	resXMLType          = 0x0003
+	resXMLStartNSType   = 0x0100
+	resXMLStartElemType = 0x0102
+	resXMLCDataType     = 0x0104
+	resTablePackageType = 0x0200
+	resTableTypeType    = 0x0201
+)
+
+// Types of resource values.
+const (
+	resValueReference = 0x01
+	resValueAttribute = 0x02
+	resValueString    = 0x03
+	resValueFloat     = 0x04
+	resValueIntDec    = 0x10
+	resValueIntHex    = 0x11
+	resValueBoolean   = 0x12
+)
+
+// resChunk is a chunk of a binary XML document or a resource table, which starts with its type and the sizes of
+// its header and of the whole chunk.
+type resChunk struct {
+	typ        int
+	headerSize int
+	data       []byte
+}
+
+// resChunks splits data into chunks.
+func resChunks(data []byte) ([]resChunk, error) {
+	var chunks []resChunk
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return chunks, fmt.Errorf("%w: truncated chunk header", errResFormat)
+		}
+		headerSize := int(binary.LittleEndian.Uint16(data[2:]))
+		size := int(binary.LittleEndian.Uint32(data[4:]))
+		if headerSize < 8 || size < headerSize || size > len(data) {
+			return chunks, fmt.Errorf("%w: bad chunk size", errResFormat)
+		}
+		chunks = append(chunks, resChunk{
+			typ:        int(binary.LittleEndian.Uint16(data)),
+			headerSize: headerSize,
+			data:       data[:size],
+		})
+		data = data[size:]
+	}
+	return chunks, nil
+}
+
+// u32 reads a value of the chunk, or returns resNoIndex if it is out of the chunk.
+func (c resChunk) u32(off int) int {
+	if off < 0 || off+4 > len(c.data) {
+		return resNoIndex
+	}
+	return int(binary.LittleEndian.Uint32(c.data[off:]))
+}
+
+func (c resChunk) u16(off int) int {
+	if off < 0 || off+2 > len(c.data) {
+		return 0
+	}
+	return int(binary.LittleEndian.Uint16(c.data[off:]))
+}
+
+func (c resChunk) u8(off int) int {
+	if off < 0 || off >= len(c.data) {
+		return 0
+	}
+	return int(c.data[off])
+}
+
+// body returns the chunks that follow the header of the chunk.
+func (c resChunk) body() ([]resChunk, error) {
+	return resChunks(c.data[c.headerSize:])
+}
+
+// resStrings is a string pool, whose strings are decoded when they are read.
+type resStrings struct {
+	chunk   resChunk
+	count   int
+	utf8    bool
+	strings int
+}
+
+// newResStrings reads the header of a string pool.
+func newResStrings(chunk resChunk) (*resStrings, error) {
+	if chunk.typ != resStringPoolType || len(chunk.data) < 28 {
+		return nil, fmt.Errorf("%w: bad string pool", errResFormat)
+	}
+	count := chunk.u32(8)
+	if count > (len(chunk.data)-chunk.headerSize)/4 {
+		return nil, fmt.Errorf("%w: bad string count", errResFormat)
+	}
+	return &resStrings{
+		chunk:   chunk,
+		count:   count,
+		utf8:    chunk.u32(16)&(1<<8) != 0,
+		strings: chunk.u32(20),
+	}, nil
+}
+
+// get returns a string of the pool, which is empty if the index is out of the pool.
+func (p *resStrings) get(i int) string {
+	if p == nil || i < 0 || i >= p.count {
+		return ""
+	}
+	c := p.chunk
+	off := p.strings + c.u32(c.headerSize+4*i)
+
+	if p.utf8 {
+		// Lengths of UTF-8 strings take one byte, or two if the high bit of the first one is set.
+		readLen := func() int {
+			n := c.u8(off)
+			off++
+			if n&0x80 != 0 {
+				n = (n&0x7f)<<8 | c.u8(off)
+				off++
+			}
+			return n
+		}
+		readLen() // The length in UTF-16 code units precedes the length in bytes.
+		n := readLen()
+		if off+n > len(c.data) {
+			return ""
+		}
+		return string(c.data[off : off+n])
+	}
+
+	n := c.u16(off)
+	off += 2
+	if n&0x8000 != 0 {
+		n = (n&0x7fff)<<16 | c.u16(off)
+		off += 2
+	}
+	if n < 0 || off+2*n > len(c.data) {
+		return ""
+	}
+	units := make([]uint16, n)
+	for j := range units {
+		units[j] = uint16(c.u16(off + 2*j))
+	}
+	return string(utf16.Decode(units))
+}
+
+// decodeAXML returns the elements of a binary XML document, one per line with their attributes, such as
+// `<meta-data android:name="key" android:value="value">`, and the text between them.
+func decodeAXML(data []byte) (string, error) {
+	chunks, err := resChunks(data)
+	if err != nil {
+		return "", err
+	}
+	if len(chunks) != 1 || chunks[0].typ != resXMLType {
+		return "", fmt.Errorf("%w: not a binary XML document", errResFormat)
+	}
+	// The chunks of truncated documents are decoded until the first bad one.
+	nodes, err := chunks[0].body()
+
+	var (
+		out      strings.Builder
+		pool     *resStrings
+		prefixes = make(map[int]string)
+	)
+	for _, node := range nodes {
+		// The nodes of the tree have a header with their line number and comment, followed by their content.
+		ext := node.headerSize
+		switch node.typ {
+		case resStringPoolType:
+			if pool, err = newResStrings(node); err != nil {
+				return "", err
+			}
+		case resXMLStartNSType:
+			prefixes[node.u32(ext+4)] = pool.get(node.u32(ext))
+		case resXMLStartElemType:
+			out.WriteByte('<')
+			out.WriteString(pool.get(node.u32(ext + 4)))
+			attrStart, attrSize, attrCount := node.u16(ext+8), node.u16(ext+10), node.u16(ext+12)
+			for i := 0; i < attrCount; i++ {
+				attr := ext + attrStart + i*attrSize
+				name := pool.get(node.u32(attr + 4))
+				if prefix := prefixes[node.u32(attr)]; prefix != "" {
+					name = prefix + ":" + name
+				}
+				// Attributes keep their raw string, if any, along with their typed value.
+				value := pool.get(node.u32(attr + 8))
+				if node.u32(attr+8) == resNoIndex {
+					value = formatResValue(pool, node.u8(attr+15), uint32(node.u32(attr+16)))
+				}
+				fmt.Fprintf(&out, " %s=\"%s\"", name, value)
+			}
+			out.WriteString(">\n")
+		case resXMLCDataType:
+			out.WriteString(pool.get(node.u32(ext)))
+			out.WriteByte('\n')
+		}
+	}
+	return out.String(), err
+}
+
+// formatResValue formats a typed value of a resource.
+func formatResValue(pool *resStrings, dataType int, data uint32) string {
+	switch dataType {
+	case resValueString:
+		return pool.get(int(data))
+	case resValueReference:
+		return fmt.Sprintf("@0x%08x", data)
+	case resValueAttribute:
+		return fmt.Sprintf("?0x%08x", data)
+	case resValueFloat:
+		return strconv.FormatFloat(float64(math.Float32frombits(data)), 'g', -1, 32)
+	case resValueIntDec:
+		return strconv.Itoa(int(int32(data)))
+	case resValueBoolean:
+		return strconv.FormatBool(data != 0)
+	default:
+		return fmt.Sprintf("0x%x", data)
+	}
+}
+
+// Flags of the types and entries of resource tables.
+const (
+	resTypeSparse      = 0x01
+	resTypeOffset16    = 0x02
+	resEntryComplex    = 0x0001
+	resEntryCompact    = 0x0008
+	resNoEntryOffset16 = 0xffff
+)
+
+// arscStrings returns the string resources of a resource table, one per line, such as "string/api_key=value",
+// followed by the other strings of the table.
+func arscStrings(data []byte) (string, error) {
+	chunks, err := resChunks(data)
+	if err != nil {
+		return "", err
+	}
+	if len(chunks) != 1 || chunks[0].typ != resTableType {
+		return "", fmt.Errorf("%w: not a resource table", errResFormat)
+	}
+	tableChunks, err := chunks[0].body()
+
+	var (
+		out    strings.Builder
+		values *resStrings
+		sent   = make(map[int]bool)
+		lines  = make(map[string]bool)
+	)
+	for _, chunk := range tableChunks {
+		switch chunk.typ {
+		case resStringPoolType:
+			if values, err = newResStrings(chunk); err != nil {
+				return "", err
+			}
+		case resTablePackageType:
+			// The names of the types and of the keys of a package are string pools at offsets of its header.
+			typeNames, _ := resStringsAt(chunk, chunk.u32(268))
+			keyNames, _ := resStringsAt(chunk, chunk.u32(276))
+			packageChunks, _ := chunk.body()
+			for _, typ := range packageChunks {
+				if typ.typ != resTableTypeType {
+					continue
+				}
+				typeName := typeNames.get(typ.u8(8) - 1)
+				for _, entry := range resTypeEntries(typ) {
+					key, dataType, data := resTypeEntry(typ, entry)
+					if dataType != resValueString {
+						continue
+					}
+					line := fmt.Sprintf("%s/%s=%s\n", typeName, keyNames.get(key), values.get(data))
+					if !lines[line] {
+						out.WriteString(line)
+						lines[line] = true
+					}
+					sent[data] = true
+				}
+			}
+		}
+	}
+
+	for i := 0; values != nil && i < values.count; i++ {
+		if value := values.get(i); !sent[i] && value != "" {
+			out.WriteString(value)
+			out.WriteByte('\n')
+		}
+	}
+	return out.String(), err
+}
+
+// resStringsAt reads the string pool at an offset of a chunk.
+func resStringsAt(chunk resChunk, off int) (*resStrings, error) {
+	if off <= 0 || off >= len(chunk.data) {
+		return nil, fmt.Errorf("%w: bad string pool offset", errResFormat)
+	}
+	pools, err := resChunks(chunk.data[off:])
+	if len(pools) == 0 {
+		return nil, err
+	}
+	return newResStrings(pools[0])
+}
+
+// resTypeEntries returns the offsets of the entries of a type chunk in the chunk.
+func resTypeEntries(typ resChunk) []int {
+	flags, count, entriesStart := typ.u8(9), typ.u32(12), typ.u32(16)
+	if count > len(typ.data)/2 {
+		return nil
+	}
+
+	var entries []int
+	for i := 0; i < count; i++ {
+		var off int
+		switch {
+		case flags&resTypeSparse != 0:
+			// Sparse entries are pairs of indexes and offsets divided by 4.
+			off = 4 * typ.u16(typ.headerSize+4*i+2)
+		case flags&resTypeOffset16 != 0:
+			off = typ.u16(typ.headerSize + 2*i)
+			if off == resNoEntryOffset16 {
+				continue
+			}
+			off *= 4
+		default:
+			off = typ.u32(typ.headerSize + 4*i)
+			if off == resNoIndex {
+				continue
+			}
+		}
+		entries = append(entries, entriesStart+off)
+	}
+	return entries
+}
+
+// resTypeEntry returns the key of the entry at off of a type chunk, and the type and data of its value. Complex
+// entries, such as arrays and styles, have no value.
+func resTypeEntry(typ resChunk, off int) (key, dataType, data int) {
+	size, flags := typ.u16(off), typ.u16(off+2)
+	switch {
+	case flags&resEntryCompact != 0:
+		// Compact entries store the key in place of the size, and the type of the value in the flags.
+		return size, flags >> 8, typ.u32(off + 4)
+	case flags&resEntryComplex != 0:
+		return -1, 0, 0
+	default:
+		value := off + size
+		return typ.u32(off + 4), typ.u8(value + 3), typ.u32(value + 4)
+	}
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleAndroidFile(t *testing.T) {
+	tests := map[string]struct {
+		file string
+		mime mimeType
+		want string
+	}{
+		"class": {
+			file: "testdata/Config.class",
+			mime: classMime,
+			want: "API_KEY=AKIAEXAMPLEKEY\npassword=hunter2 zoë\n",
+		},
+		"dex": {
+			file: "testdata/classes.dex",
+			mime: dexMime,
+			want: "MAPS_KEY=AIzaEXAMPLEKEY\ntoken=ghp_examplevalue\n",
+		},
+		"binary xml": {
+			file: "testdata/AndroidManifest.xml",
+			mime: axmlMime,
+			want: "<manifest package=\"com.example.app\" android:versionCode=\"7\">\n" +
+				"<meta-data android:name=\"com.google.android.geo.API_KEY\" android:value=\"AIzaEXAMPLEKEY\">\n",
+		},
+		"resource table": {
+			file: "testdata/resources.arsc",
+			mime: arscMime,
+			want: "string/stripe_key=sk_live_examplevalue\nstring/app_name=Example App\nlayout/main=res/layout/main.xml\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := readTestFile(t, tt.file)
+
+			rdr, err := newFileReader(bytes.NewReader(data))
+			require.NoError(t, err)
+			defer rdr.Close()
+			assert.Equal(t, tt.mime, mimeType(rdr.mime.String()))
+
+			assert.Equal(t, []string{tt.want}, handleTestFile(t, data))
+		})
+	}
+}
+
+func TestHandleAndroidFile_APK(t *testing.T) {
+	// The files of packages are handled with their own handlers.
+	got := strings.Join(handleTestFile(t, readTestFile(t, "testdata/test.apk")), "")
+	assert.Contains(t, got, "android:value=\"AIzaEXAMPLEKEY\"")
+	assert.Contains(t, got, "MAPS_KEY=AIzaEXAMPLEKEY\n")
+	assert.Contains(t, got, "string/stripe_key=sk_live_examplevalue\n")
+	assert.Contains(t, got, "API_KEY=AKIAEXAMPLEKEY\n")
+}
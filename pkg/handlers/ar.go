@@ -21,8 +21,8 @@ func newARHandler() *arHandler {
 
 // HandleFile processes AR formatted files. This function needs to be implemented to extract or
 // manage data from AR files according to specific requirements.
-func (h *arHandler) HandleFile(ctx logContext.Context, input fileReader) (chan []byte, error) {
-	archiveChan := make(chan []byte, defaultBufferSize)
+func (h *arHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	archiveChan := make(chan chunkData, defaultBufferSize)
 
 	go func() {
 		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
@@ -65,7 +65,7 @@ func (h *arHandler) HandleFile(ctx logContext.Context, input fileReader) (chan [
 	return archiveChan, nil
 }
 
-func (h *arHandler) processARFiles(ctx logContext.Context, reader *deb.Ar, archiveChan chan []byte) error {
+func (h *arHandler) processARFiles(ctx logContext.Context, reader *deb.Ar, archiveChan chan chunkData) error {
 	for {
 		select {
 		case <-ctx.Done():
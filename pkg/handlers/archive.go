@@ -15,8 +15,9 @@ import (
 type ctxKey int
 
 const (
-	depthKey          ctxKey = iota
-	defaultBufferSize        = 512
+	depthKey ctxKey = iota
+	memoryOnlyKey
+	defaultBufferSize = 512
 )
 
 var (
@@ -47,8 +48,8 @@ func newArchiveHandler() *archiveHandler {
 // utilizing a single output channel. It first tries to identify the input as an archive. If it is an archive,
 // it processes it accordingly; otherwise, it handles the input as non-archive content.
 // The function returns a channel that will receive the extracted data bytes and an error if the initial setup fails.
-func (h *archiveHandler) HandleFile(ctx logContext.Context, input fileReader) (chan []byte, error) {
-	dataChan := make(chan []byte, defaultBufferSize)
+func (h *archiveHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
 
 	go func() {
 		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
@@ -77,7 +78,7 @@ var ErrMaxDepthReached = errors.New("max archive depth reached")
 // It takes a reader from which it attempts to identify and process the archive format. Depending on the archive type,
 // it either decompresses or extracts the contents directly, sending data to the provided channel.
 // Returns an error if the archive cannot be processed due to issues like exceeding maximum depth or unsupported formats.
-func (h *archiveHandler) openArchive(ctx logContext.Context, depth int, reader fileReader, archiveChan chan []byte) error {
+func (h *archiveHandler) openArchive(ctx logContext.Context, depth int, reader fileReader, archiveChan chan chunkData) error {
 	if common.IsDone(ctx) {
 		return ctx.Err()
 	}
@@ -89,6 +90,10 @@ func (h *archiveHandler) openArchive(ctx logContext.Context, depth int, reader f
 
 	if reader.format == nil {
 		if depth > 0 {
+			// Files that have a handler of their own, such as the classes of a JAR, are handled with it.
+			if handler := selectHandler(mimeType(reader.mime.String()), false); !isDefaultHandler(handler) {
+				return h.forwardHandledFile(ctx, handler, reader, archiveChan)
+			}
 			return h.handleNonArchiveContent(ctx, newMimeTypeReaderFromFileReader(reader), archiveChan)
 		}
 		return fmt.Errorf("unknown archive format")
@@ -103,7 +108,7 @@ func (h *archiveHandler) openArchive(ctx logContext.Context, depth int, reader f
 		}
 		defer compReader.Close()
 
-		rdr, err := newFileReader(compReader)
+		rdr, err := newContextFileReader(ctx, compReader)
 		if err != nil {
 			if errors.Is(err, ErrEmptyReader) {
 				ctx.Logger().V(5).Info("empty reader, skipping file")
@@ -129,7 +134,7 @@ func (h *archiveHandler) openArchive(ctx logContext.Context, depth int, reader f
 // It logs the extraction, checks for cancellation, and decides whether to skip the file based on its name or type,
 // particularly for binary files if configured to skip. If the file is not skipped, it recursively calls openArchive
 // to handle nested archives or to continue processing based on the file's content and depth in the archive structure.
-func (h *archiveHandler) extractorHandler(archiveChan chan []byte) func(context.Context, archiver.File) error {
+func (h *archiveHandler) extractorHandler(archiveChan chan chunkData) func(context.Context, archiver.File) error {
 	return func(ctx context.Context, file archiver.File) error {
 		lCtx := logContext.WithValues(
 			logContext.AddLogger(ctx),
@@ -187,7 +192,7 @@ func (h *archiveHandler) extractorHandler(archiveChan chan []byte) func(context.
 			}
 		}()
 
-		rdr, err := newFileReader(f)
+		rdr, err := newContextFileReader(lCtx, f)
 		if err != nil {
 			if errors.Is(err, ErrEmptyReader) {
 				lCtx.Logger().V(5).Info("empty reader, skipping file")
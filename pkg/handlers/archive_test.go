@@ -102,7 +102,7 @@ func TestArchiveHandler(t *testing.T) {
 			matched := false
 			for chunk := range archiveChan {
 				count++
-				if re.Match(chunk) {
+				if re.Match(chunk.data) {
 					matched = true
 				}
 			}
@@ -123,7 +123,7 @@ func TestOpenInvalidArchive(t *testing.T) {
 	assert.NoError(t, err)
 	defer rdr.Close()
 
-	archiveChan := make(chan []byte)
+	archiveChan := make(chan chunkData)
 
 	err = handler.openArchive(ctx, 0, rdr, archiveChan)
 	assert.Error(t, err)
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -29,9 +33,9 @@ func newDefaultHandler(handlerType handlerType) *defaultHandler {
 // utilizing a single output channel. It first tries to identify the input as an archive. If it is an archive,
 // it processes it accordingly; otherwise, it handles the input as non-archive content.
 // The function returns a channel that will receive the extracted data bytes and an error if the initial setup fails.
-func (h *defaultHandler) HandleFile(ctx logContext.Context, input fileReader) (chan []byte, error) {
+func (h *defaultHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
 	// Shared channel for both archive and non-archive content.
-	dataChan := make(chan []byte, defaultBufferSize)
+	dataChan := make(chan chunkData, defaultBufferSize)
 
 	go func() {
 		defer close(dataChan)
@@ -71,7 +75,7 @@ func (h *defaultHandler) measureLatencyAndHandleErrors(start time.Time, err erro
 // on the type, particularly for binary files. It manages reading file chunks and writing them to the archive channel,
 // effectively collecting the final bytes for further processing. This function is a key component in ensuring that all
 // file content, regardless of being an archive or not, is handled appropriately.
-func (h *defaultHandler) handleNonArchiveContent(ctx logContext.Context, reader mimeTypeReader, archiveChan chan []byte) error {
+func (h *defaultHandler) handleNonArchiveContent(ctx logContext.Context, reader mimeTypeReader, archiveChan chan chunkData) error {
 	mimeExt := reader.mimeExt
 
 	if common.SkipFile(mimeExt) || common.IsBinary(mimeExt) {
@@ -88,10 +92,91 @@ func (h *defaultHandler) handleNonArchiveContent(ctx logContext.Context, reader
 			continue
 		}
 
-		if err := common.CancellableWrite(ctx, archiveChan, data.Bytes()); err != nil {
+		if err := common.CancellableWrite(ctx, archiveChan, chunkData{data: data.Bytes()}); err != nil {
 			return err
 		}
 		h.metrics.incBytesProcessed(len(data.Bytes()))
 	}
 	return nil
 }
+
+// handleText handles text extracted from a file, such as the strings of a compiled program or the text of an office
+// document, like non-archive content.
+func (h *defaultHandler) handleText(ctx logContext.Context, text string, dataChan chan chunkData) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	rdr, err := newMimeTypeReader(strings.NewReader(text))
+	if err != nil {
+		return fmt.Errorf("error creating mime-type reader: %w", err)
+	}
+	return h.handleNonArchiveContent(ctx, rdr, dataChan)
+}
+
+// sendTextAt sends text extracted from a file in chunks, with the number of lines that precede each of them in the
+// file, starting from lineOffset for the text, so that findings are located in the file.
+func (h *defaultHandler) sendTextAt(ctx logContext.Context, lineOffset int64, text string, dataChan chan chunkData) error {
+	chunkReader := sources.NewChunkReader()
+	for data := range chunkReader(ctx, strings.NewReader(text)) {
+		if err := data.Error(); err != nil {
+			ctx.Logger().Error(err, "error reading chunk")
+			h.metrics.incErrors()
+			continue
+		}
+
+		chunk := chunkData{data: data.Bytes(), lineOffset: lineOffset}
+		if err := common.CancellableWrite(ctx, dataChan, chunk); err != nil {
+			return err
+		}
+		h.metrics.incBytesProcessed(len(chunk.data))
+		// Chunks overlap by the data peeked after them, so the next one starts ChunkSize bytes into this one.
+		lineOffset += int64(bytes.Count(chunk.data[:min(len(chunk.data), sources.ChunkSize)], []byte("\n")))
+	}
+	return nil
+}
+
+// handleNestedFile handles a file found inside another one, such as an object embedded in a document, with the
+// handler of its type, and forwards its data to dataChan. The depth of nested files is limited like the one of
+// nested archives.
+func (h *defaultHandler) handleNestedFile(ctx logContext.Context, r io.Reader, dataChan chan chunkData) error {
+	depth := 0
+	if ctxDepth, ok := ctx.Value(depthKey).(int); ok {
+		depth = ctxDepth
+	}
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	rdr, err := newContextFileReader(ctx, r)
+	if err != nil {
+		if errors.Is(err, ErrEmptyReader) {
+			ctx.Logger().V(5).Info("empty reader, skipping file")
+			return nil
+		}
+		return fmt.Errorf("error creating custom reader: %w", err)
+	}
+	defer rdr.Close()
+
+	handler := selectHandler(mimeType(rdr.mime.String()), rdr.isGenericArchive)
+	return h.forwardHandledFile(logContext.WithValue(ctx, depthKey, depth+1), handler, rdr, dataChan)
+}
+
+// forwardHandledFile handles a file with handler and forwards its data to dataChan.
+func (h *defaultHandler) forwardHandledFile(
+	ctx logContext.Context,
+	handler FileHandler,
+	rdr fileReader,
+	dataChan chan chunkData,
+) error {
+	nestedChan, err := handler.HandleFile(ctx, rdr)
+	if err != nil {
+		return err
+	}
+	for data := range nestedChan {
+		if err := common.CancellableWrite(ctx, dataChan, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,9 +8,12 @@ import (
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/mholt/archiver/v4"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/iobuf"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
@@ -80,9 +83,23 @@ func newMimeTypeReader(r io.Reader) (mimeTypeReader, error) {
 
 // newFileReader creates a fileReader from an io.Reader, optionally using BufferedFileWriter for certain formats.
 func newFileReader(r io.Reader) (fileReader, error) {
+	return detectFileReader(iobuf.NewBufferedReaderSeeker(r))
+}
+
+// newContextFileReader creates a fileReader like newFileReader, but keeps the data in memory when the
+// memory-only option of HandleFile is set in ctx.
+func newContextFileReader(ctx logContext.Context, r io.Reader) (fileReader, error) {
+	if memoryOnly, _ := ctx.Value(memoryOnlyKey).(bool); memoryOnly {
+		return detectFileReader(iobuf.NewMemoryBufferedReaderSeeker(r))
+	}
+	return newFileReader(r)
+}
+
+// detectFileReader detects the MIME type and archive format of the data of a BufferedReadSeeker.
+func detectFileReader(brs *iobuf.BufferedReadSeeker) (fileReader, error) {
 	var fReader fileReader
 
-	fReader.BufferedReadSeeker = iobuf.NewBufferedReaderSeeker(r)
+	fReader.BufferedReadSeeker = brs
 
 	mime, err := mimetype.DetectReader(fReader)
 	if err != nil {
@@ -123,15 +140,26 @@ func newFileReader(r io.Reader) (fileReader, error) {
 	return fReader, nil
 }
 
+// chunkData is data sent by a handler to be scanned as a chunk.
+type chunkData struct {
+	data []byte
+	// lineOffset is the number of lines that precede data in the file, for data extracted from the file rather than
+	// read from it as is, such as the source of a cell of a notebook.
+	lineOffset int64
+}
+
 // FileHandler represents a handler for files.
 // It has a single method, HandleFile, which takes a context and a fileReader as input,
 // and returns a channel of byte slices and an error.
 type FileHandler interface {
-	HandleFile(ctx logContext.Context, reader fileReader) (chan []byte, error)
+	HandleFile(ctx logContext.Context, reader fileReader) (chan chunkData, error)
 }
 
 // fileHandlingConfig encapsulates configuration settings that control the behavior of file processing.
-type fileHandlingConfig struct{ skipArchives bool }
+type fileHandlingConfig struct {
+	skipArchives bool
+	memoryOnly   bool
+}
 
 // newFileHandlingConfig creates a default fileHandlingConfig with default settings.
 // Optional functional parameters can customize the configuration.
@@ -150,13 +178,27 @@ func WithSkipArchives(skip bool) func(*fileHandlingConfig) {
 	return func(c *fileHandlingConfig) { c.skipArchives = skip }
 }
 
+// WithMemoryOnly sets the memoryOnly field of the fileHandlingConfig.
+// If memoryOnly is true, the file and the files nested in it are never buffered to temporary files. Past the
+// in-memory threshold their data is streamed, so handlers that need to seek back into it, such as the one of zip
+// archives, fail.
+func WithMemoryOnly(memoryOnly bool) func(*fileHandlingConfig) {
+	return func(c *fileHandlingConfig) { c.memoryOnly = memoryOnly }
+}
+
 type handlerType string
 
 const (
-	archiveHandlerType handlerType = "archive"
-	arHandlerType      handlerType = "ar"
-	rpmHandlerType     handlerType = "rpm"
-	defaultHandlerType handlerType = "default"
+	archiveHandlerType  handlerType = "archive"
+	arHandlerType       handlerType = "ar"
+	rpmHandlerType      handlerType = "rpm"
+	ooxmlHandlerType    handlerType = "ooxml"
+	pdfHandlerType      handlerType = "pdf"
+	sqliteHandlerType   handlerType = "sqlite"
+	notebookHandlerType handlerType = "notebook"
+	classHandlerType    handlerType = "class"
+	androidHandlerType  handlerType = "android"
+	defaultHandlerType  handlerType = "default"
 )
 
 type mimeType string
@@ -191,6 +233,16 @@ const (
 	pyScriptMime mimeType = "application/x-script.python"
 	tclTextMime  mimeType = "text/x-tcl"
 	tclMime      mimeType = "application/x-tcl"
+	docxMime     mimeType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	xlsxMime     mimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	pptxMime     mimeType = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	pdfMime      mimeType = "application/pdf"
+	sqliteMime   mimeType = "application/vnd.sqlite3"
+	notebookMime mimeType = "application/x-ipynb+json"
+	classMime    mimeType = "application/x-java-applet"
+	dexMime      mimeType = "application/vnd.android.dex"
+	axmlMime     mimeType = "application/vnd.android.axml"
+	arscMime     mimeType = "application/vnd.android.arsc"
 )
 
 // skipArchiverMimeTypes is a set of MIME types that should bypass archiver library processing because they are either
@@ -225,6 +277,16 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 	pyScriptMime: {},
 	tclTextMime:  {},
 	tclMime:      {},
+	docxMime:     {},
+	xlsxMime:     {},
+	pptxMime:     {},
+	pdfMime:      {},
+	sqliteMime:   {},
+	notebookMime: {},
+	classMime:    {},
+	dexMime:      {},
+	axmlMime:     {},
+	arscMime:     {},
 }
 
 // selectHandler dynamically selects and configures a FileHandler based on the provided |mimetype| type and archive flag.
@@ -232,6 +294,13 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 // This method uses specialized handlers for specific file types:
 // - arHandler is used for Unix archives and Debian packages ('arMime', 'unixArMime', and 'debMime').
 // - rpmHandler is used for RPM and CPIO archives ('rpmMime' and 'cpioMime').
+// - ooxmlHandler is used for Word, Excel and PowerPoint documents ('docxMime', 'xlsxMime' and 'pptxMime').
+// - pdfHandler is used for PDF documents ('pdfMime').
+// - sqliteHandler is used for SQLite databases ('sqliteMime').
+// - notebookHandler is used for Jupyter notebooks ('notebookMime').
+// - classHandler is used for Java class files ('classMime').
+// - androidHandler is used for Dalvik executables, binary XML and resource tables ('dexMime', 'axmlMime' and
+// 'arscMime').
 // - archiveHandler is used for common archive formats supported by the archiver library (.zip, .tar, .gz, etc.).
 // - defaultHandler is used for non-archive files.
 // The selected handler is then returned, ready to handle the file according to its specific format and requirements.
@@ -241,6 +310,18 @@ func selectHandler(mimeT mimeType, isGenericArchive bool) FileHandler {
 		return newARHandler()
 	case rpmMime, cpioMime:
 		return newRPMHandler()
+	case docxMime, xlsxMime, pptxMime:
+		return newOOXMLHandler()
+	case pdfMime:
+		return newPDFHandler()
+	case sqliteMime:
+		return newSQLiteHandler()
+	case notebookMime:
+		return newNotebookHandler()
+	case classMime:
+		return newClassHandler()
+	case dexMime, axmlMime, arscMime:
+		return newAndroidHandler()
 	default:
 		if isGenericArchive {
 			return newArchiveHandler()
@@ -249,6 +330,12 @@ func selectHandler(mimeT mimeType, isGenericArchive bool) FileHandler {
 	}
 }
 
+// isDefaultHandler reports whether handler is the one of files that have no handler of their own.
+func isDefaultHandler(handler FileHandler) bool {
+	_, ok := handler.(*defaultHandler)
+	return ok
+}
+
 // HandleFile orchestrates the complete file handling process for a given file.
 // It determines the MIME type of the file, selects the appropriate handler based on this type, and processes the file.
 // This function initializes the handling process and delegates to the specific handler to manage file
@@ -269,7 +356,12 @@ func HandleFile(
 		return fmt.Errorf("reader is nil")
 	}
 
-	rdr, err := newFileReader(reader)
+	config := newFileHandlingConfig(options...)
+	if config.memoryOnly {
+		ctx = logContext.WithValue(ctx, memoryOnlyKey, true)
+	}
+
+	rdr, err := newContextFileReader(ctx, reader)
 	if err != nil {
 		if errors.Is(err, ErrEmptyReader) {
 			ctx.Logger().V(5).Info("empty reader, skipping file")
@@ -280,7 +372,6 @@ func HandleFile(
 	defer rdr.Close()
 
 	mimeT := mimeType(rdr.mime.String())
-	config := newFileHandlingConfig(options...)
 	if config.skipArchives && rdr.isGenericArchive {
 		ctx.Logger().V(5).Info("skipping archive file", "mime", mimeT)
 		return nil
@@ -301,7 +392,7 @@ func HandleFile(
 // is done. It returns true if all chunks are processed successfully, otherwise returns false on errors or cancellation.
 func handleChunks(
 	ctx logContext.Context,
-	handlerChan chan []byte,
+	handlerChan chan chunkData,
 	chunkSkel *sources.Chunk,
 	reporter sources.ChunkReporter,
 ) error {
@@ -317,7 +408,8 @@ func handleChunks(
 				return nil
 			}
 			chunk := *chunkSkel
-			chunk.Data = data
+			chunk.Data = data.data
+			chunk.SourceMetadata = offsetLine(chunk.SourceMetadata, data.lineOffset)
 			if err := reporter.ChunkOk(ctx, chunk); err != nil {
 				return fmt.Errorf("error reporting chunk: %w", err)
 			}
@@ -326,3 +418,26 @@ func handleChunks(
 		}
 	}
 }
+
+// offsetLine returns a copy of the metadata of a chunk with its line moved by offset, so that the line numbers of
+// the findings in data extracted from a file are those in the file. The metadata is returned as is when it has no
+// line.
+func offsetLine(metadata *source_metadatapb.MetaData, offset int64) *source_metadatapb.MetaData {
+	if metadata == nil || offset == 0 {
+		return metadata
+	}
+	msg := metadata.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("data"))
+	if field == nil || field.Message() == nil {
+		return metadata
+	}
+	line := field.Message().Fields().ByName("line")
+	if line == nil || line.Kind() != protoreflect.Int64Kind || line.Cardinality() == protoreflect.Repeated {
+		return metadata
+	}
+
+	metadata = proto.Clone(metadata).(*source_metadatapb.MetaData)
+	data := metadata.ProtoReflect().Get(field).Message()
+	data.Set(line, protoreflect.ValueOfInt64(data.Get(line).Int()+offset))
+	return metadata
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
@@ -417,3 +418,21 @@ func TestHandleLargeHTTPJson(t *testing.T) {
 	}
 	assert.Equal(t, wantCount, count)
 }
+
+func TestOffsetLine(t *testing.T) {
+	fsMetadata := &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Filesystem{Filesystem: &source_metadatapb.Filesystem{File: "a.ipynb", Line: 2}},
+	}
+	got := offsetLine(fsMetadata, 5)
+	assert.Equal(t, int64(7), got.GetFilesystem().GetLine())
+	assert.Equal(t, "a.ipynb", got.GetFilesystem().GetFile())
+	// The metadata of the skeleton is shared by all the chunks of a file.
+	assert.Equal(t, int64(2), fsMetadata.GetFilesystem().GetLine())
+
+	// Metadata without a line is kept as is.
+	s3Metadata := &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_S3{S3: &source_metadatapb.S3{File: "a.ipynb"}},
+	}
+	assert.Same(t, s3Metadata, offsetLine(s3Metadata, 5))
+	assert.Nil(t, offsetLine(nil, 5))
+}
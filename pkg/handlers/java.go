@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+var errClassFormat = errors.New("invalid Java class file")
+
+// Tags of the constants of the constant pool of class files.
+const (
+	classConstUTF8   = 1
+	classConstString = 8
+	classConstLong   = 5
+	classConstDouble = 6
+)
+
+// classHandler handles Java class files. Their string literals are stored in the constant pool in modified UTF-8,
+// prefixed with their length, so they are read from it and sent one per line.
+type classHandler struct{ *defaultHandler }
+
+// newClassHandler creates a classHandler.
+func newClassHandler() *classHandler {
+	return &classHandler{defaultHandler: newDefaultHandler(classHandlerType)}
+}
+
+// HandleFile processes Java class files, sending their string constants.
+func (h *classHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the class processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading Java class")
+			}
+		}()
+
+		if err = h.processClass(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing Java class")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+func (h *classHandler) processClass(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	data, err := io.ReadAll(io.LimitReader(input, int64(maxSize)))
+	if err != nil {
+		return fmt.Errorf("error reading class: %w", err)
+	}
+	h.metrics.observeFileSize(int64(len(data)))
+
+	text, err := classStrings(data)
+	if err != nil {
+		return fmt.Errorf("error parsing class: %w", err)
+	}
+	return h.handleText(ctx, text, dataChan)
+}
+
+// classConstant is an entry of the constant pool of a class file. Only UTF-8 constants and the constants that
+// reference them are decoded.
+type classConstant struct {
+	tag   byte
+	text  string
+	index int
+}
+
+// classStrings returns the string constants of a class file (https://docs.oracle.com/javase/specs/jvms/se21/html/jvms-4.html),
+// one per line. The constants that are the values of fields, such as `static final String KEY = "..."`, come
+// first, as name=value, followed by the other string literals.
+func classStrings(data []byte) (string, error) {
+	r := &classReader{data: data}
+	if r.u32() != 0xcafebabe {
+		return "", fmt.Errorf("%w: bad magic", errClassFormat)
+	}
+	r.skip(4) // The minor and major versions.
+
+	pool := make([]classConstant, r.u16())
+	for i := 1; i < len(pool) && r.err == nil; i++ {
+		tag := r.u8()
+		pool[i].tag = tag
+		switch tag {
+		case classConstUTF8:
+			pool[i].text = decodeMUTF8(r.bytes(r.u16()))
+		case classConstString, 7, 16, 19, 20:
+			// Strings, classes, method types, modules and packages reference a UTF-8 constant.
+			pool[i].index = r.u16()
+		case 3, 4, 9, 10, 11, 12, 17, 18:
+			r.skip(4)
+		case 15:
+			r.skip(3)
+		case classConstLong, classConstDouble:
+			// 8-byte constants take two entries of the pool.
+			r.skip(8)
+			i++
+		default:
+			return "", fmt.Errorf("%w: unknown constant tag %d", errClassFormat, tag)
+		}
+	}
+	if r.err != nil {
+		return "", r.err
+	}
+	utf8At := func(i int) (string, bool) {
+		if i <= 0 || i >= len(pool) || pool[i].tag != classConstUTF8 {
+			return "", false
+		}
+		return pool[i].text, true
+	}
+
+	var out strings.Builder
+	sent := make(map[int]bool)
+
+	// The access flags, this class and super class precede the interfaces and the fields.
+	r.skip(6)
+	r.skip(2 * r.u16())
+	fieldCount := r.u16()
+	for i := 0; i < fieldCount && r.err == nil; i++ {
+		r.skip(2) // The access flags.
+		name, _ := utf8At(r.u16())
+		r.skip(2) // The descriptor.
+		for attrCount := r.u16(); attrCount > 0 && r.err == nil; attrCount-- {
+			attrName, _ := utf8At(r.u16())
+			info := r.bytes(int(r.u32()))
+			if attrName != "ConstantValue" || len(info) != 2 {
+				continue
+			}
+			index := int(binary.BigEndian.Uint16(info))
+			if index <= 0 || index >= len(pool) || pool[index].tag != classConstString {
+				continue
+			}
+			if value, ok := utf8At(pool[index].index); ok {
+				fmt.Fprintf(&out, "%s=%s\n", name, value)
+				sent[index] = true
+			}
+		}
+	}
+	// The fields of truncated classes are left out, but their literals are still sent.
+
+	for i, c := range pool {
+		if c.tag != classConstString || sent[i] {
+			continue
+		}
+		if value, ok := utf8At(c.index); ok && value != "" {
+			out.WriteString(value)
+			out.WriteByte('\n')
+		}
+	}
+	return out.String(), nil
+}
+
+// classReader reads the big-endian values of class files. Reads past the end of the data return zero values and
+// set err.
+type classReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *classReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.pos+n > len(r.data) {
+		if r.err == nil {
+			r.err = fmt.Errorf("%w: truncated at offset %d", errClassFormat, r.pos)
+		}
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *classReader) skip(n int) { r.bytes(n) }
+
+func (r *classReader) u8() byte {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *classReader) u16() int {
+	if b := r.bytes(2); b != nil {
+		return int(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (r *classReader) u32() uint32 {
+	if b := r.bytes(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+// decodeMUTF8 decodes the modified UTF-8 of class and DEX files, which encodes NUL with two bytes and
+// supplementary characters as surrogate pairs of three bytes each.
+func decodeMUTF8(b []byte) string {
+	units := make([]uint16, 0, len(b))
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			units = append(units, uint16(c))
+			i++
+		case c&0xe0 == 0xc0 && i+1 < len(b):
+			units = append(units, uint16(c&0x1f)<<6|uint16(b[i+1]&0x3f))
+			i += 2
+		case c&0xf0 == 0xe0 && i+2 < len(b):
+			units = append(units, uint16(c&0x0f)<<12|uint16(b[i+1]&0x3f)<<6|uint16(b[i+2]&0x3f))
+			i += 3
+		default:
+			units = append(units, utf8.RuneError)
+			i++
+		}
+	}
+	return string(utf16.Decode(units))
+}
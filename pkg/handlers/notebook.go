@@ -0,0 +1,391 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+var (
+	// notebookPrefixPat matches the start of a notebook in the nbformat 4 format, whose first key is "cells".
+	notebookPrefixPat = regexp.MustCompile(`^\s*\{\s*"cells"\s*:\s*\[`)
+	// ansiEscapePat matches the escape sequences that color the tracebacks of errors.
+	ansiEscapePat = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+)
+
+func init() {
+	// Notebooks are JSON documents, so they are detected as a sub-format of JSON.
+	mimetype.Lookup(string(jsonMime)).Extend(isNotebook, string(notebookMime), ".ipynb")
+}
+
+// isNotebook reports whether the start of a JSON document is the one of a Jupyter notebook.
+func isNotebook(raw []byte, _ uint32) bool {
+	return notebookPrefixPat.Match(raw) &&
+		(bytes.Contains(raw, []byte(`"cell_type"`)) || bytes.Contains(raw, []byte(`"nbformat"`)))
+}
+
+// notebookHandler handles Jupyter notebooks. Their cells and outputs are JSON strings, or arrays of lines, in which
+// quotes and newlines are escaped and binary outputs such as images are base64-encoded, so they are decoded before
+// being scanned.
+type notebookHandler struct{ *defaultHandler }
+
+// newNotebookHandler creates a notebookHandler.
+func newNotebookHandler() *notebookHandler {
+	return &notebookHandler{defaultHandler: newDefaultHandler(notebookHandlerType)}
+}
+
+// HandleFile processes Jupyter notebooks, sending the source and the outputs of their cells.
+func (h *notebookHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the notebook processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading notebook")
+			}
+		}()
+
+		if err = h.processNotebook(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing notebook")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+// notebook is a Jupyter notebook in the nbformat 4 format (https://nbformat.readthedocs.io/en/latest/format_description.html).
+type notebook struct {
+	Cells []notebookCell `json:"cells"`
+}
+
+type notebookCell struct {
+	CellType    string                        `json:"cell_type"`
+	Source      notebookText                  `json:"source"`
+	Outputs     []notebookOutput              `json:"outputs"`
+	Attachments map[string]notebookMimeBundle `json:"attachments"`
+}
+
+type notebookOutput struct {
+	OutputType string             `json:"output_type"`
+	Text       notebookText       `json:"text"`
+	Data       notebookMimeBundle `json:"data"`
+	Ename      string             `json:"ename"`
+	Evalue     string             `json:"evalue"`
+	Traceback  []string           `json:"traceback"`
+}
+
+// notebookMimeBundle maps MIME types to representations of the same data, such as an image and its text.
+type notebookMimeBundle map[string]json.RawMessage
+
+// notebookText is text stored either as a string or as an array of lines, which keep their newlines.
+type notebookText struct {
+	// parts are the lines of the text when it is stored as an array, or the text itself.
+	parts   []string
+	isArray bool
+}
+
+func (t *notebookText) UnmarshalJSON(data []byte) error {
+	var lines []string
+	if err := json.Unmarshal(data, &lines); err == nil {
+		*t = notebookText{parts: lines, isArray: true}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*t = notebookText{parts: []string{s}}
+	return nil
+}
+
+func (t notebookText) String() string { return strings.Join(t.parts, "") }
+
+// fileText returns the text found at path in the notebook, and the number of lines that precede it. The newlines of
+// the text that are not line breaks of the notebook, such as the escaped newlines of a string, are replaced by
+// carriage returns, so that the lines of the text are the ones of the notebook.
+func (t notebookText) fileText(path string, lines map[string]int64) (int64, string) {
+	partLine := func(i int) int64 {
+		if !t.isArray {
+			return lines[path]
+		}
+		return lines[path+"/"+strconv.Itoa(i)]
+	}
+
+	var text strings.Builder
+	for i, part := range t.parts {
+		// The line breaks of the notebook between this part and the next, or the trailing newlines of the last part.
+		var breaks int
+		if i+1 < len(t.parts) {
+			breaks = int(partLine(i+1) - partLine(i))
+		} else {
+			breaks = len(part) - len(strings.TrimRight(part, "\n"))
+		}
+		if extra := strings.Count(part, "\n") - breaks; extra > 0 {
+			part = strings.Replace(part, "\n", "\r", extra)
+		}
+		text.WriteString(part)
+	}
+	return lines[path], text.String()
+}
+
+func (h *notebookHandler) processNotebook(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	data, err := io.ReadAll(io.LimitReader(input, int64(maxSize)))
+	if err != nil {
+		return fmt.Errorf("error reading notebook: %w", err)
+	}
+	h.metrics.observeFileSize(int64(len(data)))
+
+	var nb notebook
+	if err := json.Unmarshal(data, &nb); err != nil {
+		return fmt.Errorf("error parsing notebook: %w", err)
+	}
+	lines, err := jsonValueLines(data)
+	if err != nil {
+		return fmt.Errorf("error locating notebook values: %w", err)
+	}
+
+	for i, cell := range nb.Cells {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		cellPath := fmt.Sprintf("/cells/%d", i)
+		cellCtx := logContext.WithValues(ctx, "cell", i+1)
+		if err := h.sendNotebookText(cellCtx, cellPath+"/source", lines, cell.Source, dataChan); err != nil {
+			return err
+		}
+
+		for j, output := range cell.Outputs {
+			outputPath := fmt.Sprintf("%s/outputs/%d", cellPath, j)
+			if err := h.sendOutput(cellCtx, outputPath, lines, output, dataChan); err != nil {
+				return err
+			}
+		}
+
+		names := make([]string, 0, len(cell.Attachments))
+		for name := range cell.Attachments {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			attachmentPath := cellPath + "/attachments/" + jsonPointerEscaper.Replace(name)
+			attachmentCtx := logContext.WithValues(cellCtx, "attachment", name)
+			err := h.sendMimeBundle(attachmentCtx, attachmentPath, lines, cell.Attachments[name], dataChan)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sendOutput sends an output of a cell, found at path in the notebook: the text of streams, the message and
+// traceback of errors, and the data of results.
+func (h *notebookHandler) sendOutput(
+	ctx logContext.Context,
+	path string,
+	lines map[string]int64,
+	output notebookOutput,
+	dataChan chan chunkData,
+) error {
+	switch output.OutputType {
+	case "stream":
+		return h.sendNotebookText(ctx, path+"/text", lines, output.Text, dataChan)
+	case "error":
+		message := notebookText{parts: []string{output.Ename + ": " + output.Evalue}}
+		if err := h.sendNotebookText(ctx, path+"/evalue", lines, message, dataChan); err != nil {
+			return err
+		}
+		// The lines of tracebacks are joined by newlines.
+		traceback := notebookText{parts: make([]string, len(output.Traceback)), isArray: true}
+		for i, line := range output.Traceback {
+			traceback.parts[i] = ansiEscapePat.ReplaceAllString(line, "")
+			if i+1 < len(output.Traceback) {
+				traceback.parts[i] += "\n"
+			}
+		}
+		return h.sendNotebookText(ctx, path+"/traceback", lines, traceback, dataChan)
+	default:
+		return h.sendMimeBundle(ctx, path+"/data", lines, output.Data, dataChan)
+	}
+}
+
+// sendMimeBundle sends the textual representations of data, found at path in the notebook, and handles the binary
+// ones, which are base64-encoded, with the handler of their type.
+func (h *notebookHandler) sendMimeBundle(
+	ctx logContext.Context,
+	path string,
+	lines map[string]int64,
+	bundle notebookMimeBundle,
+	dataChan chan chunkData,
+) error {
+	mimeTypes := make([]string, 0, len(bundle))
+	for mimeT := range bundle {
+		mimeTypes = append(mimeTypes, mimeT)
+	}
+	sort.Strings(mimeTypes)
+
+	for _, mimeT := range mimeTypes {
+		raw := bundle[mimeT]
+		dataPath := path + "/" + jsonPointerEscaper.Replace(mimeT)
+		// JSON data is stored as is rather than as a string.
+		if mimeT == string(jsonMime) || strings.HasSuffix(mimeT, "+json") {
+			if err := h.sendText(ctx, lines[dataPath], string(raw), dataChan); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var value notebookText
+		if err := json.Unmarshal(raw, &value); err != nil {
+			ctx.Logger().V(3).Info("skipping malformed output data", "mime", mimeT, "error", err)
+			continue
+		}
+		if !isTextMime(mimeT) {
+			decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value.String()), ""))
+			if err == nil {
+				dataCtx := logContext.WithValues(ctx, "mime", mimeT)
+				if err := h.handleNestedFile(dataCtx, bytes.NewReader(decoded), dataChan); err != nil {
+					dataCtx.Logger().Error(err, "error handling output data")
+					h.metrics.incErrors()
+				}
+				continue
+			}
+		}
+
+		// Scan text, and data that isn't base64-encoded, as text.
+		if err := h.sendNotebookText(ctx, dataPath, lines, value, dataChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isTextMime reports whether data of a MIME type is stored as text in notebooks, rather than base64-encoded.
+func isTextMime(mimeT string) bool {
+	return strings.HasPrefix(mimeT, "text/") ||
+		strings.HasSuffix(mimeT, "+xml") ||
+		mimeT == string(jsAppMime)
+}
+
+// sendNotebookText sends text found at path in the notebook, unless it is blank.
+func (h *notebookHandler) sendNotebookText(
+	ctx logContext.Context,
+	path string,
+	lines map[string]int64,
+	text notebookText,
+	dataChan chan chunkData,
+) error {
+	lineOffset, s := text.fileText(path, lines)
+	return h.sendText(ctx, lineOffset, s, dataChan)
+}
+
+// sendText sends text found at lineOffset in the notebook, unless it is blank.
+func (h *notebookHandler) sendText(ctx logContext.Context, lineOffset int64, text string, dataChan chan chunkData) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	return h.sendTextAt(ctx, lineOffset, text, dataChan)
+}
+
+// jsonPointerEscaper escapes the keys of JSON objects in JSON Pointers (RFC 6901).
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// jsonValueLines returns the number of lines that precede the text of each value of a JSON document, by JSON
+// Pointer, such as "/cells/0/source" or "/cells/0/source/2" for the elements of arrays. The text of arrays starts at
+// their first element: notebooks store multi-line text as arrays of lines, which Jupyter writes one per line.
+func jsonValueLines(data []byte) (map[string]int64, error) {
+	l := &jsonLines{dec: json.NewDecoder(bytes.NewReader(data)), data: data, lines: make(map[string]int64)}
+	if err := l.walk(""); err != nil {
+		return nil, err
+	}
+	return l.lines, nil
+}
+
+// jsonLines records the lines of the values of a JSON document as it is decoded.
+type jsonLines struct {
+	dec   *json.Decoder
+	data  []byte
+	off   int   // The offset up to which lines were counted.
+	line  int64 // The number of lines before off.
+	lines map[string]int64
+}
+
+// next returns the number of lines that precede the next token.
+func (l *jsonLines) next() int64 {
+	off := int(l.dec.InputOffset())
+	for off < len(l.data) && strings.IndexByte(" \t\r\n,:", l.data[off]) >= 0 {
+		off++
+	}
+	if off > l.off {
+		l.line += int64(bytes.Count(l.data[l.off:off], []byte("\n")))
+		l.off = off
+	}
+	return l.line
+}
+
+// walk records the lines of the value at path and of the values it contains.
+func (l *jsonLines) walk(path string) error {
+	line := l.next()
+	tok, err := l.dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('['):
+		l.lines[path] = l.next()
+		for i := 0; l.dec.More(); i++ {
+			if err := l.walk(path + "/" + strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+	case json.Delim('{'):
+		l.lines[path] = line
+		for l.dec.More() {
+			key, err := l.dec.Token()
+			if err != nil {
+				return err
+			}
+			name, _ := key.(string)
+			if err := l.walk(path + "/" + jsonPointerEscaper.Replace(name)); err != nil {
+				return err
+			}
+		}
+	default:
+		l.lines[path] = line
+		return nil
+	}
+	// Read the end of the array or object.
+	_, err = l.dec.Token()
+	return err
+}
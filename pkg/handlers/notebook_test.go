@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleNotebookFile(t *testing.T) {
+	notebook := readTestFile(t, "testdata/test.ipynb")
+
+	rdr, err := newFileReader(bytes.NewReader(notebook))
+	require.NoError(t, err)
+	defer rdr.Close()
+	assert.Equal(t, notebookMime, mimeType(rdr.mime.String()))
+
+	// Each text is sent at the line that precedes it in the notebook.
+	type text struct {
+		data string
+		line int64
+	}
+	var got []text
+	for _, chunk := range handleTestFileChunks(t, notebook) {
+		got = append(got, text{string(chunk.Data), chunk.SourceMetadata.GetFilesystem().GetLine()})
+	}
+	assert.Equal(t, []text{
+		{"# Deploy\nUses the \"prod\" token.\n", 7},
+		{"import os\ntoken = \"ghp_examplevalue\"\nprint(token)", 17},
+		{"ghp_examplevalue\n", 26},
+		{"{\n       \"key\": \"AKIAEXAMPLE\"\n      }", 33},
+		// The image is decoded and handled on its own.
+		{"password=hunter2\n", 0},
+		{"<Figure \"size\" 640x480>", 38},
+		{"KeyError: 'SECRET'", 45},
+		{"KeyError: 'SECRET'", 47},
+	}, got)
+}
+
+func TestHandleNotebookFile_EscapedNewlines(t *testing.T) {
+	// The source is a string and the traceback has lines with newlines, which are escaped in the notebook.
+	notebook := `{
+ "cells": [
+  {
+   "cell_type": "code",
+   "source": "import os\ntoken = \"ghp_examplevalue\"\nprint(token)\n",
+   "outputs": [
+    {
+     "output_type": "error",
+     "ename": "KeyError",
+     "evalue": "'SECRET'",
+     "traceback": [
+      "\u001b[0;31m------------------------------\u001b[0m",
+      "Cell In[1], line 2\n      1 import os\n----> 2 os.environ['SECRET']",
+      "KeyError: 'SECRET'"
+     ]
+    }
+   ]
+  }
+ ],
+ "nbformat": 4
+}`
+
+	type text struct {
+		data string
+		line int64
+	}
+	var got []text
+	for _, chunk := range handleTestFileChunks(t, []byte(notebook)) {
+		got = append(got, text{string(chunk.Data), chunk.SourceMetadata.GetFilesystem().GetLine()})
+	}
+	// The escaped newlines are sent as carriage returns, so that the lines of the text are the ones of the notebook.
+	assert.Equal(t, []text{
+		{"import os\rtoken = \"ghp_examplevalue\"\rprint(token)\n", 4},
+		{"KeyError: 'SECRET'", 9},
+		{"------------------------------\nCell In[1], line 2\r      1 import os\r----> 2 os.environ['SECRET']\nKeyError: 'SECRET'", 11},
+	}, got)
+}
+
+func TestNotebookMimeDetection(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  mimeType
+	}{
+		{name: "notebook", input: `{"cells": [{"cell_type": "code"}], "nbformat": 4}`, want: notebookMime},
+		{name: "empty notebook", input: `{"cells": [], "metadata": {}, "nbformat": 4}`, want: notebookMime},
+		{name: "json with cells", input: `{"cells": [1, 2, 3]}`, want: jsonMime},
+		{name: "json", input: `{"nbformat": 4}`, want: jsonMime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdr, err := newFileReader(bytes.NewReader([]byte(tt.input)))
+			require.NoError(t, err)
+			defer rdr.Close()
+			assert.Equal(t, tt.want, mimeType(rdr.mime.String()))
+		})
+	}
+}
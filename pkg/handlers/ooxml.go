@@ -0,0 +1,487 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// ooxmlTextParts are the parts of Office Open XML documents whose text is extracted: the body, headers, footers,
+// notes and comments of Word documents, the slides, speaker notes and comments of PowerPoint presentations,
+// and the comments and drawings of Excel workbooks. The cells of workbooks are handled separately.
+var ooxmlTextParts = []string{
+	"word/document.xml",
+	"word/header*.xml",
+	"word/footer*.xml",
+	"word/footnotes.xml",
+	"word/endnotes.xml",
+	"word/comments*.xml",
+	"ppt/slides/slide*.xml",
+	"ppt/notesSlides/notesSlide*.xml",
+	"ppt/comments/*.xml",
+	"xl/comments*.xml",
+	"xl/threadedComments/*.xml",
+	"xl/drawings/drawing*.xml",
+}
+
+// ooxmlCellParts are the parts of Excel workbooks whose text is sent with the cells of the workbook.
+var ooxmlCellParts = []string{
+	"xl/sharedStrings.xml",
+	"xl/worksheets/*.xml",
+}
+
+// ooxmlHandler handles Office Open XML documents (.docx, .xlsx and .pptx). Although they are zip archives, their
+// text is split across XML elements, such as the runs of a Word paragraph or the shared strings of an Excel
+// workbook, so it is reconstructed before being scanned. The other XML parts, such as the connections of workbooks
+// and the properties of documents, are scanned as is, and embedded objects are handled like any other file.
+type ooxmlHandler struct{ *defaultHandler }
+
+// newOOXMLHandler creates an ooxmlHandler.
+func newOOXMLHandler() *ooxmlHandler {
+	return &ooxmlHandler{defaultHandler: newDefaultHandler(ooxmlHandlerType)}
+}
+
+// HandleFile processes Office Open XML documents, sending their text and the content of their embedded objects.
+func (h *ooxmlHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the document processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading OOXML document")
+			}
+		}()
+
+		if err = h.processDocument(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing OOXML document")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+func (h *ooxmlHandler) processDocument(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	size, err := input.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("error getting document size: %w", err)
+	}
+	zipReader, err := zip.NewReader(input, size)
+	if err != nil {
+		return fmt.Errorf("error opening document: %w", err)
+	}
+
+	parts := make(map[string]*zip.File, len(zipReader.File))
+	for _, f := range zipReader.File {
+		parts[f.Name] = f
+	}
+
+	// The parts of the cells are scanned as is when they can't be read as cells.
+	cellsSent := false
+	if _, ok := parts["xl/workbook.xml"]; ok {
+		if err := h.processWorkbook(ctx, parts, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing workbook cells")
+			h.metrics.incErrors()
+		} else {
+			cellsSent = true
+		}
+	}
+
+	for _, f := range zipReader.File {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		fileCtx := logContext.WithValues(ctx, "filename", f.Name, "size", f.UncompressedSize64)
+
+		var err error
+		switch {
+		case matchesAny(ooxmlTextParts, f.Name):
+			err = h.sendPart(fileCtx, f, extractText, dataChan)
+		case strings.HasSuffix(f.Name, ".rels"):
+			err = h.sendPart(fileCtx, f, extractExternalTargets, dataChan)
+		case strings.Contains(f.Name, "/embeddings/"):
+			err = h.handleEmbedded(fileCtx, f, dataChan)
+		case cellsSent && matchesAny(ooxmlCellParts, f.Name):
+			continue
+		case strings.HasSuffix(f.Name, ".xml"):
+			err = h.handleRawPart(fileCtx, f, dataChan)
+		default:
+			continue
+		}
+		if err != nil {
+			fileCtx.Logger().Error(err, "error handling OOXML part")
+			h.metrics.incErrors()
+		}
+		h.metrics.observeFileSize(int64(f.UncompressedSize64))
+	}
+	return nil
+}
+
+// sendPart sends the text that extract returns for a part of the document.
+func (h *ooxmlHandler) sendPart(
+	ctx logContext.Context,
+	f *zip.File,
+	extract func(io.Reader) (string, error),
+	dataChan chan chunkData,
+) error {
+	rc, err := openPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	text, err := extract(rc)
+	if err != nil {
+		return fmt.Errorf("error extracting text: %w", err)
+	}
+	return h.handleText(ctx, text, dataChan)
+}
+
+// handleEmbedded handles an object embedded in the document, such as a spreadsheet in a Word document, with the
+// handler of its type.
+func (h *ooxmlHandler) handleEmbedded(ctx logContext.Context, f *zip.File, dataChan chan chunkData) error {
+	rc, err := openPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return h.handleNestedFile(ctx, rc, dataChan)
+}
+
+// handleRawPart scans the XML of a part as is.
+func (h *ooxmlHandler) handleRawPart(ctx logContext.Context, f *zip.File, dataChan chan chunkData) error {
+	rc, err := openPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	rdr, err := newMimeTypeReader(rc)
+	if err != nil {
+		return fmt.Errorf("error creating mime-type reader: %w", err)
+	}
+	return h.handleNonArchiveContent(ctx, rdr, dataChan)
+}
+
+// processWorkbook sends the cells of every sheet of an Excel workbook, one per line, prefixed with their sheet and
+// coordinates, such as "Sheet1!B2: value". Shared strings are resolved into the cells that reference them.
+func (h *ooxmlHandler) processWorkbook(ctx logContext.Context, parts map[string]*zip.File, dataChan chan chunkData) error {
+	var sharedStrings []string
+	if f, ok := parts["xl/sharedStrings.xml"]; ok {
+		var err error
+		if sharedStrings, err = readSharedStrings(f); err != nil {
+			return fmt.Errorf("error reading shared strings: %w", err)
+		}
+	}
+
+	sheets, err := readSheets(parts)
+	if err != nil {
+		return fmt.Errorf("error reading sheets: %w", err)
+	}
+	for _, sheet := range sheets {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		f, ok := parts[sheet.part]
+		if !ok {
+			continue
+		}
+		sheetCtx := logContext.WithValues(ctx, "sheet", sheet.name)
+		text, err := readCells(f, sheet.name, sharedStrings)
+		if err != nil {
+			sheetCtx.Logger().Error(err, "error reading sheet")
+			h.metrics.incErrors()
+			continue
+		}
+		if err := h.handleText(sheetCtx, text, dataChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type sheet struct{ name, part string }
+
+// readSheets returns the sheets of a workbook in order, resolving the part of each through the relationships of
+// the workbook.
+func readSheets(parts map[string]*zip.File) ([]sheet, error) {
+	targets := map[string]string{}
+	if f, ok := parts["xl/_rels/workbook.xml.rels"]; ok {
+		err := walkPart(f, func(_ *xml.Decoder, el xml.StartElement) error {
+			if el.Name.Local == "Relationship" {
+				targets[attr(el, "Id")] = resolveTarget("xl", attr(el, "Target"))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sheets []sheet
+	err := walkPart(parts["xl/workbook.xml"], func(_ *xml.Decoder, el xml.StartElement) error {
+		if el.Name.Local == "sheet" {
+			sheets = append(sheets, sheet{name: attr(el, "name"), part: targets[attr(el, "id")]})
+		}
+		return nil
+	})
+	return sheets, err
+}
+
+// readSharedStrings returns the shared strings of a workbook, by index. The text of a string may be split across
+// the runs of rich text, but not its phonetic guide.
+func readSharedStrings(f *zip.File) ([]string, error) {
+	var sharedStrings []string
+	err := walkPart(f, func(d *xml.Decoder, el xml.StartElement) error {
+		if el.Name.Local != "si" {
+			return nil
+		}
+		text, err := elementText(d, "rPh")
+		sharedStrings = append(sharedStrings, text)
+		return err
+	})
+	return sharedStrings, err
+}
+
+// readCells returns the non-empty cells of a sheet, along with their formulas.
+func readCells(f *zip.File, sheetName string, sharedStrings []string) (string, error) {
+	var out strings.Builder
+	err := walkPart(f, func(d *xml.Decoder, el xml.StartElement) error {
+		if el.Name.Local != "c" {
+			return nil
+		}
+		ref, cellType := attr(el, "r"), attr(el, "t")
+
+		var value, inline, formula string
+		for {
+			tok, err := d.Token()
+			if err != nil {
+				return err
+			}
+			if end, ok := tok.(xml.EndElement); ok && end.Name.Local == "c" {
+				break
+			}
+			start, ok := tok.(xml.StartElement)
+			if !ok {
+				continue
+			}
+			switch start.Name.Local {
+			case "v":
+				value, err = elementText(d)
+			case "f":
+				formula, err = elementText(d)
+			case "is":
+				inline, err = elementText(d, "rPh")
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		switch cellType {
+		case "s":
+			if i, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && i >= 0 && i < len(sharedStrings) {
+				value = sharedStrings[i]
+			}
+		case "inlineStr":
+			value = inline
+		}
+		if value != "" {
+			fmt.Fprintf(&out, "%s!%s: %s\n", sheetName, ref, value)
+		}
+		if formula != "" {
+			fmt.Fprintf(&out, "%s!%s formula: =%s\n", sheetName, ref, formula)
+		}
+		return nil
+	})
+	return out.String(), err
+}
+
+// extractText returns the text of a Word, PowerPoint or drawing part with a line per paragraph, joining the runs
+// that paragraphs are made of. Deleted text of tracked changes and field instructions are included.
+func extractText(r io.Reader) (string, error) {
+	var out strings.Builder
+	d := xml.NewDecoder(r)
+	inText := 0
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return out.String(), nil
+		}
+		if err != nil {
+			return out.String(), err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t", "delText", "instrText", "text":
+				inText++
+			case "tab":
+				out.WriteByte('\t')
+			case "br", "cr":
+				out.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t", "delText", "instrText", "text":
+				inText--
+			case "p", "comment", "threadedComment":
+				out.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText > 0 {
+				out.Write(t)
+			}
+		}
+	}
+}
+
+// extractExternalTargets returns the external targets of a relationships part, such as the URLs of hyperlinks,
+// one per line.
+func extractExternalTargets(r io.Reader) (string, error) {
+	var out strings.Builder
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return out.String(), nil
+		}
+		if err != nil {
+			return out.String(), err
+		}
+		if el, ok := tok.(xml.StartElement); ok && el.Name.Local == "Relationship" && attr(el, "TargetMode") == "External" {
+			out.WriteString(attr(el, "Target"))
+			out.WriteByte('\n')
+		}
+	}
+}
+
+// walkPart calls visit with every element of an XML part. visit may consume the content of the element.
+func walkPart(f *zip.File, visit func(*xml.Decoder, xml.StartElement) error) error {
+	if f == nil {
+		return fmt.Errorf("part not found")
+	}
+	rc, err := openPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	d := xml.NewDecoder(rc)
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if el, ok := tok.(xml.StartElement); ok {
+			if err := visit(d, el); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// elementText consumes the content of the current element, and returns its text, leaving out the text of the
+// elements named in skip.
+func elementText(d *xml.Decoder, skip ...string) (string, error) {
+	var out bytes.Buffer
+	depth, skipping := 1, 0
+	for depth > 0 {
+		tok, err := d.Token()
+		if err != nil {
+			return out.String(), err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if skipping > 0 || slices.Contains(skip, t.Name.Local) {
+				skipping++
+			}
+		case xml.EndElement:
+			depth--
+			if skipping > 0 {
+				skipping--
+			}
+		case xml.CharData:
+			if skipping == 0 {
+				out.Write(t)
+			}
+		}
+	}
+	return out.String(), nil
+}
+
+// openPart opens a part of the document, limiting its size to guard against zip bombs.
+func openPart(f *zip.File) (io.ReadCloser, error) {
+	if f.UncompressedSize64 > uint64(maxSize) {
+		return nil, fmt.Errorf("part %s is too large: %d bytes", f.Name, f.UncompressedSize64)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("error opening part %s: %w", f.Name, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, int64(maxSize)), rc}, nil
+}
+
+// resolveTarget resolves the target of a relationship against the directory of the part it belongs to.
+func resolveTarget(dir, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(dir, target)
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,706 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// pdfMaxSize is the size of the largest PDF document that is parsed. Documents are parsed in memory, so larger
+	// ones, which are mostly scanned images anyway, are skipped.
+	pdfMaxSize = 100 << 20 // 100 MB
+
+	// pdfMaxFormDepth limits the nesting of form XObjects and of form fields, which may be circular in malicious
+	// files.
+	pdfMaxFormDepth = 16
+)
+
+// pdfHandler handles PDF documents. Their text is drawn by the content streams of pages, usually compressed and
+// encoded with the fonts of the page, so it is decoded page by page. The values of form fields and embedded files
+// are handled as well. Findings are located by their line in the text of the document, in which every page starts
+// on a new line and the form fields follow the pages.
+type pdfHandler struct{ *defaultHandler }
+
+// newPDFHandler creates a pdfHandler.
+func newPDFHandler() *pdfHandler {
+	return &pdfHandler{defaultHandler: newDefaultHandler(pdfHandlerType)}
+}
+
+// HandleFile processes PDF documents, sending the text of their pages, the values of their form fields and the
+// content of their attachments.
+func (h *pdfHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the document processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading PDF document")
+			}
+		}()
+
+		if err = h.processDocument(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing PDF document")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+func (h *pdfHandler) processDocument(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	size, err := input.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("error getting document size: %w", err)
+	}
+	if size > min(pdfMaxSize, int64(maxSize)) {
+		ctx.Logger().V(3).Info("skipping document due to size", "size", size)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+	h.metrics.observeFileSize(size)
+
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking to the start of the document: %w", err)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(input, data); err != nil {
+		return fmt.Errorf("error reading document: %w", err)
+	}
+
+	doc, err := parsePDF(data)
+	if err != nil {
+		return fmt.Errorf("error parsing document: %w", err)
+	}
+	if doc.isEncrypted() {
+		ctx.Logger().V(2).Info("skipping encrypted PDF document")
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	var lineOffset int64
+	for i, page := range doc.pages() {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		text := doc.pageText(page)
+		if err := h.sendText(logContext.WithValues(ctx, "page", i+1), lineOffset, text, dataChan); err != nil {
+			return err
+		}
+		lineOffset += countLines(text)
+	}
+
+	if err := h.sendText(ctx, lineOffset, doc.formFields(), dataChan); err != nil {
+		return err
+	}
+
+	for _, file := range doc.embeddedFiles() {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		fileCtx := logContext.WithValues(ctx, "object", file.num)
+		content, err := doc.decodeStream(file.stream)
+		if err != nil {
+			fileCtx.Logger().Error(err, "error decoding embedded file")
+			h.metrics.incErrors()
+			if len(content) == 0 {
+				continue
+			}
+		}
+		if err := h.handleNestedFile(fileCtx, bytes.NewReader(content), dataChan); err != nil {
+			fileCtx.Logger().Error(err, "error handling embedded file")
+			h.metrics.incErrors()
+		}
+	}
+	return nil
+}
+
+// sendText sends text of the document that starts after lineOffset lines of it, unless it is blank.
+func (h *pdfHandler) sendText(ctx logContext.Context, lineOffset int64, text string, dataChan chan chunkData) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	return h.sendTextAt(ctx, lineOffset, text, dataChan)
+}
+
+// countLines returns the number of lines of text, including a last line without a newline.
+func countLines(text string) int64 {
+	lines := int64(strings.Count(text, "\n"))
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		lines++
+	}
+	return lines
+}
+
+// catalog returns the root of the document, from the trailer or, for damaged files, by looking for it.
+func (d *pdfDocument) catalog() pdfDict {
+	for _, trailer := range d.trailers {
+		if root := d.dict(trailer["Root"]); root != nil {
+			return root
+		}
+	}
+	for _, num := range d.objectNumbers() {
+		if dict, ok := d.objects[num].(pdfDict); ok && dict["Type"] == pdfName("Catalog") {
+			return dict
+		}
+	}
+	return nil
+}
+
+func (d *pdfDocument) objectNumbers() []int {
+	nums := make([]int, 0, len(d.objects))
+	for num := range d.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// pdfPage is a page of the document, along with its resources, which may be inherited from the page tree.
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict
+}
+
+// pages returns the pages of the document in order. For damaged files without a page tree, the page objects
+// are returned in the order of their numbers.
+func (d *pdfDocument) pages() []pdfPage {
+	var pages []pdfPage
+	visited := map[pdfRef]bool{}
+	var walk func(node any, resources pdfDict)
+	walk = func(node any, resources pdfDict) {
+		if ref, ok := node.(pdfRef); ok {
+			if visited[ref] {
+				return
+			}
+			visited[ref] = true
+		}
+		dict := d.dict(node)
+		if dict == nil {
+			return
+		}
+		if r := d.dict(dict["Resources"]); r != nil {
+			resources = r
+		}
+		if dict["Type"] == pdfName("Page") {
+			pages = append(pages, pdfPage{dict: dict, resources: resources})
+			return
+		}
+		for _, kid := range d.array(dict["Kids"]) {
+			walk(kid, resources)
+		}
+	}
+	if root := d.catalog(); root != nil {
+		walk(root["Pages"], nil)
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	for _, num := range d.objectNumbers() {
+		if dict, ok := d.objects[num].(pdfDict); ok && dict["Type"] == pdfName("Page") {
+			pages = append(pages, pdfPage{dict: dict, resources: d.dict(dict["Resources"])})
+		}
+	}
+	return pages
+}
+
+// pageText returns the text drawn by the content streams of a page.
+func (d *pdfDocument) pageText(page pdfPage) string {
+	var content []byte
+	for _, obj := range d.array(page.dict["Contents"]) {
+		s, ok := d.resolve(obj).(*pdfStream)
+		if !ok {
+			continue
+		}
+		data, _ := d.decodeStream(s)
+		// The streams of a page are concatenated, and may split operations between them.
+		content = append(content, data...)
+		content = append(content, '\n')
+	}
+
+	var out strings.Builder
+	d.showText(content, page.resources, &out, 0)
+	return out.String()
+}
+
+// showText writes the text shown by the operators of a content stream, with a line per line of text. Kerning
+// wide enough to separate words is written as a space.
+func (d *pdfDocument) showText(content []byte, resources pdfDict, out *strings.Builder, depth int) {
+	if depth > pdfMaxFormDepth {
+		return
+	}
+	fonts := d.dict(resources["Font"])
+	xObjects := d.dict(resources["XObject"])
+	font := &pdfFont{}
+
+	l := &pdfLexer{data: content}
+	var operands []any
+	for {
+		start := l.pos
+		obj, err := l.object()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			if l.pos == start {
+				l.pos++
+			}
+			operands = operands[:0]
+			continue
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			// Operands of a single operator are few; this limits the memory of garbage content.
+			if len(operands) < 64 {
+				operands = append(operands, obj)
+			}
+			continue
+		}
+
+		switch op {
+		case "Tf":
+			if len(operands) >= 1 {
+				if name, ok := operands[0].(pdfName); ok {
+					font = d.font(fonts[name])
+				}
+			}
+		case "Tj":
+			writeOperand(out, font, operands, 0)
+		case "'":
+			separate(out, '\n')
+			writeOperand(out, font, operands, 0)
+		case "\"":
+			separate(out, '\n')
+			writeOperand(out, font, operands, 2)
+		case "TJ":
+			if len(operands) >= 1 {
+				arr, _ := operands[0].(pdfArray)
+				for _, item := range arr {
+					switch v := item.(type) {
+					case pdfString:
+						out.WriteString(font.decode(v))
+					case float64:
+						if v < -250 {
+							separate(out, ' ')
+						}
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				if ty, _ := operands[1].(float64); ty != 0 {
+					separate(out, '\n')
+				} else if tx, _ := operands[0].(float64); tx != 0 {
+					separate(out, ' ')
+				}
+			}
+		case "T*", "Tm", "ET":
+			separate(out, '\n')
+		case "ID":
+			// The data of inline images isn't made of objects; skip it up to the EI operator.
+			l.pos = skipInlineImage(content, l.pos)
+		case "Do":
+			if len(operands) >= 1 {
+				if name, ok := operands[0].(pdfName); ok {
+					d.showForm(xObjects[name], resources, out, depth)
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+// showForm writes the text of a form XObject, which is a content stream drawn by other ones.
+func (d *pdfDocument) showForm(obj any, resources pdfDict, out *strings.Builder, depth int) {
+	s, ok := d.resolve(obj).(*pdfStream)
+	if !ok || s.dict["Subtype"] != pdfName("Form") {
+		return
+	}
+	if r := d.dict(s.dict["Resources"]); r != nil {
+		resources = r
+	}
+	data, _ := d.decodeStream(s)
+	d.showText(data, resources, out, depth+1)
+}
+
+// separate writes a space or a line break between pieces of text, unless the text already ends with one.
+func separate(out *strings.Builder, sep byte) {
+	text := out.String()
+	if text == "" {
+		return
+	}
+	if last := text[len(text)-1]; last != '\n' && last != sep {
+		out.WriteByte(sep)
+	}
+}
+
+func writeOperand(out *strings.Builder, font *pdfFont, operands []any, i int) {
+	if i < len(operands) {
+		if s, ok := operands[i].(pdfString); ok {
+			out.WriteString(font.decode(s))
+		}
+	}
+}
+
+// skipInlineImage returns the position following the EI operator that ends the data of an inline image.
+func skipInlineImage(content []byte, pos int) int {
+	for i := pos; i+2 <= len(content); i++ {
+		if content[i] == 'E' && content[i+1] == 'I' && i > 0 && isPDFSpace(content[i-1]) &&
+			(i+2 == len(content) || isPDFSpace(content[i+2])) {
+			return i + 2
+		}
+	}
+	return len(content)
+}
+
+// formField is a field of an interactive form, named after its fully qualified name, such as "account.password".
+type formField struct{ name, value string }
+
+// formFields returns the values of the fields of the interactive form of the document, one per line, such as
+// "account.password: value".
+func (d *pdfDocument) formFields() string {
+	root := d.catalog()
+	if root == nil {
+		return ""
+	}
+	form := d.dict(root["AcroForm"])
+	if form == nil {
+		return ""
+	}
+
+	var fields []formField
+	visited := map[pdfRef]bool{}
+	var walk func(node any, parent string, depth int)
+	walk = func(node any, parent string, depth int) {
+		if ref, ok := node.(pdfRef); ok {
+			if visited[ref] {
+				return
+			}
+			visited[ref] = true
+		}
+		dict := d.dict(node)
+		if dict == nil || depth > pdfMaxFormDepth {
+			return
+		}
+		name := parent
+		if partial, ok := d.resolve(dict["T"]).(pdfString); ok {
+			if name != "" {
+				name += "."
+			}
+			name += decodeTextString(partial)
+		}
+		if value := d.fieldValue(dict["V"]); value != "" {
+			fields = append(fields, formField{name: name, value: value})
+		}
+		for _, kid := range d.array(dict["Kids"]) {
+			walk(kid, name, depth+1)
+		}
+	}
+	for _, field := range d.array(form["Fields"]) {
+		walk(field, "", 0)
+	}
+
+	var out strings.Builder
+	for _, field := range fields {
+		fmt.Fprintf(&out, "%s: %s\n", field.name, field.value)
+	}
+	return out.String()
+}
+
+// fieldValue returns the value of a form field, which is text for text fields, a name for check boxes and radio
+// buttons, and an array of them for list boxes. Rich text values are streams.
+func (d *pdfDocument) fieldValue(obj any) string {
+	switch v := d.resolve(obj).(type) {
+	case pdfString:
+		return decodeTextString(v)
+	case pdfName:
+		return string(v)
+	case *pdfStream:
+		data, _ := d.decodeStream(v)
+		return decodeTextString(data)
+	case pdfArray:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := d.resolve(item).(pdfString); ok {
+				values = append(values, decodeTextString(s))
+			}
+		}
+		return strings.Join(values, ", ")
+	}
+	return ""
+}
+
+// pdfEmbeddedFile is the stream of a file attached to the document.
+type pdfEmbeddedFile struct {
+	num    int
+	stream *pdfStream
+}
+
+// embeddedFiles returns the files attached to the document or to its annotations.
+func (d *pdfDocument) embeddedFiles() []pdfEmbeddedFile {
+	var files []pdfEmbeddedFile
+	for _, num := range d.objectNumbers() {
+		if s, ok := d.objects[num].(*pdfStream); ok && s.dict["Type"] == pdfName("EmbeddedFile") {
+			files = append(files, pdfEmbeddedFile{num: num, stream: s})
+		}
+	}
+	return files
+}
+
+// pdfFont decodes the strings shown with a font into text.
+type pdfFont struct {
+	// toUnicode maps the character codes of the font to text, when the font has a ToUnicode CMap.
+	toUnicode *pdfCMap
+	// composite is set for Type0 fonts, whose codes are glyph identifiers that can't be decoded without a CMap.
+	composite bool
+	// differences overrides the standard encoding of simple fonts.
+	differences map[byte]string
+}
+
+// font returns the font of a font resource, caching it by object.
+func (d *pdfDocument) font(obj any) *pdfFont {
+	ref, isRef := obj.(pdfRef)
+	if isRef {
+		if font, ok := d.fonts[ref]; ok {
+			return font
+		}
+	}
+
+	font := &pdfFont{}
+	dict := d.dict(obj)
+	if dict != nil {
+		font.composite = dict["Subtype"] == pdfName("Type0")
+		if s, ok := d.resolve(dict["ToUnicode"]).(*pdfStream); ok {
+			data, _ := d.decodeStream(s)
+			font.toUnicode = parseCMap(data)
+		}
+		if encoding := d.dict(dict["Encoding"]); encoding != nil {
+			font.differences = d.differences(encoding["Differences"])
+		}
+	}
+
+	if isRef {
+		if d.fonts == nil {
+			d.fonts = map[pdfRef]*pdfFont{}
+		}
+		d.fonts[ref] = font
+	}
+	return font
+}
+
+// differences reads the Differences array of an encoding, made of codes followed by the names of the glyphs of
+// consecutive codes.
+func (d *pdfDocument) differences(obj any) map[byte]string {
+	diffs := map[byte]string{}
+	code := 0
+	for _, item := range d.array(obj) {
+		switch v := item.(type) {
+		case float64:
+			code = int(v)
+		case pdfName:
+			if text, ok := glyphText(string(v)); ok && code >= 0 && code < 256 {
+				diffs[byte(code)] = text
+			}
+			code++
+		}
+	}
+	return diffs
+}
+
+// pdfGlyphNames maps the names of common glyphs, other than letters, to their text.
+var pdfGlyphNames = map[string]string{
+	"space": " ", "exclam": "!", "quotedbl": "\"", "numbersign": "#", "dollar": "$", "percent": "%",
+	"ampersand": "&", "quotesingle": "'", "quoteright": "'", "parenleft": "(", "parenright": ")", "asterisk": "*",
+	"plus": "+", "comma": ",", "hyphen": "-", "period": ".", "slash": "/", "colon": ":", "semicolon": ";",
+	"less": "<", "equal": "=", "greater": ">", "question": "?", "at": "@", "bracketleft": "[",
+	"backslash": "\\", "bracketright": "]", "asciicircum": "^", "underscore": "_", "grave": "`",
+	"quoteleft": "`", "braceleft": "{", "bar": "|", "braceright": "}", "asciitilde": "~",
+	"zero": "0", "one": "1", "two": "2", "three": "3", "four": "4",
+	"five": "5", "six": "6", "seven": "7", "eight": "8", "nine": "9",
+}
+
+// glyphText returns the text of a glyph name: a letter, a common glyph, or a uniXXXX name.
+func glyphText(name string) (string, bool) {
+	if len(name) == 1 && (name[0] >= 'a' && name[0] <= 'z' || name[0] >= 'A' && name[0] <= 'Z') {
+		return name, true
+	}
+	if text, ok := pdfGlyphNames[name]; ok {
+		return text, true
+	}
+	if len(name) == 7 && strings.HasPrefix(name, "uni") {
+		if r, err := strconv.ParseUint(name[3:], 16, 16); err == nil {
+			return string(rune(r)), true
+		}
+	}
+	return "", false
+}
+
+// decode returns the text of a string shown with the font. The codes of simple fonts without a ToUnicode CMap are
+// decoded as Latin-1, which matches the standard encodings for the printable ASCII characters secrets are made of.
+func (f *pdfFont) decode(s pdfString) string {
+	if f.toUnicode != nil {
+		return f.toUnicode.decode(s)
+	}
+	if f.composite {
+		return ""
+	}
+	var out strings.Builder
+	for _, b := range s {
+		if text, ok := f.differences[b]; ok {
+			out.WriteString(text)
+			continue
+		}
+		out.WriteRune(rune(b))
+	}
+	return out.String()
+}
+
+// pdfCMap is a ToUnicode CMap, which maps the character codes of a font to text.
+type pdfCMap struct {
+	// codeLengths are the lengths in bytes of the codes of the code space, in increasing order.
+	codeLengths []int
+	mappings    map[string]string
+}
+
+// pdfMaxCMapRange limits the size of the ranges of a CMap, which are expanded into mappings.
+const pdfMaxCMapRange = 1 << 16
+
+// parseCMap reads the code space and the bfchar and bfrange mappings of a ToUnicode CMap.
+func parseCMap(data []byte) *pdfCMap {
+	cmap := &pdfCMap{mappings: map[string]string{}}
+	lengths := map[int]bool{}
+
+	l := &pdfLexer{data: data}
+	var operands []any
+	for {
+		obj, err := l.object()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		switch op {
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				if lo, ok := operands[i].(pdfString); ok && len(lo) > 0 {
+					lengths[len(lo)] = true
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					cmap.mappings[string(src)] = decodeUTF16BE(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 && len(lo) == len(hi) {
+					cmap.addRange(lo, hi, operands[i+2])
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+
+	for n := range lengths {
+		cmap.codeLengths = append(cmap.codeLengths, n)
+	}
+	if len(cmap.codeLengths) == 0 {
+		for src := range cmap.mappings {
+			lengths[len(src)] = true
+		}
+		for n := range lengths {
+			cmap.codeLengths = append(cmap.codeLengths, n)
+		}
+	}
+	sort.Ints(cmap.codeLengths)
+	return cmap
+}
+
+// addRange maps the codes from lo to hi, which differ in their last byte. The destination is either the text of
+// the first code, incremented for the following ones, or an array of the text of every code.
+func (c *pdfCMap) addRange(lo, hi pdfString, dst any) {
+	last := len(lo) - 1
+	if !bytes.Equal(lo[:last], hi[:last]) || lo[last] > hi[last] {
+		return
+	}
+	code := append(pdfString{}, lo...)
+	for i := 0; i <= int(hi[last]-lo[last]) && len(c.mappings) < pdfMaxCMapRange; i++ {
+		code[last] = lo[last] + byte(i)
+		switch v := dst.(type) {
+		case pdfString:
+			text := []rune(decodeUTF16BE(v))
+			if len(text) > 0 {
+				text[len(text)-1] += rune(i)
+			}
+			c.mappings[string(code)] = string(text)
+		case pdfArray:
+			if i < len(v) {
+				if s, ok := v[i].(pdfString); ok {
+					c.mappings[string(code)] = decodeUTF16BE(s)
+				}
+			}
+		}
+	}
+}
+
+// decode returns the text of a string, reading the shortest code of the code space that has a mapping at every
+// position. Unmapped bytes are skipped.
+func (c *pdfCMap) decode(s pdfString) string {
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		matched := false
+		for _, n := range c.codeLengths {
+			if i+n > len(s) {
+				break
+			}
+			if text, ok := c.mappings[string(s[i:i+n])]; ok {
+				out.WriteString(text)
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			// Skip a code of the shortest length.
+			i += max(1, c.codeLengths[0])
+		}
+	}
+	return out.String()
+}
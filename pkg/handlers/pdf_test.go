@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// pdfText is text sent for a PDF document, at the line that precedes it.
+type pdfText struct {
+	data string
+	line int64
+}
+
+func TestHandlePDFFile(t *testing.T) {
+	document := readTestFile(t, "testdata/document.pdf")
+
+	// The text of the pages and the form fields is sent with the number of lines of the document that precede it.
+	want := []pdfText{
+		{"AWS key: AKIAZZZEXAMPLEKEY\nsplit words\nunder_score\nfrom form xobject\n", 0},
+		{"ecrs 01234\n", 4},
+		{"account.password: hunter2 é\naccount.remember: Yes\n", 5},
+		{"attached secret: ghp_embedded\n", 0},
+	}
+
+	tests := []struct {
+		name     string
+		document []byte
+		want     []pdfText
+	}{
+		{name: "document", document: document, want: want},
+		{name: "object streams", document: readTestFile(t, "testdata/compressed.pdf"), want: want},
+		{
+			name: "encrypted",
+			document: []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n" +
+				"trailer\n<< /Root 1 0 R /Encrypt << /Filter /Standard >> >>\n%%EOF\n"),
+		},
+		{name: "truncated", document: document[:len(document)/2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			rdr, err := newFileReader(bytes.NewReader(tt.document))
+			require.NoError(t, err)
+			defer rdr.Close()
+			assert.Equal(t, pdfMime, mimeType(rdr.mime.String()))
+
+			chunkCh := make(chan *sources.Chunk, 64)
+			require.NoError(t, HandleFile(ctx, bytes.NewReader(tt.document), filesystemChunk(), sources.ChanReporter{Ch: chunkCh}))
+			close(chunkCh)
+
+			var got []pdfText
+			for chunk := range chunkCh {
+				got = append(got, pdfText{string(chunk.Data), chunk.SourceMetadata.GetFilesystem().GetLine()})
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+			for _, text := range got {
+				assert.NotContains(t, text.data, "not text")
+			}
+		})
+	}
+}
+
+func TestHandlePDFFile_MaxSize(t *testing.T) {
+	document := readTestFile(t, "testdata/document.pdf")
+
+	// Documents over the smaller of pdfMaxSize and the configured maximum size are skipped rather than read.
+	defer SetArchiveMaxSize(maxSize)
+	SetArchiveMaxSize(len(document) - 1)
+
+	chunkCh := make(chan *sources.Chunk, 64)
+	require.NoError(t, HandleFile(context.Background(), bytes.NewReader(document), filesystemChunk(), sources.ChanReporter{Ch: chunkCh}))
+	close(chunkCh)
+	assert.Empty(t, chunkCh)
+}
+
+func TestPDFDecodeStreamLimits(t *testing.T) {
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, err := w.Write(make([]byte, pdfMaxStreamSize+1))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	stream := &pdfStream{dict: pdfDict{"Filter": pdfName("FlateDecode")}, raw: compressed.Bytes()}
+
+	// Streams are truncated to their maximum size.
+	d := &pdfDocument{}
+	data, err := d.decodeStream(stream)
+	assert.Error(t, err)
+	assert.Len(t, data, pdfMaxStreamSize)
+
+	// Streams are no longer decompressed once the document reaches its maximum size.
+	d.inflated = pdfMaxInflatedSize
+	data, err = d.decodeStream(stream)
+	assert.Error(t, err)
+	assert.Empty(t, data)
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,566 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// This file implements the subset of PDF (ISO 32000) needed to extract text: the object syntax, indirect objects
+// and object streams, and the common stream filters. Cross-reference tables are ignored in favor of scanning the
+// file for objects, which also recovers objects of damaged files.
+
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  []byte
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num, gen int }
+	pdfStream  struct {
+		dict pdfDict
+		raw  []byte
+	}
+)
+
+var (
+	errPDFSyntax = errors.New("invalid PDF syntax")
+
+	// pdfObjectPat matches the header of an indirect object, such as "12 0 obj".
+	pdfObjectPat = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	// pdfTrailerPat matches the start of a trailer dictionary.
+	pdfTrailerPat = regexp.MustCompile(`trailer\s*<<`)
+)
+
+// pdfMaxResolveDepth limits chains of references, which may be circular in malicious files.
+const pdfMaxResolveDepth = 32
+
+// Compressed streams may expand to many times their size, so their decompressed size is limited, both per stream
+// and for all the streams of a document.
+const (
+	pdfMaxStreamSize   = 1024 * sources.ChunkSize
+	pdfMaxInflatedSize = 16 * pdfMaxStreamSize
+)
+
+// pdfLexer reads PDF objects from a file or a content stream.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFSpace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+// skipSpace skips whitespace and comments.
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case isPDFSpace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// regular reads a run of regular characters, which make up numbers, keywords and names.
+func (l *pdfLexer) regular() []byte {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return l.data[start:l.pos]
+}
+
+// object reads the next object. Keywords other than true, false and null, such as the operators of content streams,
+// are returned as pdfKeyword. It returns io.EOF at the end of the data.
+func (l *pdfLexer) object() (any, error) {
+	return l.objectDepth(0)
+}
+
+func (l *pdfLexer) objectDepth(depth int) (any, error) {
+	if depth > pdfMaxResolveDepth {
+		return nil, fmt.Errorf("%w: objects nested too deeply", errPDFSyntax)
+	}
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, io.EOF
+	}
+
+	switch c := l.data[l.pos]; c {
+	case '/':
+		l.pos++
+		return pdfName(decodeName(l.regular())), nil
+	case '(':
+		l.pos++
+		return l.literalString(), nil
+	case '<':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+			l.pos += 2
+			return l.dict(depth)
+		}
+		l.pos++
+		return l.hexString(), nil
+	case '[':
+		l.pos++
+		var arr pdfArray
+		for {
+			l.skipSpace()
+			if l.pos >= len(l.data) {
+				return arr, fmt.Errorf("%w: unterminated array", errPDFSyntax)
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				return arr, nil
+			}
+			obj, err := l.objectDepth(depth + 1)
+			if err != nil {
+				return arr, err
+			}
+			arr = append(arr, obj)
+		}
+	case '>', ']', ')', '{', '}':
+		// Stray delimiters are returned as keywords, so that callers can skip them.
+		l.pos++
+		if c == '>' && l.pos < len(l.data) && l.data[l.pos] == '>' {
+			l.pos++
+			return pdfKeyword(">>"), nil
+		}
+		return pdfKeyword(c), nil
+	}
+
+	token := l.regular()
+	if len(token) == 0 {
+		l.pos++
+		return pdfKeyword(l.data[l.pos-1]), nil
+	}
+	switch string(token) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(string(token), 64)
+	if err != nil {
+		return pdfKeyword(token), nil
+	}
+
+	// An integer may start a reference, such as "12 0 R".
+	if num, err := strconv.Atoi(string(token)); err == nil {
+		save := l.pos
+		l.skipSpace()
+		if gen, err := strconv.Atoi(string(l.regular())); err == nil {
+			l.skipSpace()
+			if string(l.regular()) == "R" {
+				return pdfRef{num: num, gen: gen}, nil
+			}
+		}
+		l.pos = save
+	}
+	return n, nil
+}
+
+func (l *pdfLexer) dict(depth int) (pdfDict, error) {
+	dict := pdfDict{}
+	for {
+		l.skipSpace()
+		if l.pos+1 < len(l.data) && l.data[l.pos] == '>' && l.data[l.pos+1] == '>' {
+			l.pos += 2
+			return dict, nil
+		}
+		key, err := l.objectDepth(depth + 1)
+		if err != nil {
+			return dict, err
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			return dict, fmt.Errorf("%w: dictionary key is not a name", errPDFSyntax)
+		}
+		value, err := l.objectDepth(depth + 1)
+		if err != nil {
+			return dict, err
+		}
+		dict[name] = value
+	}
+}
+
+// literalString reads a string in parentheses, after the opening one.
+func (l *pdfLexer) literalString() pdfString {
+	var out []byte
+	nesting := 0
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			nesting++
+		case ')':
+			if nesting == 0 {
+				return out
+			}
+			nesting--
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			c = l.data[l.pos]
+			l.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// A backslash at the end of a line continues the string.
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if c >= '0' && c <= '7' {
+					// Up to three octal digits.
+					v := int(c - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(v)
+				}
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// hexString reads a string in angle brackets, after the opening one.
+func (l *pdfLexer) hexString() pdfString {
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	n, _ := hex.Decode(out, digits)
+	return out[:n]
+}
+
+// decodeName resolves the #xx escapes of a name.
+func decodeName(raw []byte) string {
+	if !bytes.Contains(raw, []byte("#")) {
+		return string(raw)
+	}
+	var out []byte
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '#' && i+2 < len(raw) {
+			if b, err := strconv.ParseUint(string(raw[i+1:i+3]), 16, 8); err == nil {
+				out = append(out, byte(b))
+				i += 2
+				continue
+			}
+		}
+		out = append(out, raw[i])
+	}
+	return string(out)
+}
+
+// pdfDocument holds the objects of a PDF file.
+type pdfDocument struct {
+	objects  map[int]any
+	trailers []pdfDict
+	// fonts caches the fonts of the document by object, as decoding their CMaps is costly.
+	fonts map[pdfRef]*pdfFont
+	// inflated is the number of bytes decompressed from the streams of the document.
+	inflated int
+}
+
+// parsePDF reads every indirect object of the file, including those of object streams.
+func parsePDF(data []byte) (*pdfDocument, error) {
+	doc := &pdfDocument{objects: map[int]any{}}
+
+	for pos := 0; pos < len(data); {
+		loc := pdfObjectPat.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		l := &pdfLexer{data: data, pos: pos + loc[1]}
+		obj, err := l.object()
+		if err != nil {
+			pos += loc[1]
+			continue
+		}
+		if dict, ok := obj.(pdfDict); ok {
+			obj = l.stream(dict)
+		}
+		doc.objects[num] = obj
+		pos = l.pos
+	}
+	if len(doc.objects) == 0 {
+		return nil, fmt.Errorf("%w: no objects found", errPDFSyntax)
+	}
+
+	for _, loc := range pdfTrailerPat.FindAllIndex(data, -1) {
+		l := &pdfLexer{data: data, pos: loc[1]}
+		if trailer, err := l.dict(0); err == nil {
+			doc.trailers = append(doc.trailers, trailer)
+		}
+	}
+
+	// Objects of object streams don't override objects defined directly.
+	var objStms []*pdfStream
+	for _, obj := range doc.objects {
+		if s, ok := obj.(*pdfStream); ok {
+			switch s.dict["Type"] {
+			case pdfName("ObjStm"):
+				objStms = append(objStms, s)
+			case pdfName("XRef"):
+				doc.trailers = append(doc.trailers, s.dict)
+			}
+		}
+	}
+	for _, s := range objStms {
+		doc.readObjectStream(s)
+	}
+	return doc, nil
+}
+
+// stream reads the data of a stream following its dictionary, if any. Its length is found by looking for the
+// endstream keyword when the /Length entry is indirect or wrong.
+func (l *pdfLexer) stream(dict pdfDict) any {
+	l.skipSpace()
+	if !bytes.HasPrefix(l.data[l.pos:], []byte("stream")) {
+		return dict
+	}
+	l.pos += len("stream")
+	if l.pos < len(l.data) && l.data[l.pos] == '\r' {
+		l.pos++
+	}
+	if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+		l.pos++
+	}
+	start := l.pos
+
+	if length, ok := dict["Length"].(float64); ok && length >= 0 && start+int(length) <= len(l.data) {
+		end := start + int(length)
+		rest := bytes.TrimLeft(l.data[end:], "\r\n \t")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			l.pos = len(l.data) - len(rest) + len("endstream")
+			return &pdfStream{dict: dict, raw: l.data[start:end]}
+		}
+	}
+
+	end := bytes.Index(l.data[start:], []byte("endstream"))
+	if end < 0 {
+		l.pos = len(l.data)
+		return &pdfStream{dict: dict, raw: l.data[start:]}
+	}
+	l.pos = start + end + len("endstream")
+	raw := bytes.TrimSuffix(l.data[start:start+end], []byte("\n"))
+	return &pdfStream{dict: dict, raw: bytes.TrimSuffix(raw, []byte("\r"))}
+}
+
+// readObjectStream reads the objects compressed in an object stream: a header of object number and offset pairs,
+// followed by the objects starting at the /First offset.
+func (d *pdfDocument) readObjectStream(s *pdfStream) {
+	data, err := d.decodeStream(s)
+	if err != nil {
+		return
+	}
+	n, _ := d.resolve(s.dict["N"]).(float64)
+	first, _ := d.resolve(s.dict["First"]).(float64)
+	if first < 0 || int(first) > len(data) {
+		return
+	}
+
+	header := &pdfLexer{data: data[:int(first)]}
+	for i := 0; i < int(n); i++ {
+		num, err1 := header.object()
+		offset, err2 := header.object()
+		if err1 != nil || err2 != nil {
+			return
+		}
+		objNum, ok1 := num.(float64)
+		objOffset, ok2 := offset.(float64)
+		if !ok1 || !ok2 || int(first)+int(objOffset) >= len(data) {
+			return
+		}
+		if _, ok := d.objects[int(objNum)]; ok {
+			continue
+		}
+		l := &pdfLexer{data: data, pos: int(first) + int(objOffset)}
+		if obj, err := l.object(); err == nil {
+			d.objects[int(objNum)] = obj
+		}
+	}
+}
+
+// resolve follows references to the object they point at.
+func (d *pdfDocument) resolve(obj any) any {
+	for i := 0; i < pdfMaxResolveDepth; i++ {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj
+		}
+		obj = d.objects[ref.num]
+	}
+	return nil
+}
+
+// dict resolves obj into a dictionary, which is the one of a stream for streams.
+func (d *pdfDocument) dict(obj any) pdfDict {
+	switch v := d.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.dict
+	}
+	return nil
+}
+
+func (d *pdfDocument) array(obj any) pdfArray {
+	switch v := d.resolve(obj).(type) {
+	case pdfArray:
+		return v
+	case nil:
+		return nil
+	default:
+		// Single values are allowed where arrays are expected, such as for filters and contents.
+		return pdfArray{v}
+	}
+}
+
+// isEncrypted reports whether the strings and streams of the document are encrypted.
+func (d *pdfDocument) isEncrypted() bool {
+	for _, trailer := range d.trailers {
+		if _, ok := trailer["Encrypt"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeStream returns the data of a stream, decoded with its filters. Data that fails to decode midway is
+// returned up to the failure, as damaged streams are common.
+func (d *pdfDocument) decodeStream(s *pdfStream) ([]byte, error) {
+	data := s.raw
+	for _, filter := range d.array(s.dict["Filter"]) {
+		var err error
+		switch d.resolve(filter) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			limit := min(pdfMaxStreamSize, pdfMaxInflatedSize-d.inflated)
+			if limit <= 0 {
+				return nil, fmt.Errorf("document exceeds %d decompressed bytes", pdfMaxInflatedSize)
+			}
+			data, err = inflate(data, limit)
+			d.inflated += len(data)
+		case pdfName("ASCIIHexDecode"), pdfName("AHx"):
+			data = (&pdfLexer{data: append(bytes.TrimSpace(data), '>')}).hexString()
+		case pdfName("ASCII85Decode"), pdfName("A85"):
+			data, err = decodeASCII85(data)
+		default:
+			return nil, fmt.Errorf("unsupported stream filter: %v", filter)
+		}
+		if err != nil {
+			return data, err
+		}
+	}
+	return data, nil
+}
+
+// inflate decompresses data of up to limit bytes. Larger data is truncated to limit bytes.
+func inflate(data []byte, limit int) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing stream: %w", err)
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("error decompressing stream: %w", err)
+	}
+	if len(out) > limit {
+		return out[:limit], fmt.Errorf("stream exceeds %d decompressed bytes", limit)
+	}
+	return out, nil
+}
+
+func decodeASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if i := bytes.Index(data, []byte("~>")); i >= 0 {
+		data = data[:i]
+	}
+	out := make([]byte, len(data))
+	n, _, err := ascii85.Decode(out, data, true)
+	return out[:n], err
+}
+
+// decodeTextString decodes a text string, such as the value of a form field, which is encoded as UTF-16BE with
+// a byte order mark, UTF-8 with a byte order mark, or PDFDocEncoding.
+func decodeTextString(s pdfString) string {
+	switch {
+	case len(s) >= 2 && s[0] == 0xfe && s[1] == 0xff:
+		return decodeUTF16BE(s[2:])
+	case len(s) >= 3 && s[0] == 0xef && s[1] == 0xbb && s[2] == 0xbf:
+		return string(s[3:])
+	}
+	// PDFDocEncoding matches Latin-1 for printable characters.
+	runes := make([]rune, len(s))
+	for i, b := range s {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+func decodeUTF16BE(b []byte) string {
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
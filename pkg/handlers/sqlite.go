@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// sqliteHandler handles SQLite databases, such as the Cookies and Login Data files of browsers. Their values are
+// stored in pages of b-trees, prefixed with variable-length integers and possibly split across overflow pages, so
+// the rows of every table are read and sent as text instead.
+type sqliteHandler struct{ *defaultHandler }
+
+// newSQLiteHandler creates a sqliteHandler.
+func newSQLiteHandler() *sqliteHandler {
+	return &sqliteHandler{defaultHandler: newDefaultHandler(sqliteHandlerType)}
+}
+
+// HandleFile processes SQLite databases, sending the rows of every table.
+func (h *sqliteHandler) HandleFile(ctx logContext.Context, input fileReader) (chan chunkData, error) {
+	dataChan := make(chan chunkData, defaultBufferSize)
+
+	go func() {
+		ctx, cancel := logContext.WithTimeout(ctx, maxTimeout)
+		defer cancel()
+		defer close(dataChan)
+
+		// Update the metrics for the file processing.
+		start := time.Now()
+		var err error
+		defer func() {
+			h.measureLatencyAndHandleErrors(start, err)
+			h.metrics.incFilesProcessed()
+		}()
+
+		// Defer a panic recovery to handle any panics that occur during the database processing.
+		defer func() {
+			if r := recover(); r != nil {
+				// Return the panic as an error.
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = fmt.Errorf("panic occurred: %v", r)
+				}
+				ctx.Logger().Error(err, "Panic occurred when reading SQLite database")
+			}
+		}()
+
+		if err = h.processDatabase(ctx, input, dataChan); err != nil {
+			ctx.Logger().Error(err, "error processing SQLite database")
+		}
+	}()
+
+	return dataChan, nil
+}
+
+func (h *sqliteHandler) processDatabase(ctx logContext.Context, input fileReader, dataChan chan chunkData) error {
+	size, err := input.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("error getting database size: %w", err)
+	}
+	if size > int64(maxSize) {
+		ctx.Logger().V(3).Info("skipping database due to size", "size", size)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+	h.metrics.observeFileSize(size)
+
+	db, err := openSQLite(input, size)
+	if err != nil {
+		return fmt.Errorf("error opening database: %w", err)
+	}
+	tables, err := db.tables()
+	if err != nil {
+		return fmt.Errorf("error reading schema: %w", err)
+	}
+
+	for _, table := range tables {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		tableCtx := logContext.WithValues(ctx, "table", table.name)
+		if err := h.sendTable(tableCtx, db, table, dataChan); err != nil {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			// Send what can be read of the other tables of damaged databases.
+			tableCtx.Logger().Error(err, "error reading table")
+			h.metrics.incErrors()
+		}
+	}
+	return nil
+}
+
+// sendTable sends the rows of a table, one per line, such as "rowid 1: name=value, token=value". The rows of
+// WITHOUT ROWID tables are numbered in the order of their primary key instead, such as "row 1: ...". Rows are
+// grouped into chunks prefixed with the name of the table, such as "[table users]", so that findings can be
+// located in the database. Binary blobs, such as compressed or encrypted data, are handled with the handler of
+// their type.
+func (h *sqliteHandler) sendTable(ctx logContext.Context, db *sqliteDB, table sqliteTable, dataChan chan chunkData) error {
+	marker := fmt.Sprintf("[table %s]\n", table.name)
+	var buf strings.Builder
+
+	flush := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		data := []byte(marker + buf.String())
+		buf.Reset()
+		if err := common.CancellableWrite(ctx, dataChan, chunkData{data: data}); err != nil {
+			return err
+		}
+		h.metrics.incBytesProcessed(len(data))
+		return nil
+	}
+
+	var rows int64
+	err := db.walkTable(table.rootPage, func(rowid int64, values []any) error {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		rows++
+		if table.withoutRowid {
+			rowid = rows
+		}
+
+		for i, value := range values {
+			blob, ok := value.([]byte)
+			if !ok || utf8.Valid(blob) {
+				continue
+			}
+			blobCtx := logContext.WithValues(ctx, "row", rowid, "column", table.columnName(i))
+			if err := h.handleNestedFile(blobCtx, bytes.NewReader(blob), dataChan); err != nil {
+				blobCtx.Logger().Error(err, "error handling blob")
+				h.metrics.incErrors()
+			}
+		}
+		writeRow(&buf, table, rowid, values)
+		if buf.Len() >= sources.ChunkSize {
+			return flush()
+		}
+		return nil
+	})
+	if flushErr := flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// writeRow writes a row as column=value pairs. NULL values and binary blobs are left out.
+func writeRow(buf *strings.Builder, table sqliteTable, rowid int64, values []any) {
+	if table.withoutRowid {
+		fmt.Fprintf(buf, "row %d:", rowid)
+	} else {
+		fmt.Fprintf(buf, "rowid %d:", rowid)
+	}
+	sep := " "
+	for i, value := range values {
+		if i == table.rowidColumn && value == nil {
+			// The value of a rowid alias is the rowid.
+			value = rowid
+		}
+
+		var text string
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int64:
+			text = strconv.FormatInt(v, 10)
+		case float64:
+			text = strconv.FormatFloat(v, 'g', -1, 64)
+		case string:
+			text = v
+		case []byte:
+			if !utf8.Valid(v) {
+				continue
+			}
+			text = string(v)
+		}
+
+		buf.WriteString(sep)
+		buf.WriteString(table.columnName(i))
+		buf.WriteByte('=')
+		buf.WriteString(text)
+		sep = ", "
+	}
+	buf.WriteByte('\n')
+}
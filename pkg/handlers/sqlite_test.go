@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func handleTestFile(t *testing.T, data []byte) []string {
+	t.Helper()
+
+	var got []string
+	for _, chunk := range handleTestFileChunks(t, data) {
+		got = append(got, string(chunk.Data))
+	}
+	return got
+}
+
+// handleTestFileChunks handles a file and returns the chunks it is reported in.
+// filesystemChunk returns the skeleton of the chunks of a file of the filesystem, starting at line 0.
+func filesystemChunk() *sources.Chunk {
+	return &sources.Chunk{
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Filesystem{Filesystem: &source_metadatapb.Filesystem{File: "test"}},
+		},
+	}
+}
+
+func handleTestFileChunks(t *testing.T, data []byte) []*sources.Chunk {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	chunkCh := make(chan *sources.Chunk, 64)
+	require.NoError(t, HandleFile(ctx, bytes.NewReader(data), filesystemChunk(), sources.ChanReporter{Ch: chunkCh}))
+	close(chunkCh)
+
+	var got []*sources.Chunk
+	for chunk := range chunkCh {
+		got = append(got, chunk)
+	}
+	return got
+}
+
+func TestHandleSQLiteFile(t *testing.T) {
+	database := readTestFile(t, "testdata/test.sqlite")
+
+	rdr, err := newFileReader(bytes.NewReader(database))
+	require.NoError(t, err)
+	defer rdr.Close()
+	assert.Equal(t, sqliteMime, mimeType(rdr.mime.String()))
+
+	got := handleTestFile(t, database)
+	require.Len(t, got, 6)
+	// Binary blobs are handled on their own, before the rows they belong to.
+	assert.Equal(t, "\x89PNG", got[0])
+	assert.Equal(t, "[table credentials]\n"+
+		"rowid 1: id=1, service=github, token=ghp_examplevalue, expires=1.5\n"+
+		"rowid 2: id=2, service=aws, token=AKIAEXAMPLE, icon=text blob\n", got[1])
+
+	assert.True(t, strings.HasPrefix(got[2], "[table notes]\nrowid 1: body=xxx"))
+	assert.Contains(t, got[2], "x overflowing secret\nrowid 2: body=note 0\n")
+	assert.Contains(t, got[2], "rowid 201: body=note 199\nrowid 202: body=last note, author=alice\n")
+
+	// The rows of WITHOUT ROWID tables are stored in the order of their primary key, with its columns first.
+	assert.True(t, strings.HasPrefix(got[3], "[table settings]\n"+
+		"row 1: name=api_key, value=sk_live_withoutrowid\n"+
+		"row 2: name=setting 000, value=value 0\n"))
+	assert.True(t, strings.HasSuffix(got[3], "row 151: name=setting 149, value=value 149\n"))
+	assert.Equal(t, 151, strings.Count(got[3], "\nrow "))
+
+	assert.Equal(t, "password=gzipped_hunter2\n", got[4])
+	assert.Equal(t, "[table files]\nrowid 1: name=config.gz\n", got[5])
+}
+
+func TestHandleSQLiteFile_UTF16(t *testing.T) {
+	got := handleTestFile(t, readTestFile(t, "testdata/test-utf16.sqlite"))
+	assert.Equal(t, []string{"[table accounts]\nrowid 1: user=zoë, password=hunter2\n"}, got)
+}
+
+func TestHandleSQLiteFile_Truncated(t *testing.T) {
+	database := readTestFile(t, "testdata/test.sqlite")
+
+	// The pages that remain are still read.
+	got := strings.Join(handleTestFile(t, database[:len(database)/2]), "")
+	assert.Contains(t, got, "token=ghp_examplevalue")
+	assert.NotContains(t, got, "last note")
+}
@@ -0,0 +1,526 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// This file implements a reader of the SQLite database file format (https://www.sqlite.org/fileformat2.html),
+// limited to reading the rows of tables. Journals and write-ahead logs aren't read.
+
+var (
+	errSQLiteFormat = errors.New("invalid SQLite database")
+
+	sqliteMagic = []byte("SQLite format 3\x00")
+
+	// sqliteRowidAliasPat matches the definition of an INTEGER PRIMARY KEY column, which is an alias of the rowid,
+	// and isn't stored in records.
+	sqliteRowidAliasPat = regexp.MustCompile(`(?i)^\S+\s+INTEGER\s+PRIMARY\s+KEY\b`)
+	// sqliteWithoutRowidPat matches the end of the definition of a table stored in an index b-tree.
+	sqliteWithoutRowidPat = regexp.MustCompile(`(?i)\bWITHOUT\s+ROWID\s*;?\s*$`)
+	// sqlitePrimaryKeyPat matches a PRIMARY KEY constraint, and the columns of table constraints.
+	sqlitePrimaryKeyPat = regexp.MustCompile(`(?is)\bPRIMARY\s+KEY\b(?:\s*\((.*)\))?`)
+)
+
+// sqliteMaxTreeDepth limits the depth of b-trees, which may be circular in malicious files.
+const sqliteMaxTreeDepth = 64
+
+// Types of b-tree pages.
+const (
+	sqliteInteriorIndex = 0x02
+	sqliteInteriorTable = 0x05
+	sqliteLeafIndex     = 0x0a
+	sqliteLeafTable     = 0x0d
+)
+
+// Text encodings of the database.
+const (
+	sqliteUTF8    = 1
+	sqliteUTF16LE = 2
+	sqliteUTF16BE = 3
+)
+
+// sqliteDB reads the pages of a database.
+type sqliteDB struct {
+	r          io.ReaderAt
+	pageSize   int
+	usableSize int
+	pageCount  int
+	encoding   int
+}
+
+// openSQLite reads the header of a database of the given size.
+func openSQLite(r io.ReaderAt, size int64) (*sqliteDB, error) {
+	header := make([]byte, 100)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("error reading header: %w", err)
+	}
+	if !bytes.Equal(header[:16], sqliteMagic) {
+		return nil, fmt.Errorf("%w: bad magic", errSQLiteFormat)
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(header[16:18]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 {
+		return nil, fmt.Errorf("%w: bad page size %d", errSQLiteFormat, pageSize)
+	}
+	usableSize := pageSize - int(header[20])
+	if usableSize < 480 {
+		return nil, fmt.Errorf("%w: bad reserved space", errSQLiteFormat)
+	}
+
+	encoding := int(binary.BigEndian.Uint32(header[56:60]))
+	if encoding == 0 {
+		encoding = sqliteUTF8
+	}
+
+	return &sqliteDB{
+		r:          r,
+		pageSize:   pageSize,
+		usableSize: usableSize,
+		pageCount:  int(size / int64(pageSize)),
+		encoding:   encoding,
+	}, nil
+}
+
+// page reads a page by its number, which starts at 1.
+func (db *sqliteDB) page(n int) ([]byte, error) {
+	if n < 1 || n > db.pageCount {
+		return nil, fmt.Errorf("%w: page %d out of range", errSQLiteFormat, n)
+	}
+	buf := make([]byte, db.pageSize)
+	if _, err := db.r.ReadAt(buf, int64(n-1)*int64(db.pageSize)); err != nil {
+		return nil, fmt.Errorf("error reading page %d: %w", n, err)
+	}
+	return buf, nil
+}
+
+// sqliteTable is a table of the schema of a database.
+type sqliteTable struct {
+	name     string
+	rootPage int
+	columns  []string
+	// rowidColumn is the index of the column that is an alias of the rowid, or -1.
+	rowidColumn int
+	// withoutRowid is set for WITHOUT ROWID tables, whose rows are stored in index b-trees, with the columns of
+	// their primary key first.
+	withoutRowid bool
+}
+
+// columnName returns the name of the column at index i of the records of the table. Columns that couldn't be parsed
+// from the schema are named by position.
+func (t sqliteTable) columnName(i int) string {
+	if i < len(t.columns) {
+		return t.columns[i]
+	}
+	return "column" + strconv.Itoa(i+1)
+}
+
+// tables returns the tables of the schema, which is itself a table rooted at the first page.
+func (db *sqliteDB) tables() ([]sqliteTable, error) {
+	var tables []sqliteTable
+	err := db.walkTable(1, func(_ int64, values []any) error {
+		// The columns of the schema are type, name, tbl_name, rootpage and sql.
+		if len(values) < 5 {
+			return nil
+		}
+		kind, _ := values[0].(string)
+		name, _ := values[1].(string)
+		rootPage, _ := values[3].(int64)
+		sql, _ := values[4].(string)
+		if kind != "table" || rootPage <= 0 {
+			// Views, indexes and virtual tables have no rows of their own.
+			return nil
+		}
+		table := sqliteTable{name: name, rootPage: int(rootPage), withoutRowid: sqliteWithoutRowidPat.MatchString(sql)}
+		var primaryKey []string
+		table.columns, table.rowidColumn, primaryKey = parseColumns(sql)
+		if table.withoutRowid {
+			// Tables without rowids have no rowid alias, and store their columns in the order of their index.
+			table.rowidColumn = -1
+			table.columns = keyColumnsFirst(table.columns, primaryKey)
+		}
+		tables = append(tables, table)
+		return nil
+	})
+	return tables, err
+}
+
+// walkTable calls visit with the rowid and values of every row of the b-tree rooted at root, in order. The rows of
+// index b-trees, which store WITHOUT ROWID tables, have no rowid and are visited with a rowid of 0.
+func (db *sqliteDB) walkTable(root int, visit func(rowid int64, values []any) error) error {
+	visited := map[int]bool{}
+	var walk func(n, depth int) error
+	walk = func(n, depth int) error {
+		if visited[n] || depth > sqliteMaxTreeDepth {
+			return fmt.Errorf("%w: b-tree cycle at page %d", errSQLiteFormat, n)
+		}
+		visited[n] = true
+
+		page, err := db.page(n)
+		if err != nil {
+			return err
+		}
+		// The first page starts with the header of the database.
+		offset := 0
+		if n == 1 {
+			offset = 100
+		}
+		if offset+8 > len(page) {
+			return fmt.Errorf("%w: truncated page %d", errSQLiteFormat, n)
+		}
+		kind := page[offset]
+		cellCount := int(binary.BigEndian.Uint16(page[offset+3 : offset+5]))
+		headerSize := 8
+		if kind == sqliteInteriorTable || kind == sqliteInteriorIndex {
+			headerSize = 12
+		}
+		pointers := page[offset+headerSize:]
+		if len(pointers) < 2*cellCount {
+			return fmt.Errorf("%w: bad cell count on page %d", errSQLiteFormat, n)
+		}
+		visitRow := func(rowid int64, payload []byte) error {
+			values, err := db.record(payload)
+			if err != nil {
+				return fmt.Errorf("error reading row %d: %w", rowid, err)
+			}
+			return visit(rowid, values)
+		}
+
+		switch kind {
+		case sqliteInteriorTable:
+			for i := 0; i < cellCount; i++ {
+				cell := int(binary.BigEndian.Uint16(pointers[2*i:]))
+				if cell+4 > len(page) {
+					return fmt.Errorf("%w: bad cell pointer on page %d", errSQLiteFormat, n)
+				}
+				if err := walk(int(binary.BigEndian.Uint32(page[cell:])), depth+1); err != nil {
+					return err
+				}
+			}
+			return walk(int(binary.BigEndian.Uint32(page[offset+8:])), depth+1)
+		case sqliteInteriorIndex:
+			// The cells of interior index pages hold rows, which follow the rows of their child page.
+			for i := 0; i < cellCount; i++ {
+				cell := int(binary.BigEndian.Uint16(pointers[2*i:]))
+				if cell+4 > len(page) {
+					return fmt.Errorf("%w: bad cell pointer on page %d", errSQLiteFormat, n)
+				}
+				if err := walk(int(binary.BigEndian.Uint32(page[cell:])), depth+1); err != nil {
+					return err
+				}
+				payload, err := db.indexCell(page, cell+4)
+				if err != nil {
+					return fmt.Errorf("error reading cell %d of page %d: %w", i, n, err)
+				}
+				if err := visitRow(0, payload); err != nil {
+					return err
+				}
+			}
+			return walk(int(binary.BigEndian.Uint32(page[offset+8:])), depth+1)
+		case sqliteLeafTable:
+			for i := 0; i < cellCount; i++ {
+				cell := int(binary.BigEndian.Uint16(pointers[2*i:]))
+				rowid, payload, err := db.tableLeafCell(page, cell)
+				if err != nil {
+					return fmt.Errorf("error reading cell %d of page %d: %w", i, n, err)
+				}
+				if err := visitRow(rowid, payload); err != nil {
+					return err
+				}
+			}
+			return nil
+		case sqliteLeafIndex:
+			for i := 0; i < cellCount; i++ {
+				cell := int(binary.BigEndian.Uint16(pointers[2*i:]))
+				payload, err := db.indexCell(page, cell)
+				if err != nil {
+					return fmt.Errorf("error reading cell %d of page %d: %w", i, n, err)
+				}
+				if err := visitRow(0, payload); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			return fmt.Errorf("%w: page %d has type %#x, not a b-tree page", errSQLiteFormat, n, kind)
+		}
+	}
+	return walk(root, 0)
+}
+
+// tableLeafCell reads the rowid and the payload of a cell of a table leaf page.
+func (db *sqliteDB) tableLeafCell(page []byte, cell int) (int64, []byte, error) {
+	if cell >= len(page) {
+		return 0, nil, fmt.Errorf("%w: bad cell pointer", errSQLiteFormat)
+	}
+	payloadSize, n := sqliteVarint(page[cell:])
+	rowid, m := sqliteVarint(page[cell+n:])
+	if n == 0 || m == 0 {
+		return 0, nil, fmt.Errorf("%w: bad cell header", errSQLiteFormat)
+	}
+	payload, err := db.payload(page, cell+n+m, payloadSize, db.usableSize-35)
+	return rowid, payload, err
+}
+
+// indexCell reads the payload of a cell of an index page, which starts after the child page of interior pages.
+func (db *sqliteDB) indexCell(page []byte, cell int) ([]byte, error) {
+	if cell >= len(page) {
+		return nil, fmt.Errorf("%w: bad cell pointer", errSQLiteFormat)
+	}
+	payloadSize, n := sqliteVarint(page[cell:])
+	if n == 0 {
+		return nil, fmt.Errorf("%w: bad cell header", errSQLiteFormat)
+	}
+	return db.payload(page, cell+n, payloadSize, (db.usableSize-12)*64/255-23)
+}
+
+// payload reads the payload of a cell that starts at start in page. Payloads larger than maxLocal bytes don't fit
+// in the page, and continue on a chain of overflow pages.
+func (db *sqliteDB) payload(page []byte, start int, payloadSize int64, maxLocal int) ([]byte, error) {
+	// A payload can't be larger than the database, which guards against allocating for a corrupted size.
+	if payloadSize < 0 || payloadSize > int64(db.pageCount)*int64(db.usableSize) {
+		return nil, fmt.Errorf("%w: bad cell header", errSQLiteFormat)
+	}
+
+	// The amount of payload stored in the page is computed as specified by the file format.
+	u, p := db.usableSize, int(payloadSize)
+	local := p
+	if p > maxLocal {
+		minLocal := (u-12)*32/255 - 23
+		local = minLocal + (p-minLocal)%(u-4)
+		if local > maxLocal {
+			local = minLocal
+		}
+	}
+	if start+local > len(page) {
+		return nil, fmt.Errorf("%w: payload out of page", errSQLiteFormat)
+	}
+	payload := append(make([]byte, 0, p), page[start:start+local]...)
+	if local == p {
+		return payload, nil
+	}
+
+	if start+local+4 > len(page) {
+		return nil, fmt.Errorf("%w: missing overflow page", errSQLiteFormat)
+	}
+	next := int(binary.BigEndian.Uint32(page[start+local:]))
+	for pages := 0; len(payload) < p; pages++ {
+		if next == 0 || pages > db.pageCount {
+			return nil, fmt.Errorf("%w: broken overflow chain", errSQLiteFormat)
+		}
+		overflow, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = int(binary.BigEndian.Uint32(overflow))
+		payload = append(payload, overflow[4:min(u, 4+p-len(payload))]...)
+	}
+	return payload, nil
+}
+
+// record decodes the values of a record: integers as int64, floats as float64, text as string and blobs as
+// []byte. NULL values are nil.
+func (db *sqliteDB) record(payload []byte) ([]any, error) {
+	headerSize, n := sqliteVarint(payload)
+	if n == 0 || headerSize < int64(n) || headerSize > int64(len(payload)) {
+		return nil, fmt.Errorf("%w: bad record header", errSQLiteFormat)
+	}
+
+	var values []any
+	body := payload[headerSize:]
+	for header := payload[n:headerSize]; len(header) > 0; {
+		serialType, m := sqliteVarint(header)
+		if m == 0 {
+			return values, fmt.Errorf("%w: bad serial type", errSQLiteFormat)
+		}
+		header = header[m:]
+
+		size := sqliteValueSize(serialType)
+		if size > len(body) {
+			return values, fmt.Errorf("%w: record out of payload", errSQLiteFormat)
+		}
+		raw := body[:size]
+		body = body[size:]
+
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 6:
+			// Big-endian two's complement integers of 1, 2, 3, 4, 6 and 8 bytes.
+			v := int64(int8(raw[0]))
+			for _, b := range raw[1:] {
+				v = v<<8 | int64(b)
+			}
+			values = append(values, v)
+		case serialType == 7:
+			values = append(values, math.Float64frombits(binary.BigEndian.Uint64(raw)))
+		case serialType == 8, serialType == 9:
+			values = append(values, serialType-8)
+		case serialType >= 12 && serialType%2 == 0:
+			values = append(values, raw)
+		case serialType >= 13:
+			values = append(values, db.text(raw))
+		default:
+			return values, fmt.Errorf("%w: reserved serial type %d", errSQLiteFormat, serialType)
+		}
+	}
+	return values, nil
+}
+
+// sqliteValueSize returns the size in bytes of a value of a serial type.
+func sqliteValueSize(serialType int64) int {
+	switch {
+	case serialType >= 1 && serialType <= 4:
+		return int(serialType)
+	case serialType == 5:
+		return 6
+	case serialType == 6, serialType == 7:
+		return 8
+	case serialType >= 12:
+		return int((serialType - 12) / 2)
+	}
+	return 0
+}
+
+// text decodes text in the encoding of the database.
+func (db *sqliteDB) text(raw []byte) string {
+	if db.encoding != sqliteUTF16LE && db.encoding != sqliteUTF16BE {
+		return string(raw)
+	}
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		if db.encoding == sqliteUTF16LE {
+			units[i] = binary.LittleEndian.Uint16(raw[2*i:])
+		} else {
+			units[i] = binary.BigEndian.Uint16(raw[2*i:])
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+// sqliteVarint decodes a variable-length integer of up to 9 bytes, and returns it with its length, which is 0 if
+// the data is truncated.
+func sqliteVarint(data []byte) (int64, int) {
+	var v uint64
+	for i := 0; i < 9 && i < len(data); i++ {
+		if i == 8 {
+			return int64(v<<8 | uint64(data[i])), 9
+		}
+		v = v<<7 | uint64(data[i]&0x7f)
+		if data[i]&0x80 == 0 {
+			return int64(v), i + 1
+		}
+	}
+	return 0, 0
+}
+
+// parseColumns returns the names of the columns of a CREATE TABLE statement, the index of the column that is an
+// alias of the rowid, or -1, and the columns of the primary key. Table constraints are left out of the columns.
+func parseColumns(sql string) ([]string, int, []string) {
+	start := strings.IndexByte(sql, '(')
+	if start < 0 {
+		return nil, -1, nil
+	}
+
+	var defs []string
+	depth, quote, last := 0, byte(0), start+1
+loop:
+	for i := start + 1; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			defs = append(defs, strings.TrimSpace(sql[last:i]))
+			last = i + 1
+		case c == ')':
+			defs = append(defs, strings.TrimSpace(sql[last:i]))
+			break loop
+		}
+	}
+
+	var columns, primaryKey []string
+	rowidColumn := -1
+	for _, def := range defs {
+		if def == "" {
+			continue
+		}
+		match := sqlitePrimaryKeyPat.FindStringSubmatch(def)
+		keyword := strings.ToUpper(strings.Fields(def)[0])
+		switch keyword {
+		case "CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN":
+			if match != nil && match[1] != "" {
+				for _, key := range strings.Split(match[1], ",") {
+					if key = strings.TrimSpace(key); key != "" {
+						primaryKey = append(primaryKey, columnName(key))
+					}
+				}
+			}
+			continue
+		}
+		if sqliteRowidAliasPat.MatchString(def) {
+			rowidColumn = len(columns)
+		}
+		if match != nil {
+			primaryKey = append(primaryKey, columnName(def))
+		}
+		columns = append(columns, columnName(def))
+	}
+	return columns, rowidColumn, primaryKey
+}
+
+// keyColumnsFirst returns the columns with the ones of the primary key first, in the order of the key, which is the
+// order of the columns of the records of WITHOUT ROWID tables.
+func keyColumnsFirst(columns, primaryKey []string) []string {
+	ordered := make([]string, 0, len(columns))
+	isKey := make(map[string]bool, len(primaryKey))
+	for _, key := range primaryKey {
+		for _, column := range columns {
+			if strings.EqualFold(column, key) && !isKey[column] {
+				ordered = append(ordered, column)
+				isKey[column] = true
+			}
+		}
+	}
+	for _, column := range columns {
+		if !isKey[column] {
+			ordered = append(ordered, column)
+		}
+	}
+	return ordered
+}
+
+// columnName returns the name at the start of a column definition, without its quotes.
+func columnName(def string) string {
+	closing := map[byte]byte{'"': '"', '\'': '\'', '`': '`', '[': ']'}
+	if end, ok := closing[def[0]]; ok {
+		if i := strings.IndexByte(def[1:], end); i >= 0 {
+			return def[1 : i+1]
+		}
+	}
+	if i := strings.IndexFunc(def, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' || r == '\r' }); i >= 0 {
+		return def[:i]
+	}
+	return def
+}
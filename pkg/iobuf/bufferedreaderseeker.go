@@ -14,6 +14,10 @@ const defaultBufferSize = 1 << 16 // 64KB
 
 var defaultBufferPool *pool.Pool
 
+// ErrNotBuffered is returned when seeking or reading back into data of a memory-only
+// BufferedReadSeeker that was read past its in-memory threshold and is no longer buffered.
+var ErrNotBuffered = errors.New("data is no longer buffered")
+
 func init() { defaultBufferPool = pool.NewBufferPool(defaultBufferSize) }
 
 // BufferedReadSeeker provides a buffered reading interface with seeking capabilities.
@@ -47,6 +51,11 @@ type BufferedReadSeeker struct {
 	tempFileName   string   // Name of the temporary file
 	diskBufferSize int64    // Size of data written to disk
 
+	// Fields to keep the data in memory only. Once the threshold is reached,
+	// the data is no longer buffered and can only be read forward.
+	memoryOnly bool // Whether to never buffer data to disk
+	truncated  bool // Whether the buffer stopped at the threshold
+
 	// Fields to provide a quick way to determine the total size of the reader
 	// without having to seek.
 	totalSize int64 // Total size of the reader
@@ -75,6 +84,16 @@ func NewBufferedReaderSeeker(r io.Reader) *BufferedReadSeeker {
 	}
 }
 
+// NewMemoryBufferedReaderSeeker creates a BufferedReadSeeker that never writes to disk.
+// Non-seekable readers are buffered in memory up to the threshold, which is enough to
+// detect their type and format. The data past it is streamed: reading or seeking back
+// into it returns ErrNotBuffered.
+func NewMemoryBufferedReaderSeeker(r io.Reader) *BufferedReadSeeker {
+	br := NewBufferedReaderSeeker(r)
+	br.memoryOnly = true
+	return br
+}
+
 // Read reads len(out) bytes from the reader starting at the current index.
 // It handles both seekable and non-seekable underlying readers efficiently.
 func (br *BufferedReadSeeker) Read(out []byte) (int, error) {
@@ -136,6 +155,11 @@ func (br *BufferedReadSeeker) Read(out []byte) (int, error) {
 		return totalBytesRead, nil
 	}
 
+	// The data between the buffer and the data read so far was not kept.
+	if br.truncated && br.index < br.bytesRead {
+		return totalBytesRead, ErrNotBuffered
+	}
+
 	// If we still need to read more data.
 	var raderBytes int
 	raderBytes, err = br.reader.Read(out)
@@ -186,6 +210,10 @@ func (br *BufferedReadSeeker) Seek(offset int64, whence int) (int64, error) {
 		return 0, errors.New("can not seek to before start of reader")
 	}
 
+	if br.truncated && newIndex >= int64(br.buf.Len()) && newIndex < br.bytesRead {
+		return 0, ErrNotBuffered
+	}
+
 	// For non-seekable readers, we need to ensure we've read up to the new index.
 	if br.seeker == nil && newIndex > br.bytesRead {
 		if err := br.readUntil(newIndex); err != nil {
@@ -242,6 +270,13 @@ func (br *BufferedReadSeeker) readToEnd() error {
 }
 
 func (br *BufferedReadSeeker) writeData(data []byte) error {
+	// Memory-only readers stop buffering at the threshold rather than switching to a file.
+	if br.memoryOnly && (br.truncated || br.buf.Len()+len(data) > int(br.threshold)) {
+		br.truncated = true
+		br.bytesRead += int64(len(data))
+		return nil
+	}
+
 	_, err := br.buf.Write(data)
 	if err != nil {
 		return err
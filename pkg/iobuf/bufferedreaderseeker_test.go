@@ -350,3 +350,30 @@ func TestBufferedReaderSeekerReadAt(t *testing.T) {
 		})
 	}
 }
+
+func TestMemoryBufferedReaderSeeker(t *testing.T) {
+	brs := NewMemoryBufferedReaderSeeker(bytes.NewBufferString("test data, more data"))
+	brs.threshold = 8
+	defer brs.Close()
+
+	// The data under the threshold can be read again.
+	out := make([]byte, 4)
+	_, err := io.ReadFull(brs, out)
+	assert.NoError(t, err)
+	_, err = brs.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+
+	// The data past the threshold is streamed without being buffered.
+	rest, err := io.ReadAll(brs)
+	assert.NoError(t, err)
+	assert.Equal(t, "test data, more data", string(rest))
+	assert.Nil(t, brs.tempFile)
+
+	_, err = brs.Seek(12, io.SeekStart)
+	assert.ErrorIs(t, err, ErrNotBuffered)
+
+	_, err = brs.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	_, err = io.ReadAll(brs)
+	assert.ErrorIs(t, err, ErrNotBuffered)
+}
@@ -0,0 +1,250 @@
+// Package msgraph is a minimal Microsoft Graph REST client, shared by the
+// sources scanning Microsoft 365 services.
+package msgraph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/microsoft"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+)
+
+const (
+	// DefaultEndpoint is the endpoint of the global Microsoft Graph service.
+	DefaultEndpoint = "https://graph.microsoft.com/v1.0"
+
+	defaultScope = "https://graph.microsoft.com/.default"
+)
+
+// StaticToken returns a token source for an access token obtained elsewhere.
+func StaticToken(token string) oauth2.TokenSource {
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token, TokenType: "Bearer"})
+}
+
+// ClientCredentials returns a token source for an app registration, using the
+// client credentials flow. The app is granted its application permissions.
+func ClientCredentials(ctx context.Context, creds *credentialspb.ClientCredentials) (oauth2.TokenSource, error) {
+	cred, err := azidentity.NewClientSecretCredential(creds.GetTenantId(), creds.GetClientId(), creds.GetClientSecret(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client credentials: %w", err)
+	}
+	return oauth2.ReuseTokenSource(nil, &credentialTokenSource{ctx: ctx, cred: cred}), nil
+}
+
+// OAuth returns a token source for delegated OAuth credentials. The access
+// token is refreshed with the refresh token, when there is one.
+func OAuth(ctx context.Context, creds *credentialspb.Oauth2) oauth2.TokenSource {
+	token := &oauth2.Token{AccessToken: creds.GetAccessToken(), RefreshToken: creds.GetRefreshToken(), TokenType: "Bearer"}
+	if token.RefreshToken == "" {
+		return oauth2.StaticTokenSource(token)
+	}
+
+	cfg := &oauth2.Config{
+		ClientID:     creds.GetClientId(),
+		ClientSecret: creds.GetClientSecret(),
+		Endpoint:     microsoft.AzureADEndpoint("common"),
+		Scopes:       []string{defaultScope, "offline_access"},
+	}
+	return cfg.TokenSource(ctx, token)
+}
+
+// credentialTokenSource adapts an Azure identity credential to an OAuth2 token
+// source.
+type credentialTokenSource struct {
+	ctx  context.Context
+	cred azcore.TokenCredential
+}
+
+func (s *credentialTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.cred.GetToken(s.ctx, policy.TokenRequestOptions{Scopes: []string{defaultScope}})
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: token.Token, TokenType: "Bearer", Expiry: token.ExpiresOn}, nil
+}
+
+// Error is an error returned by the Graph API.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("graph API error %d (%s): %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is a Graph API error for a missing resource.
+func IsNotFound(err error) bool {
+	var graphErr *Error
+	return errors.As(err, &graphErr) && graphErr.StatusCode == http.StatusNotFound
+}
+
+// Identity is a user or an application, e.g. the author of a message.
+type Identity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email"`
+}
+
+// IdentitySet holds the identities involved in an action.
+type IdentitySet struct {
+	User        *Identity `json:"user"`
+	Application *Identity `json:"application"`
+}
+
+// Client is a Microsoft Graph client. It retries throttled requests, waiting
+// for as long as the Retry-After header asks to.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the Graph endpoint, authenticating with the
+// tokens of the token source. The default endpoint is used when empty.
+func NewClient(ctx context.Context, endpoint string, tokens oauth2.TokenSource) (*Client, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid Microsoft Graph endpoint %q", endpoint)
+	}
+
+	base := roundtripper.NewRoundTripper(nil,
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithLogging(),
+		roundtripper.WithRetryable(roundtripper.WithShouldRetry5XXDuration(10*time.Second)),
+	)
+	return &Client{
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		httpClient: &http.Client{Transport: &authTransport{host: u.Host, tokens: tokens, base: base}},
+	}, nil
+}
+
+// authTransport authenticates the requests sent to the Graph API. Downloads
+// are redirected to pre-authenticated URLs on other hosts, which must not
+// receive the token.
+type authTransport struct {
+	host   string
+	tokens oauth2.TokenSource
+	base   http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.host {
+		return t.base.RoundTrip(req)
+	}
+
+	token, err := t.tokens.Token()
+	if err != nil {
+		return nil, fmt.Errorf("could not get a Microsoft Graph token: %w", err)
+	}
+	req = req.Clone(req.Context())
+	token.SetAuthHeader(req)
+	return t.base.RoundTrip(req)
+}
+
+// resolve returns the URL of a Graph resource. Absolute URLs, such as the
+// next page links, are returned as is.
+func (c *Client) resolve(path string) string {
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		return path
+	}
+	return c.endpoint + "/" + strings.TrimPrefix(path, "/")
+}
+
+// Open returns the response body of a Graph resource, such as the content of
+// a file. The caller is responsible for closing the returned reader.
+func (c *Client) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.resolve(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newError(resp)
+	}
+	return resp.Body, nil
+}
+
+// Get decodes a Graph resource into target.
+func (c *Client) Get(ctx context.Context, path string, target any) error {
+	body, err := c.Open(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if err := json.NewDecoder(body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Graph API response: %w", err)
+	}
+	return nil
+}
+
+func newError(resp *http.Response) error {
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&body)
+	return &Error{StatusCode: resp.StatusCode, Code: body.Error.Code, Message: body.Error.Message}
+}
+
+type page[T any] struct {
+	Value    []T    `json:"value"`
+	NextLink string `json:"@odata.nextLink"`
+}
+
+// List calls visit for every item of a Graph collection, following the next
+// page links.
+func List[T any](ctx context.Context, c *Client, path string, visit func(T) error) error {
+	next := path
+	for next != "" {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var p page[T]
+		if err := c.Get(ctx, next, &p); err != nil {
+			return err
+		}
+		for _, item := range p.Value {
+			if err := visit(item); err != nil {
+				return err
+			}
+		}
+		next = p.NextLink
+	}
+	return nil
+}
+
+// SharingPath returns the path of the drive item behind a sharing URL, such as
+// the link to a file attached to a Teams message.
+// https://learn.microsoft.com/en-us/graph/api/shares-get
+func SharingPath(sharingURL string) string {
+	return "/shares/u!" + base64.RawURLEncoding.EncodeToString([]byte(sharingURL)) + "/driveItem"
+}
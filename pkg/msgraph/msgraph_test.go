@@ -0,0 +1,89 @@
+package msgraph
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+func TestClient_List(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v1.0/teams", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer graph-token", r.Header.Get("Authorization"))
+		if r.URL.Query().Get("$skiptoken") == "2" {
+			_, _ = fmt.Fprint(w, `{"value": [{"id": "c"}]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"value": [{"id": "a"}, {"id": "b"}], "@odata.nextLink": "%s/v1.0/teams?$skiptoken=2"}`, server.URL)
+	})
+	mux.HandleFunc("/v1.0/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"error": {"code": "itemNotFound", "message": "The resource could not be found."}}`)
+	})
+
+	client, err := NewClient(ctx, server.URL+"/v1.0/", StaticToken("graph-token"))
+	require.NoError(t, err)
+
+	var ids []string
+	err = List(ctx, client, "/teams", func(item struct {
+		ID string `json:"id"`
+	}) error {
+		ids = append(ids, item.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+
+	err = client.Get(ctx, "missing", &struct{}{})
+	assert.True(t, IsNotFound(err))
+	assert.EqualError(t, err, "graph API error 404 (itemNotFound): The resource could not be found.")
+}
+
+func TestClient_Open_DoesNotLeakToken(t *testing.T) {
+	ctx := context.Background()
+
+	download := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = fmt.Fprint(w, "file content")
+	}))
+	defer download.Close()
+
+	graph := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer graph-token", r.Header.Get("Authorization"))
+		http.Redirect(w, r, download.URL+"/file.txt?tempauth=abc", http.StatusFound)
+	}))
+	defer graph.Close()
+
+	client, err := NewClient(ctx, graph.URL, StaticToken("graph-token"))
+	require.NoError(t, err)
+
+	content, err := client.Open(ctx, "/drives/1/items/2/content")
+	require.NoError(t, err)
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "file content", string(data))
+}
+
+func TestNewClient_InvalidEndpoint(t *testing.T) {
+	_, err := NewClient(context.Background(), "graph.microsoft.com", StaticToken("token"))
+	assert.Error(t, err)
+}
+
+func TestSharingPath(t *testing.T) {
+	// Example from https://learn.microsoft.com/en-us/graph/api/shares-get
+	got := SharingPath("https://onedrive.live.com/redir?resid=1231244193912!12&authKey=1201919!12921!1")
+	assert.Equal(t, "/shares/u!aHR0cHM6Ly9vbmVkcml2ZS5saXZlLmNvbS9yZWRpcj9yZXNpZD0xMjMxMjQ0MTkzOTEyITEyJmF1dGhLZXk9MTIwMTkxOSExMjkyMSEx/driveItem", got)
+}
@@ -234,6 +234,10 @@ func (m *Artifactory) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MaxArtifactSize
+
+	// no validation rules for SkipArchives
+
 	switch v := m.Credential.(type) {
 	case *Artifactory_BasicAuth:
 		if v == nil {
@@ -432,6 +436,12 @@ func (m *AzureStorage) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for AccountName
+
+	// no validation rules for Endpoint
+
+	// no validation rules for MaxObjectSize
+
 	switch v := m.Credential.(type) {
 	case *AzureStorage_ConnectionString:
 		if v == nil {
@@ -539,6 +549,59 @@ func (m *AzureStorage) validate(all bool) error {
 			}
 		}
 
+	case *AzureStorage_SasToken:
+		if v == nil {
+			err := AzureStorageValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for SasToken
+	case *AzureStorage_ClientCredentials:
+		if v == nil {
+			err := AzureStorageValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetClientCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AzureStorageValidationError{
+						field:  "ClientCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AzureStorageValidationError{
+						field:  "ClientCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClientCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AzureStorageValidationError{
+					field:  "ClientCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1538,6 +1601,20 @@ func (m *ECR) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for MaxTags
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = ECRValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	switch v := m.Credential.(type) {
 	case *ECR_AccessKey:
 		if v == nil {
@@ -1580,6 +1657,88 @@ func (m *ECR) validate(all bool) error {
 			}
 		}
 
+	case *ECR_CloudEnvironment:
+		if v == nil {
+			err := ECRValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCloudEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ECRValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ECRValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCloudEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ECRValidationError{
+					field:  "CloudEnvironment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ECR_SessionToken:
+		if v == nil {
+			err := ECRValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSessionToken()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ECRValidationError{
+						field:  "SessionToken",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ECRValidationError{
+						field:  "SessionToken",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSessionToken()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ECRValidationError{
+					field:  "SessionToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -2908,6 +3067,20 @@ func (m *GoogleDrive) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for IncludeRevisions
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = GoogleDriveValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	switch v := m.Credential.(type) {
 	case *GoogleDrive_RefreshToken:
 		if v == nil {
@@ -2921,6 +3094,71 @@ func (m *GoogleDrive) validate(all bool) error {
 			errors = append(errors, err)
 		}
 		// no validation rules for RefreshToken
+	case *GoogleDrive_JsonServiceAccount:
+		if v == nil {
+			err := GoogleDriveValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JsonServiceAccount
+	case *GoogleDrive_ServiceAccountFile:
+		if v == nil {
+			err := GoogleDriveValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ServiceAccountFile
+	case *GoogleDrive_Oauth:
+		if v == nil {
+			err := GoogleDriveValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOauth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoogleDriveValidationError{
+						field:  "Oauth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoogleDriveValidationError{
+						field:  "Oauth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOauth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoogleDriveValidationError{
+					field:  "Oauth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -3216,6 +3454,12 @@ func (m *JIRA) validate(all bool) error {
 
 	// no validation rules for InsecureSkipVerifyTls
 
+	// no validation rules for Jql
+
+	// no validation rules for SkipAttachments
+
+	// no validation rules for MaxAttachmentSize
+
 	switch v := m.Credential.(type) {
 	case *JIRA_BasicAuth:
 		if v == nil {
@@ -3455,6 +3699,18 @@ func (m *NPMUnauthenticatedPackage) validate(all bool) error {
 
 	var errors []error
 
+	if _, err := url.Parse(m.GetRegistry()); err != nil {
+		err = NPMUnauthenticatedPackageValidationError{
+			field:  "Registry",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	switch v := m.Credential.(type) {
 	case *NPMUnauthenticatedPackage_Unauthenticated:
 		if v == nil {
@@ -3603,6 +3859,18 @@ func (m *PyPIUnauthenticatedPackage) validate(all bool) error {
 
 	var errors []error
 
+	if _, err := url.Parse(m.GetRegistry()); err != nil {
+		err = PyPIUnauthenticatedPackageValidationError{
+			field:  "Registry",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	switch v := m.Credential.(type) {
 	case *PyPIUnauthenticatedPackage_Unauthenticated:
 		if v == nil {
@@ -3752,6 +4020,14 @@ func (m *S3) validate(all bool) error {
 
 	// no validation rules for MaxObjectSize
 
+	// no validation rules for Endpoint
+
+	// no validation rules for Region
+
+	// no validation rules for ForcePathStyle
+
+	// no validation rules for InsecureSkipVerifyTls
+
 	switch v := m.Credential.(type) {
 	case *S3_AccessKey:
 		if v == nil {
@@ -4286,6 +4562,20 @@ func (m *Buildkite) validate(all bool) error {
 
 	var errors []error
 
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = BuildkiteValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeArtifacts
+
 	switch v := m.Credential.(type) {
 	case *Buildkite_Token:
 		if v == nil {
@@ -4417,6 +4707,10 @@ func (m *Gerrit) validate(all bool) error {
 
 	// no validation rules for SkipArchives
 
+	// no validation rules for IncludeChanges
+
+	// no validation rules for IncludeComments
+
 	switch v := m.Credential.(type) {
 	case *Gerrit_BasicAuth:
 		if v == nil {
@@ -4616,7 +4910,13 @@ func (m *Jenkins) validate(all bool) error {
 
 	// no validation rules for InsecureSkipVerifyTls
 
-	switch v := m.Credential.(type) {
+	// no validation rules for Home
+
+	// no validation rules for IncludeConfigs
+
+	// no validation rules for IncludeBuildParameters
+
+	switch v := m.Credential.(type) {
 	case *Jenkins_BasicAuth:
 		if v == nil {
 			err := JenkinsValidationError{
@@ -5483,6 +5783,20 @@ func (m *Sharepoint) validate(all bool) error {
 
 	// no validation rules for SiteUrl
 
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = SharepointValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeOnedrive
+
 	switch v := m.Credential.(type) {
 	case *Sharepoint_Oauth:
 		if v == nil {
@@ -5525,6 +5839,59 @@ func (m *Sharepoint) validate(all bool) error {
 			}
 		}
 
+	case *Sharepoint_Authenticated:
+		if v == nil {
+			err := SharepointValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAuthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharepointValidationError{
+						field:  "Authenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharepointValidationError{
+						field:  "Authenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAuthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharepointValidationError{
+					field:  "Authenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Sharepoint_Token:
+		if v == nil {
+			err := SharepointValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Token
 	default:
 		_ = v // ensures v is used
 	}
@@ -5958,17 +6325,11 @@ func (m *Webhook) validate(all bool) error {
 
 	var errors []error
 
-	if err := m._validateHostname(m.GetListenAddress()); err != nil {
-		err = WebhookValidationError{
-			field:  "ListenAddress",
-			reason: "value must be a valid hostname",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ListenAddress
+
+	// no validation rules for SyncResponse
+
+	// no validation rules for MaxBodySize
 
 	switch v := m.Credential.(type) {
 	case *Webhook_Header:
@@ -6012,6 +6373,18 @@ func (m *Webhook) validate(all bool) error {
 			}
 		}
 
+	case *Webhook_HmacSecret:
+		if v == nil {
+			err := WebhookValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for HmacSecret
 	default:
 		_ = v // ensures v is used
 	}
@@ -6023,36 +6396,6 @@ func (m *Webhook) validate(all bool) error {
 	return nil
 }
 
-func (m *Webhook) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
-
-	return nil
-}
-
 // WebhookMultiError is an error wrapping multiple validation errors returned
 // by Webhook.ValidateAll() if the designated constraints aren't met.
 type WebhookMultiError []error
@@ -6240,3 +6583,497 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ElasticsearchValidationError{}
+
+// Validate checks the field values on Stdin with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stdin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stdin with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StdinMultiError, or nil if none found.
+func (m *Stdin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stdin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return StdinMultiError(errors)
+	}
+
+	return nil
+}
+
+// StdinMultiError is an error wrapping multiple validation errors returned by
+// Stdin.ValidateAll() if the designated constraints aren't met.
+type StdinMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StdinMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StdinMultiError) AllErrors() []error { return m }
+
+// StdinValidationError is the validation error returned by Stdin.Validate if
+// the designated constraints aren't met.
+type StdinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StdinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StdinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StdinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StdinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StdinValidationError) ErrorName() string { return "StdinValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StdinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStdin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StdinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StdinValidationError{}
+
+// Validate checks the field values on Gitea with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Gitea) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Gitea with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GiteaMultiError, or nil if none found.
+func (m *Gitea) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Gitea) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = GiteaValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeForks
+
+	// no validation rules for IncludeIssueComments
+
+	// no validation rules for IncludePullRequestComments
+
+	// no validation rules for IncludeWikis
+
+	// no validation rules for SkipBinaries
+
+	// no validation rules for SkipArchives
+
+	switch v := m.Credential.(type) {
+	case *Gitea_Token:
+		if v == nil {
+			err := GiteaValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Token
+	case *Gitea_Unauthenticated:
+		if v == nil {
+			err := GiteaValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GiteaValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GiteaValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GiteaValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Gitea_BasicAuth:
+		if v == nil {
+			err := GiteaValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GiteaValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GiteaValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GiteaValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GiteaMultiError(errors)
+	}
+
+	return nil
+}
+
+// GiteaMultiError is an error wrapping multiple validation errors returned by
+// Gitea.ValidateAll() if the designated constraints aren't met.
+type GiteaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GiteaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GiteaMultiError) AllErrors() []error { return m }
+
+// GiteaValidationError is the validation error returned by Gitea.Validate if
+// the designated constraints aren't met.
+type GiteaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiteaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiteaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiteaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiteaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiteaValidationError) ErrorName() string { return "GiteaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GiteaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitea.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiteaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiteaValidationError{}
+
+// Validate checks the field values on Kubernetes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kubernetes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kubernetes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KubernetesMultiError, or
+// nil if none found.
+func (m *Kubernetes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kubernetes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Context
+
+	// no validation rules for IncludeSecrets
+
+	switch v := m.Credential.(type) {
+	case *Kubernetes_Kubeconfig:
+		if v == nil {
+			err := KubernetesValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Kubeconfig
+	case *Kubernetes_KubeconfigFile:
+		if v == nil {
+			err := KubernetesValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for KubeconfigFile
+	case *Kubernetes_InCluster:
+		if v == nil {
+			err := KubernetesValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInCluster()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KubernetesValidationError{
+						field:  "InCluster",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KubernetesValidationError{
+						field:  "InCluster",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInCluster()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KubernetesValidationError{
+					field:  "InCluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return KubernetesMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesMultiError is an error wrapping multiple validation errors
+// returned by Kubernetes.ValidateAll() if the designated constraints aren't met.
+type KubernetesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesMultiError) AllErrors() []error { return m }
+
+// KubernetesValidationError is the validation error returned by
+// Kubernetes.Validate if the designated constraints aren't met.
+type KubernetesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesValidationError) ErrorName() string { return "KubernetesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KubernetesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesValidationError{}
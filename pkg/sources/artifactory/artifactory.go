@@ -0,0 +1,270 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_JFROG_ARTIFACTORY
+
+	defaultMaxArtifactSize = 250 * 1024 * 1024 // 250 MiB
+
+	unitRepo sources.SourceUnitKind = "repo"
+)
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint        string
+	repos           []string
+	repoFilter      *glob.Filter
+	pathFilter      *glob.Filter
+	maxArtifactSize int64
+	skipArchives    bool
+	client          *client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Artifactory source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Artifactory
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	endpoint, err := url.Parse(conn.GetEndpoint())
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return fmt.Errorf("invalid Artifactory endpoint %q", conn.GetEndpoint())
+	}
+	s.endpoint = strings.TrimRight(endpoint.String(), "/")
+	s.client = &client{
+		baseURL:    s.endpoint,
+		httpClient: common.RetryableHTTPClientTimeout(300),
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Artifactory_BasicAuth:
+		s.client.username = cred.BasicAuth.GetUsername()
+		s.client.password = cred.BasicAuth.GetPassword()
+	case *sourcespb.Artifactory_AccessToken:
+		if cred.AccessToken == "" {
+			return fmt.Errorf("access token is empty")
+		}
+		s.client.accessToken = cred.AccessToken
+	case *sourcespb.Artifactory_Unauthenticated:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	s.repos = conn.GetRepositories()
+	if s.repoFilter, err = glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeRepositories()...),
+		glob.WithExcludeGlobs(conn.GetIgnoreRepositories()...),
+	); err != nil {
+		return fmt.Errorf("could not compile repository globs: %w", err)
+	}
+	if s.pathFilter, err = glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludePaths()...),
+		glob.WithExcludeGlobs(conn.GetIgnorePaths()...),
+	); err != nil {
+		return fmt.Errorf("could not compile path globs: %w", err)
+	}
+
+	s.setMaxArtifactSize(conn.GetMaxArtifactSize())
+	s.skipArchives = conn.GetSkipArchives()
+
+	return nil
+}
+
+// setMaxArtifactSize sets the maximum size of artifacts that will be scanned.
+// If not set or set to a non-positive number, defaultMaxArtifactSize is used.
+func (s *Source) setMaxArtifactSize(maxArtifactSize int64) {
+	if maxArtifactSize <= 0 {
+		s.maxArtifactSize = defaultMaxArtifactSize
+		return
+	}
+	s.maxArtifactSize = maxArtifactSize
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned repository: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Artifactory scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every configured repository, or every
+// repository visible to the credential if none are configured. Enumerated
+// repositories are filtered with the include and ignore globs.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	repos := s.repos
+	if len(repos) == 0 {
+		ctx.Logger().Info("no repositories configured, enumerating")
+		found, err := s.client.listRepositories(ctx)
+		if err != nil {
+			return fmt.Errorf("could not list repositories: %w", err)
+		}
+		for _, repo := range found {
+			repos = append(repos, repo.Key)
+		}
+	}
+
+	for _, repo := range repos {
+		if !s.repoFilter.ShouldInclude(repo) {
+			ctx.Logger().V(3).Info("skipping repository", "repo", repo)
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitRepo, ID: repo}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit scans every artifact of a repository.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repo, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "repo", repo)
+
+	artifacts, err := s.client.listArtifacts(ctx, repo)
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list artifacts: %w", err))
+	}
+	ctx.Logger().V(2).Info("scanning repository", "artifacts", len(artifacts))
+
+	for _, a := range artifacts {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		path := strings.TrimPrefix(a.URI, "/")
+		if !s.pathFilter.ShouldInclude(path) {
+			continue
+		}
+		if a.Size > s.maxArtifactSize {
+			ctx.Logger().V(3).Info("skipping artifact over the size limit", "path", path, "size", a.Size)
+			continue
+		}
+
+		if err := s.scanArtifact(ctx, repo, path, a, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanArtifact(ctx context.Context, repo, path string, a artifact, reporter sources.ChunkReporter) error {
+	ctx.Logger().V(3).Info("scanning artifact", "path", path)
+
+	body, err := s.client.download(ctx, repo, path)
+	if err != nil {
+		return fmt.Errorf("could not download artifact %q: %w", path, err)
+	}
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Artifactory{
+				Artifactory: &source_metadatapb.Artifactory{
+					Repo:      sanitizer.UTF8(repo),
+					Path:      sanitizer.UTF8(path),
+					Link:      s.endpoint + "/" + artifactPath(repo, path),
+					Timestamp: a.LastModified,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	if err := handlers.HandleFile(ctx, body, chunkSkel, reporter, handlers.WithSkipArchives(s.skipArchives)); err != nil {
+		return fmt.Errorf("could not scan artifact %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+type repository struct {
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	PackageType string `json:"packageType"`
+}
+
+type artifact struct {
+	URI          string `json:"uri"`
+	Size         int64  `json:"size"`
+	LastModified string `json:"lastModified"`
+	Folder       bool   `json:"folder"`
+}
+
+type fileList struct {
+	Files []artifact `json:"files"`
+}
+
+// client talks to the Artifactory REST API. The base URL includes the
+// Artifactory context path, e.g. https://acme.jfrog.io/artifactory.
+type client struct {
+	baseURL     string
+	username    string
+	password    string
+	accessToken string
+	httpClient  *http.Client
+}
+
+func (c *client) newRequest(ctx context.Context, path string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/"+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Artifactory request: %w", err)
+	}
+	switch {
+	case c.accessToken != "":
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return req, nil
+}
+
+// open requests the given escaped path and returns the response body. The
+// caller must close it.
+func (c *client) open(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Artifactory: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		resp.Body.Close()
+		return nil, fmt.Errorf("invalid credentials or insufficient permissions, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode != http.StatusOK:
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Path)
+	}
+	return resp.Body, nil
+}
+
+func (c *client) getJSON(ctx context.Context, path string, target any) error {
+	body, err := c.open(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if err := json.NewDecoder(body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Artifactory response: %w", err)
+	}
+	return nil
+}
+
+// listRepositories returns every local, remote cache and federated repository
+// visible to the credential. Virtual repositories are skipped because they
+// only aggregate other repositories.
+func (c *client) listRepositories(ctx context.Context) ([]repository, error) {
+	var repos []repository
+	if err := c.getJSON(ctx, "api/repositories", &repos); err != nil {
+		return nil, err
+	}
+
+	filtered := repos[:0]
+	for _, repo := range repos {
+		if strings.EqualFold(repo.Type, "virtual") {
+			continue
+		}
+		filtered = append(filtered, repo)
+	}
+	return filtered, nil
+}
+
+// listArtifacts returns every file stored in a repository using the deep
+// file list API.
+func (c *client) listArtifacts(ctx context.Context, repo string) ([]artifact, error) {
+	var list fileList
+	path := "api/storage/" + url.PathEscape(repo) + "/?list&deep=1&listFolders=0&mdTimestamps=0"
+	if err := c.getJSON(ctx, path, &list); err != nil {
+		return nil, err
+	}
+
+	files := list.Files[:0]
+	for _, file := range list.Files {
+		if file.Folder {
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// download returns the content of an artifact. The caller must close it.
+func (c *client) download(ctx context.Context, repo, path string) (io.ReadCloser, error) {
+	return c.open(ctx, artifactPath(repo, path))
+}
+
+// artifactPath returns the escaped download path of an artifact.
+func artifactPath(repo, path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return url.PathEscape(repo) + "/" + strings.Join(segments, "/")
+}
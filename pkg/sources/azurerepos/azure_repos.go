@@ -0,0 +1,484 @@
+package azurerepos
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/giturl"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS
+
+// tokenCloneUser is the username sent along with a personal access token when
+// cloning. Azure DevOps ignores it, but git requires one to be set.
+const tokenCloneUser = "pat"
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	cloud    bool
+	token    string
+
+	repos         []string
+	orgs          []string
+	projects      []string
+	includeForks  bool
+	repoFilter    *glob.Filter
+	projectFilter *glob.Filter
+
+	git         *git.Git
+	scanOptions *git.ScanOptions
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Azure Repos source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.AzureRepos
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.AzureRepos_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		s.token = cred.Token
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s): only personal access tokens are supported", name, s.Type().String())
+	}
+
+	s.endpoint = cloudEndpoint
+	if endpoint := conn.GetEndpoint(); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid Azure DevOps endpoint %q", endpoint)
+		}
+		s.endpoint = strings.TrimRight(u.String(), "/")
+	}
+	s.cloud = s.endpoint == cloudEndpoint
+
+	s.repos = conn.GetRepositories()
+	s.orgs = conn.GetOrganizations()
+	s.projects = conn.GetProjects()
+	s.includeForks = conn.GetIncludeForks()
+	ctx.Logger().V(3).Info("setting filter patterns",
+		"include_repos", conn.GetIncludeRepos(), "ignore_repos", conn.GetIgnoreRepos(),
+		"include_projects", conn.GetIncludeProjects(), "ignore_projects", conn.GetIgnoreProjects(),
+	)
+	repoFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeRepos()...),
+		glob.WithExcludeGlobs(conn.GetIgnoreRepos()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile repo patterns: %w", err)
+	}
+	projectFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeProjects()...),
+		glob.WithExcludeGlobs(conn.GetIgnoreProjects()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile project patterns: %w", err)
+	}
+	s.repoFilter, s.projectFilter = repoFilter, projectFilter
+
+	if len(s.repos) == 0 && len(s.orgs) == 0 {
+		return fmt.Errorf("at least one organization or repository is required")
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			org, project, _ := parseRepoURL(repository)
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_AzureRepos{
+					AzureRepos: &source_metadatapb.AzureRepos{
+						Commit:       sanitizer.UTF8(commit),
+						File:         sanitizer.UTF8(file),
+						Email:        sanitizer.UTF8(email),
+						Repository:   sanitizer.UTF8(repository),
+						Project:      sanitizer.UTF8(project),
+						Organization: sanitizer.UTF8(org),
+						Link:         s.generateLink(repository, commit, file, line),
+						Timestamp:    sanitizer.UTF8(timestamp),
+						Line:         line,
+					},
+				},
+			}
+		},
+	}
+	s.git = git.NewGit(cfg)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+func (s *Source) newClient() *client {
+	return &client{
+		baseURL:    s.endpoint,
+		token:      s.token,
+		httpClient: common.RetryableHTTPClientTimeout(60),
+	}
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	azureReposScanned.WithLabelValues(s.name).Set(0)
+
+	var repos []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	s.repos = repos
+	// We must sort the repos so we can resume later if necessary.
+	slices.Sort(s.repos)
+
+	return s.scanRepos(ctx, chunksChan)
+}
+
+func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	// If there is resume information available, limit this scan to only the repos that still need scanning.
+	reposToScan, progressIndexOffset := sources.FilterReposToResume(s.repos, s.GetProgress().EncodedResumeInfo)
+	ctx.Logger().V(2).Info("filtered repos to resume", "before", len(s.repos), "after", len(reposToScan))
+	s.repos = reposToScan
+	scanErrs := sources.NewScanErrors()
+
+	for i, repo := range s.repos {
+		i, repoURL := i, repo
+		s.jobPool.Go(func() error {
+			logger := ctx.Logger().WithValues("repo", repoURL)
+			if common.IsDone(ctx) {
+				// We are returning nil instead of the scanErrors slice here because
+				// we don't want to mark this scan as errored if we cancelled it.
+				logger.V(2).Info("Skipping repo because context was cancelled")
+				return nil
+			}
+
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, repoURL)
+			// Ensure the repo is removed from the resume info after being scanned.
+			defer func(s *Source) {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
+			}(s)
+
+			path, repo, err := s.cloneRepo(ctx, repoURL)
+			if err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			defer os.RemoveAll(path)
+
+			logger.V(2).Info("starting scan", "num", i+1, "total", len(s.repos))
+			if err = s.git.ScanRepo(ctx, repo, path, s.scanOptions, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			azureReposScanned.WithLabelValues(s.name).Inc()
+
+			logger.V(2).Info("completed scan", "num", i+1, "total", len(s.repos))
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(s.repos), len(s.repos), "Completed Azure Repos scan", "")
+
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index int, offset int, repoURL string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	// Add the repoURL to the resume info slice.
+	s.resumeInfoSlice = append(s.resumeInfoSlice, repoURL)
+	sort.Strings(s.resumeInfoSlice)
+
+	// Make the resume info string from the slice.
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	// Add the offset to both the index and the repos to give the proper place and proper repo count.
+	s.SetProgressComplete(index+offset, len(s.repos)+offset, fmt.Sprintf("Repo: %s", repoURL), encodedResumeInfo)
+}
+
+func (s *Source) cloneRepo(ctx context.Context, repoURL string) (string, *gogit.Repository, error) {
+	return git.CloneRepoUsingToken(ctx, s.token, repoURL, tokenCloneUser)
+}
+
+func (s *Source) Validate(ctx context.Context) []error {
+	var errs []error
+	c := s.newClient()
+
+	// Listing the projects of each organization validates the token.
+	for _, org := range s.orgs {
+		if _, err := c.listProjects(ctx, org); err != nil {
+			errs = append(errs, fmt.Errorf("could not list projects of organization %q: %w", org, err))
+		}
+	}
+
+	repos, normalizeErrs := normalizeRepos(s.repos)
+	errs = append(errs, normalizeErrs...)
+	for _, r := range repos {
+		if err := git.PingRepoUsingToken(ctx, s.token, r, tokenCloneUser); err != nil {
+			errs = append(errs, fmt.Errorf("could not reach git repository %q: %w", r, err))
+		}
+	}
+
+	return errs
+}
+
+// Enumerate reports all Azure Repos repositories to be scanned to the
+// reporter. If no repositories are configured, it will find all repositories
+// within the configured (or all visible) projects of the configured
+// organizations, while respecting the configured include and ignore rules.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	azureReposEnumerated.WithLabelValues(s.name).Set(0)
+
+	repos, errs := normalizeRepos(s.repos)
+	for _, repoErr := range errs {
+		ctx.Logger().Info("error normalizing repo", "error", repoErr)
+		if err := reporter.UnitErr(ctx, repoErr); err != nil {
+			return err
+		}
+	}
+
+	// End early if we had errors getting specified repos but none were validated.
+	if len(errs) > 0 && len(repos) == 0 {
+		return fmt.Errorf("all configured repos had validation issues")
+	}
+
+	// Report all repos if specified.
+	if len(repos) > 0 {
+		for _, repo := range repos {
+			unit := git.SourceUnit{Kind: git.UnitRepo, ID: repo}
+			if err := reporter.UnitOk(ctx, unit); err != nil {
+				return err
+			}
+			azureReposEnumerated.WithLabelValues(s.name).Inc()
+		}
+		return nil
+	}
+
+	c := s.newClient()
+	for _, org := range s.orgs {
+		ctx := context.WithValue(ctx, "organization", org)
+
+		projects := s.projects
+		if len(projects) == 0 {
+			ctx.Logger().V(2).Info("no projects configured, enumerating")
+			var err error
+			if projects, err = c.listProjects(ctx, org); err != nil {
+				err = fmt.Errorf("error listing projects for organization %q: %w", org, err)
+				if err := reporter.UnitErr(ctx, err); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		for _, project := range projects {
+			if !s.projectFilter.ShouldInclude(project) {
+				ctx.Logger().V(3).Info("skipping project", "project", project, "reason", "filtered in config")
+				continue
+			}
+			if err := s.enumerateProject(ctx, c, org, project, reporter); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Source) enumerateProject(ctx context.Context, c *client, org, project string, reporter sources.UnitReporter) error {
+	ctx = context.WithValue(ctx, "project", project)
+
+	repos, err := c.listRepos(ctx, org, project)
+	if err != nil {
+		return reporter.UnitErr(ctx, fmt.Errorf("error listing repositories for project %q: %w", project, err))
+	}
+
+	for _, repo := range repos {
+		fullName := project + "/" + repo.Name
+		switch {
+		case repo.IsDisabled:
+			ctx.Logger().V(3).Info("skipping repository", "repo", fullName, "reason", "disabled")
+			continue
+		case repo.IsFork && !s.includeForks:
+			ctx.Logger().V(3).Info("skipping repository", "repo", fullName, "reason", "fork")
+			continue
+		case !s.repoFilter.ShouldInclude(fullName):
+			ctx.Logger().V(3).Info("skipping repository", "repo", fullName, "reason", "filtered in config")
+			continue
+		}
+
+		cloneURL := repo.cloneURL()
+		if cloneURL == "" {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("repository %q has no clone URL", fullName)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: cloneURL}); err != nil {
+			return err
+		}
+		azureReposEnumerated.WithLabelValues(s.name).Inc()
+	}
+	return nil
+}
+
+// ChunkUnit clones and reports chunks for the given Azure Repos repository unit.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repoURL, _ := unit.SourceUnitID()
+
+	path, repo, err := s.cloneRepo(ctx, repoURL)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	return s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter)
+}
+
+// generateLink crafts a link to the file at the commit the finding was
+// introduced in. Azure DevOps Services links are delegated to giturl; Azure
+// DevOps Server links point to the commit, since giturl can't recognize
+// arbitrary hosts.
+func (s *Source) generateLink(repository, commit, file string, line int64) string {
+	if s.cloud {
+		return giturl.GenerateLink(repository, commit, file, line)
+	}
+	return strings.TrimSuffix(repository, ".git") + "/commit/" + commit
+}
+
+// parseRepoURL returns the organization, project, and repository name of an
+// Azure Repos URL, e.g. https://dev.azure.com/<org>/<project>/_git/<repo>.
+func parseRepoURL(repoURL string) (org, project, repo string) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, part := range parts {
+		if part != "_git" || i+1 >= len(parts) {
+			continue
+		}
+		repo = parts[i+1]
+		if i >= 1 {
+			project = parts[i-1]
+		}
+		if i >= 2 {
+			org = parts[i-2]
+		}
+		break
+	}
+	return org, project, repo
+}
+
+// normalizeRepos validates the configured repository URLs and strips any user
+// information from them.
+func normalizeRepos(repos []string) ([]string, []error) {
+	// Optimistically allocate space for all valid repositories.
+	validRepos := make([]string, 0, len(repos))
+	var errs []error
+	for _, r := range repos {
+		u, err := url.Parse(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse azure repos url %q: %w", r, err))
+			continue
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			errs = append(errs, fmt.Errorf("azure repos url %q must use http or https", r))
+			continue
+		}
+		if _, _, repo := parseRepoURL(r); repo == "" {
+			errs = append(errs, fmt.Errorf("azure repos url %q is missing the /_git/<repo> path", r))
+			continue
+		}
+		u.User = nil
+		validRepos = append(validRepos, u.String())
+	}
+	return validRepos, errs
+}
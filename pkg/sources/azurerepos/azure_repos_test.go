@@ -0,0 +1,191 @@
+package azurerepos
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func initSource(t *testing.T, conn *sourcespb.AzureRepos) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - azure repos", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func unitIDs(units []sources.SourceUnit) []string {
+	ids := make([]string, 0, len(units))
+	for _, unit := range units {
+		id, _ := unit.SourceUnitID()
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestEnumerate_Organization(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/acme/_apis/projects", func(w http.ResponseWriter, r *http.Request) {
+		_, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "super-secret", pass)
+		if r.URL.Query().Get("continuationToken") == "next" {
+			_, _ = fmt.Fprint(w, `{"count": 1, "value": [{"id": "3", "name": "Sandbox"}]}`)
+			return
+		}
+		w.Header().Set(continuationHeader, "next")
+		_, _ = fmt.Fprint(w, `{"count": 2, "value": [{"id": "1", "name": "Platform"}, {"id": "2", "name": "Ops"}]}`)
+	})
+	mux.HandleFunc("/acme/Platform/_apis/git/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"count": 4, "value": [
+			{"name": "api", "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/api"},
+			{"name": "api-fork", "isFork": true, "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/api-fork"},
+			{"name": "legacy", "isDisabled": true, "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/legacy"},
+			{"name": "scratch", "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/scratch"}
+		]}`)
+	})
+	mux.HandleFunc("/acme/Ops/_apis/git/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"count": 1, "value": [
+			{"name": "infra", "remoteUrl": "https://acme@dev.azure.com/acme/Ops/_git/infra"}
+		]}`)
+	})
+	mux.HandleFunc("/acme/Sandbox/_apis/git/repositories", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("excluded project should not be listed")
+	})
+
+	s := initSource(t, &sourcespb.AzureRepos{
+		Endpoint:       server.URL,
+		Credential:     &sourcespb.AzureRepos_Token{Token: "super-secret"},
+		Organizations:  []string{"acme"},
+		IgnoreProjects: []string{"Sand*"},
+		IgnoreRepos:    []string{"Platform/scr*"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []string{
+		"https://dev.azure.com/acme/Platform/_git/api",
+		"https://dev.azure.com/acme/Ops/_git/infra",
+	}, unitIDs(reporter.Units))
+}
+
+func TestEnumerate_IncludeForks(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/acme/Platform/_apis/git/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"count": 2, "value": [
+			{"name": "api", "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/api"},
+			{"name": "api-fork", "isFork": true, "remoteUrl": "https://acme@dev.azure.com/acme/Platform/_git/api-fork"}
+		]}`)
+	})
+
+	s := initSource(t, &sourcespb.AzureRepos{
+		Endpoint:      server.URL,
+		Credential:    &sourcespb.AzureRepos_Token{Token: "super-secret"},
+		Organizations: []string{"acme"},
+		Projects:      []string{"Platform"},
+		IncludeForks:  true,
+		IncludeRepos:  []string{"Platform/api*"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []string{
+		"https://dev.azure.com/acme/Platform/_git/api",
+		"https://dev.azure.com/acme/Platform/_git/api-fork",
+	}, unitIDs(reporter.Units))
+}
+
+func TestEnumerate_InvalidToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	s := initSource(t, &sourcespb.AzureRepos{
+		Endpoint:      server.URL,
+		Credential:    &sourcespb.AzureRepos_Token{Token: "bad"},
+		Organizations: []string{"acme"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Len(t, reporter.UnitErrs, 1)
+	assert.Empty(t, reporter.Units)
+}
+
+func TestEnumerate_ConfiguredRepos(t *testing.T) {
+	s := initSource(t, &sourcespb.AzureRepos{
+		Credential: &sourcespb.AzureRepos_Token{Token: "super-secret"},
+		Repositories: []string{
+			"https://acme@dev.azure.com/acme/Platform/_git/api",
+			"https://dev.azure.com/acme/Platform",
+		},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Len(t, reporter.UnitErrs, 1)
+	assert.Equal(t, []string{"https://dev.azure.com/acme/Platform/_git/api"}, unitIDs(reporter.Units))
+}
+
+func TestInit_RequiresOrganizationOrRepo(t *testing.T) {
+	conn, err := anypb.New(&sourcespb.AzureRepos{
+		Credential: &sourcespb.AzureRepos_Token{Token: "super-secret"},
+	})
+	require.NoError(t, err)
+	assert.Error(t, (&Source{}).Init(context.Background(), "test - azure repos", 0, 0, false, conn, 1))
+}
+
+func TestGenerateLink(t *testing.T) {
+	cloud := &Source{cloud: true}
+	assert.Equal(t,
+		"https://dev.azure.com/acme/Platform/_git/api/commit/abc123/main.go?line=3",
+		cloud.generateLink("https://dev.azure.com/acme/Platform/_git/api", "abc123", "main.go", 3),
+	)
+
+	server := &Source{}
+	assert.Equal(t,
+		"https://tfs.example.com/DefaultCollection/Platform/_git/api/commit/abc123",
+		server.generateLink("https://tfs.example.com/DefaultCollection/Platform/_git/api", "abc123", "main.go", 3),
+	)
+}
+
+func TestParseRepoURL(t *testing.T) {
+	tests := []struct {
+		url                string
+		org, project, repo string
+	}{
+		{url: "https://dev.azure.com/acme/Platform/_git/api", org: "acme", project: "Platform", repo: "api"},
+		{url: "https://tfs.example.com/tfs/DefaultCollection/Platform/_git/api", org: "DefaultCollection", project: "Platform", repo: "api"},
+		{url: "https://dev.azure.com/acme/Platform"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			org, project, repo := parseRepoURL(tt.url)
+			assert.Equal(t, tt.org, org)
+			assert.Equal(t, tt.project, project)
+			assert.Equal(t, tt.repo, repo)
+		})
+	}
+}
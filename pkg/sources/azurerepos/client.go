@@ -0,0 +1,143 @@
+package azurerepos
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// cloudEndpoint is the Azure DevOps Services endpoint. Azure DevOps Server
+	// instances use their own endpoint, with collections in place of
+	// organizations.
+	cloudEndpoint = "https://dev.azure.com"
+
+	apiVersion = "6.0"
+	pageLimit  = 100
+
+	// continuationHeader carries the token for the next page of results.
+	continuationHeader = "X-Ms-Continuationtoken"
+)
+
+type project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type repository struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	RemoteURL  string  `json:"remoteUrl"`
+	WebURL     string  `json:"webUrl"`
+	IsFork     bool    `json:"isFork"`
+	IsDisabled bool    `json:"isDisabled"`
+	Project    project `json:"project"`
+}
+
+type listResponse[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
+
+// client is a minimal Azure DevOps REST client authenticated with a personal
+// access token.
+type client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// get requests the given URL, decodes the JSON response into target, and
+// returns the continuation token for the next page, if any.
+func (c *client) get(ctx context.Context, reqURL string, target any) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Azure DevOps API request: %w", err)
+	}
+	// Personal access tokens are sent as the password of basic auth with an
+	// empty username.
+	req.SetBasicAuth("", c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to make request to Azure DevOps API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return "", fmt.Errorf("invalid credentials or insufficient permissions, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode == http.StatusNonAuthoritativeInfo:
+		// Azure DevOps redirects unauthenticated API requests to a sign-in page
+		// and reports it with a 203.
+		return "", fmt.Errorf("invalid credentials, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return "", fmt.Errorf("failed to decode Azure DevOps API response: %w", err)
+	}
+	return resp.Header.Get(continuationHeader), nil
+}
+
+// listProjects returns the names of every project of an organization that is
+// visible to the token.
+func (c *client) listProjects(ctx context.Context, org string) ([]string, error) {
+	var (
+		names        []string
+		continuation string
+	)
+	for {
+		query := url.Values{
+			"api-version": {apiVersion},
+			"$top":        {strconv.Itoa(pageLimit)},
+		}
+		if continuation != "" {
+			query.Set("continuationToken", continuation)
+		}
+
+		var page listResponse[project]
+		next, err := c.get(ctx, c.baseURL+"/"+url.PathEscape(org)+"/_apis/projects?"+query.Encode(), &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range page.Value {
+			names = append(names, p.Name)
+		}
+		if next == "" || next == continuation || len(page.Value) == 0 {
+			return names, nil
+		}
+		continuation = next
+	}
+}
+
+// listRepos returns every git repository of a project.
+func (c *client) listRepos(ctx context.Context, org, project string) ([]repository, error) {
+	query := url.Values{"api-version": {apiVersion}}
+	reqURL := c.baseURL + "/" + url.PathEscape(org) + "/" + url.PathEscape(project) + "/_apis/git/repositories?" + query.Encode()
+
+	var page listResponse[repository]
+	if _, err := c.get(ctx, reqURL, &page); err != nil {
+		return nil, err
+	}
+	return page.Value, nil
+}
+
+// cloneURL returns the HTTPS clone URL of a repository without the user
+// information Azure DevOps embeds in it, so the token can be injected instead.
+func (r repository) cloneURL() string {
+	u, err := url.Parse(r.RemoteURL)
+	if err != nil || r.RemoteURL == "" {
+		return r.WebURL
+	}
+	u.User = nil
+	return u.String()
+}
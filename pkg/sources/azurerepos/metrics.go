@@ -0,0 +1,26 @@
+package azurerepos
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+)
+
+var (
+	azureReposEnumerated = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "azure_repos_enumerated",
+		Help:      "Total number of Azure Repos repositories enumerated.",
+	},
+		[]string{"source_name"})
+
+	azureReposScanned = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "azure_repos_scanned",
+		Help:      "Total number of Azure Repos repositories scanned.",
+	},
+		[]string{"source_name"})
+)
@@ -0,0 +1,318 @@
+package azurestorage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/cache"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/cache/memory"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_AZURE_STORAGE
+
+	defaultMaxObjectSize = 250 * 1024 * 1024 // 250 MiB
+
+	defaultCachePersistIncrement = 2500
+)
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+
+// Source represents an Azure Blob Storage source.
+type Source struct {
+	name     string
+	jobID    sources.JobID
+	sourceID sources.SourceID
+	verify   bool
+
+	client          *azblob.Client
+	containers      []string
+	containerFilter *glob.Filter
+	blobFilter      *glob.Filter
+	maxObjectSize   int64
+
+	jobPool *errgroup.Group
+	mu      sync.Mutex
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+// SourceID number for Azure Storage Source.
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+// JobID number for Azure Storage Source.
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Azure Storage source.
+func (s *Source) Init(ctx context.Context, name string, jobID sources.JobID, sourceID sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.jobID = jobID
+	s.sourceID = sourceID
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.AzureStorage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	client, err := newClient(&conn)
+	if err != nil {
+		return fmt.Errorf("error creating Azure Storage client: %w", err)
+	}
+	s.client = client
+
+	s.containers = conn.GetStorageContainers()
+	if _, ok := conn.GetCredential().(*sourcespb.AzureStorage_Unauthenticated); ok && len(s.containers) == 0 {
+		return fmt.Errorf("containers must be provided when scanning without authentication")
+	}
+
+	containerFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeContainers()...),
+		glob.WithExcludeGlobs(conn.GetExcludeContainers()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile container globs: %w", err)
+	}
+	blobFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeBlobs()...),
+		glob.WithExcludeGlobs(conn.GetExcludeBlobs()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile blob globs: %w", err)
+	}
+	s.containerFilter, s.blobFilter = containerFilter, blobFilter
+
+	s.setMaxObjectSize(conn.GetMaxObjectSize())
+
+	return nil
+}
+
+// setMaxObjectSize sets the maximum size of blobs that will be scanned. If
+// not set or set to a non-positive number, defaultMaxObjectSize is used.
+func (s *Source) setMaxObjectSize(maxObjectSize int64) {
+	if maxObjectSize <= 0 {
+		s.maxObjectSize = defaultMaxObjectSize
+		return
+	}
+	s.maxObjectSize = maxObjectSize
+}
+
+// persistableCache is a wrapper around cache.Cache that persists the cache
+// contents in the Progress of the source at given increments, so an
+// interrupted scan can skip the blobs it already processed.
+type persistableCache struct {
+	persistIncrement int
+	cache.Cache[string]
+	progress func(encodedResumeInfo string)
+}
+
+// Set overrides the cache Set method to enable the persistence of the cache
+// contents at given increments.
+func (c *persistableCache) Set(key, val string) {
+	c.Cache.Set(key, val)
+	if c.Count()%c.persistIncrement == 0 {
+		c.progress(c.Contents())
+	}
+}
+
+func (s *Source) setupCache(ctx context.Context, progress func(string)) *persistableCache {
+	var c cache.Cache[string]
+	if s.GetProgress().EncodedResumeInfo != "" {
+		keys := strings.Split(s.GetProgress().EncodedResumeInfo, ",")
+		entries := make([]memory.CacheEntry[string], len(keys))
+		for i, val := range keys {
+			entries[i] = memory.CacheEntry[string]{Key: val, Value: val}
+		}
+
+		c = memory.NewWithData[string](entries)
+		ctx.Logger().V(3).Info("loaded cache", "num_entries", len(entries))
+	} else {
+		c = memory.New[string]()
+	}
+
+	return &persistableCache{persistIncrement: defaultCachePersistIncrement, Cache: c, progress: progress}
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	containers, err := s.listContainers(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing containers: %w", err)
+	}
+
+	var current int
+	progressCache := s.setupCache(ctx, func(encodedResumeInfo string) {
+		s.SetProgressComplete(current, len(containers), s.GetProgress().Message, encodedResumeInfo)
+	})
+
+	scanErrs := sources.NewScanErrors()
+	for i, container := range containers {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		s.mu.Lock()
+		current = i
+		s.SetProgressComplete(i, len(containers), fmt.Sprintf("Container: %s", container), progressCache.Contents())
+		s.mu.Unlock()
+
+		if err := s.scanContainer(ctx, container, progressCache, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning container", "container", container)
+			scanErrs.Add(err)
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(containers), len(containers), fmt.Sprintf("Completed scanning %d containers", len(containers)), "")
+
+	return nil
+}
+
+// listContainers returns the configured containers, or every container of the
+// storage account, filtered with the include and exclude globs.
+func (s *Source) listContainers(ctx context.Context) ([]string, error) {
+	containers := s.containers
+	if len(containers) == 0 {
+		pager := s.client.NewListContainersPager(nil)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range page.ContainerItems {
+				if item.Name != nil {
+					containers = append(containers, *item.Name)
+				}
+			}
+		}
+	}
+
+	filtered := make([]string, 0, len(containers))
+	for _, container := range containers {
+		if !s.containerFilter.ShouldInclude(container) {
+			ctx.Logger().V(3).Info("skipping container", "container", container)
+			continue
+		}
+		filtered = append(filtered, container)
+	}
+	return filtered, nil
+}
+
+// scanContainer lists the blobs of a container and schedules each of them to
+// be scanned by the job pool.
+func (s *Source) scanContainer(ctx context.Context, container string, progressCache *persistableCache, chunksChan chan *sources.Chunk) error {
+	ctx = context.WithValue(ctx, "container", container)
+
+	pager := s.client.NewListBlobsFlatPager(container, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil || item.Properties == nil {
+				continue
+			}
+			blob := *item.Name
+			if !s.blobFilter.ShouldInclude(blob) {
+				continue
+			}
+			if size := item.Properties.ContentLength; size != nil && *size > s.maxObjectSize {
+				ctx.Logger().V(3).Info("skipping blob over the size limit", "blob", blob, "size", *size)
+				continue
+			}
+
+			var etag string
+			if item.Properties.ETag != nil {
+				etag = string(*item.Properties.ETag)
+			}
+			if etag != "" && progressCache.Exists(etag) {
+				ctx.Logger().V(5).Info("skipping blob, blob already processed", "blob", blob)
+				continue
+			}
+
+			var uploaded string
+			if item.Properties.LastModified != nil {
+				uploaded = item.Properties.LastModified.String()
+			}
+
+			s.jobPool.Go(func() error {
+				if common.IsDone(ctx) {
+					return nil
+				}
+				if err := s.scanBlob(ctx, container, blob, uploaded, chunksChan); err != nil {
+					ctx.Logger().V(1).Info("error scanning blob", "blob", blob, "error", err)
+					return nil
+				}
+				if etag != "" {
+					s.mu.Lock()
+					progressCache.Set(etag, etag)
+					s.mu.Unlock()
+				}
+				return nil
+			})
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanBlob(ctx context.Context, container, blob, uploaded string, chunksChan chan *sources.Chunk) error {
+	resp, err := s.client.DownloadStream(ctx, container, blob, nil)
+	if err != nil {
+		return fmt.Errorf("error downloading blob: %w", err)
+	}
+	defer resp.Body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Azure{
+				Azure: &source_metadatapb.Azure{
+					Container: sanitizer.UTF8(container),
+					File:      sanitizer.UTF8(blob),
+					Uploaded:  uploaded,
+					Link:      blobLink(s.client, container, blob),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	return handlers.HandleFile(ctx, resp.Body, chunkSkel, sources.ChanReporter{Ch: chunksChan})
+}
@@ -0,0 +1,92 @@
+package azurestorage
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+)
+
+// serviceURL returns the blob service URL of the storage account. An explicit
+// endpoint takes precedence, which allows targeting Azurite or sovereign clouds.
+func serviceURL(conn *sourcespb.AzureStorage, accountName string) (string, error) {
+	if endpoint := conn.GetEndpoint(); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "", fmt.Errorf("invalid Azure Storage endpoint %q", endpoint)
+		}
+		return strings.TrimRight(u.String(), "/") + "/", nil
+	}
+	if accountName == "" {
+		return "", fmt.Errorf("an account name or endpoint is required")
+	}
+	return fmt.Sprintf("https://%s.blob.core.windows.net/", accountName), nil
+}
+
+// newClient creates a blob service client for the configured credential.
+func newClient(conn *sourcespb.AzureStorage) (*azblob.Client, error) {
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.AzureStorage_ConnectionString:
+		return azblob.NewClientFromConnectionString(cred.ConnectionString, nil)
+	case *sourcespb.AzureStorage_BasicAuth:
+		// Shared key authentication uses the account name as the username and
+		// the account key as the password.
+		accountName := cred.BasicAuth.GetUsername()
+		if conn.GetAccountName() != "" {
+			accountName = conn.GetAccountName()
+		}
+		svcURL, err := serviceURL(conn, accountName)
+		if err != nil {
+			return nil, err
+		}
+		sharedKey, err := azblob.NewSharedKeyCredential(accountName, cred.BasicAuth.GetPassword())
+		if err != nil {
+			return nil, fmt.Errorf("invalid shared key credential: %w", err)
+		}
+		return azblob.NewClientWithSharedKeyCredential(svcURL, sharedKey, nil)
+	case *sourcespb.AzureStorage_SasToken:
+		svcURL, err := serviceURL(conn, conn.GetAccountName())
+		if err != nil {
+			return nil, err
+		}
+		return azblob.NewClientWithNoCredential(svcURL+"?"+strings.TrimPrefix(cred.SasToken, "?"), nil)
+	case *sourcespb.AzureStorage_ClientCredentials:
+		svcURL, err := serviceURL(conn, conn.GetAccountName())
+		if err != nil {
+			return nil, err
+		}
+		creds := cred.ClientCredentials
+		tokenCred, err := azidentity.NewClientSecretCredential(creds.GetTenantId(), creds.GetClientId(), creds.GetClientSecret(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid service principal credential: %w", err)
+		}
+		return azblob.NewClient(svcURL, tokenCred, nil)
+	case *sourcespb.AzureStorage_Unauthenticated:
+		svcURL, err := serviceURL(conn, conn.GetAccountName())
+		if err != nil {
+			return nil, err
+		}
+		return azblob.NewClientWithNoCredential(svcURL, nil)
+	default:
+		return nil, fmt.Errorf("unsupported Azure Storage credential type: %T", conn.GetCredential())
+	}
+}
+
+// blobLink returns the URL of a blob without any SAS query parameters.
+func blobLink(client *azblob.Client, container, blob string) string {
+	u, err := url.Parse(client.URL())
+	if err != nil {
+		return ""
+	}
+	u.RawQuery = ""
+
+	segments := strings.Split(blob, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.TrimRight(u.String(), "/") + "/" + url.PathEscape(container) + "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,509 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/giturl"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_BITBUCKET
+
+// tokenCloneUser is the username Bitbucket Cloud expects when cloning over
+// HTTPS with an access token instead of an app password. Bitbucket Data Center
+// expects the name of the user the token belongs to.
+const tokenCloneUser = "x-token-auth"
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	authMethod string
+	user       string
+	password   string
+	token      string
+	apiURL     string
+	cloud      bool
+
+	// tokenUser is the Data Center user of the access token, looked up once
+	// before the first clone.
+	tokenUserOnce sync.Once
+	tokenUser     string
+	tokenUserErr  error
+
+	repos      []string
+	workspaces []string
+	projects   []string
+	repoFilter *glob.Filter
+
+	useCustomContentWriter bool
+	git                    *git.Git
+	scanOptions            *git.ScanOptions
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// WithCustomContentWriter sets the useCustomContentWriter flag on the source.
+func (s *Source) WithCustomContentWriter() { s.useCustomContentWriter = true }
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Bitbucket source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Bitbucket
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.repos = conn.GetRepositories()
+	s.workspaces = conn.GetWorkspaces()
+	s.projects = conn.GetProjects()
+	ctx.Logger().V(3).Info("setting repo filter patterns", "include", conn.GetIncludeRepos(), "ignore", conn.GetIgnoreRepos())
+	repoFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeRepos()...),
+		glob.WithExcludeGlobs(conn.GetIgnoreRepos()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile repo patterns: %w", err)
+	}
+	s.repoFilter = repoFilter
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Bitbucket_Token:
+		s.authMethod = "TOKEN"
+		s.token = cred.Token
+	case *sourcespb.Bitbucket_Oauth:
+		s.authMethod = "OAUTH"
+		if cred.Oauth.GetAccessToken() == "" {
+			return fmt.Errorf("an OAuth access token is required for source %q", name)
+		}
+		s.token = cred.Oauth.GetAccessToken()
+	case *sourcespb.Bitbucket_BasicAuth:
+		s.authMethod = "BASIC_AUTH"
+		s.user = cred.BasicAuth.GetUsername()
+		s.password = cred.BasicAuth.GetPassword()
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	s.apiURL, s.cloud, err = parseEndpoint(conn.GetEndpoint())
+	if err != nil {
+		return err
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Bitbucket{
+					Bitbucket: &source_metadatapb.Bitbucket{
+						Commit:     sanitizer.UTF8(commit),
+						File:       sanitizer.UTF8(file),
+						Email:      sanitizer.UTF8(email),
+						Repository: sanitizer.UTF8(repository),
+						Workspace:  sanitizer.UTF8(repoOwner(repository)),
+						Link:       s.generateLink(repository, commit, file, line),
+						Timestamp:  sanitizer.UTF8(timestamp),
+						Line:       line,
+					},
+				},
+			}
+		},
+		UseCustomContentWriter: s.useCustomContentWriter,
+	}
+	s.git = git.NewGit(cfg)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+func (s *Source) newClient() *client {
+	c := &client{
+		baseURL:    s.apiURL,
+		cloud:      s.cloud,
+		httpClient: common.RetryableHTTPClientTimeout(60),
+	}
+	if s.authMethod == "BASIC_AUTH" {
+		c.username, c.password = s.user, s.password
+	} else {
+		c.token = s.token
+	}
+	return c
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	bitbucketReposScanned.WithLabelValues(s.name).Set(0)
+
+	var repos []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	s.repos = repos
+	// We must sort the repos so we can resume later if necessary.
+	slices.Sort(s.repos)
+
+	return s.scanRepos(ctx, chunksChan)
+}
+
+func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	// If there is resume information available, limit this scan to only the repos that still need scanning.
+	reposToScan, progressIndexOffset := sources.FilterReposToResume(s.repos, s.GetProgress().EncodedResumeInfo)
+	ctx.Logger().V(2).Info("filtered repos to resume", "before", len(s.repos), "after", len(reposToScan))
+	s.repos = reposToScan
+	scanErrs := sources.NewScanErrors()
+
+	for i, repo := range s.repos {
+		i, repoURL := i, repo
+		s.jobPool.Go(func() error {
+			logger := ctx.Logger().WithValues("repo", repoURL)
+			if common.IsDone(ctx) {
+				// We are returning nil instead of the scanErrors slice here because
+				// we don't want to mark this scan as errored if we cancelled it.
+				logger.V(2).Info("Skipping repo because context was cancelled")
+				return nil
+			}
+
+			if len(repoURL) == 0 {
+				logger.V(2).Info("Skipping empty repo")
+				return nil
+			}
+
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, repoURL)
+			// Ensure the repo is removed from the resume info after being scanned.
+			defer func(s *Source) {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
+			}(s)
+
+			path, repo, err := s.cloneRepo(ctx, repoURL)
+			if err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			defer os.RemoveAll(path)
+
+			logger.V(2).Info("starting scan", "num", i+1, "total", len(s.repos))
+			if err = s.git.ScanRepo(ctx, repo, path, s.scanOptions, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			bitbucketReposScanned.WithLabelValues(s.name).Inc()
+
+			logger.V(2).Info("completed scan", "num", i+1, "total", len(s.repos))
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(s.repos), len(s.repos), "Completed Bitbucket scan", "")
+
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index int, offset int, repoURL string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	// Add the repoURL to the resume info slice.
+	s.resumeInfoSlice = append(s.resumeInfoSlice, repoURL)
+	sort.Strings(s.resumeInfoSlice)
+
+	// Make the resume info string from the slice.
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	// Add the offset to both the index and the repos to give the proper place and proper repo count.
+	s.SetProgressComplete(index+offset, len(s.repos)+offset, fmt.Sprintf("Repo: %s", repoURL), encodedResumeInfo)
+}
+
+// cloneRepo clones the repository using the credentials the source was
+// configured with.
+func (s *Source) cloneRepo(ctx context.Context, repoURL string) (string, *gogit.Repository, error) {
+	user, secret, err := s.cloneCredentials(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return git.CloneRepoUsingToken(ctx, secret, repoURL, user)
+}
+
+// cloneCredentials returns the username and secret to clone repositories
+// with. Access tokens are used with a fixed username on Bitbucket Cloud, and
+// with the name of their user on Bitbucket Data Center.
+func (s *Source) cloneCredentials(ctx context.Context) (string, string, error) {
+	switch {
+	case s.authMethod == "BASIC_AUTH":
+		return s.user, s.password, nil
+	case s.cloud:
+		return tokenCloneUser, s.token, nil
+	}
+
+	s.tokenUserOnce.Do(func() {
+		s.tokenUser, s.tokenUserErr = s.newClient().currentUser(ctx)
+	})
+	if s.tokenUserErr != nil {
+		return "", "", fmt.Errorf("could not look up the user of the access token: %w", s.tokenUserErr)
+	}
+	return s.tokenUser, s.token, nil
+}
+
+func (s *Source) Validate(ctx context.Context) []error {
+	var errs []error
+	c := s.newClient()
+
+	// Listing the top level owners validates the configured credential.
+	var err error
+	if s.cloud {
+		_, err = c.listWorkspaces(ctx)
+	} else {
+		_, err = c.listProjects(ctx)
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("bitbucket authentication failed using method %v: %w", s.authMethod, err))
+	}
+
+	repos, normalizeErrs := s.normalizeRepos(s.repos)
+	errs = append(errs, normalizeErrs...)
+	if len(repos) == 0 {
+		return errs
+	}
+	cloneUser, cloneSecret, err := s.cloneCredentials(ctx)
+	if err != nil {
+		return append(errs, err)
+	}
+	for _, r := range repos {
+		if err := git.PingRepoUsingToken(ctx, cloneSecret, r, cloneUser); err != nil {
+			errs = append(errs, fmt.Errorf("could not reach git repository %q: %w", r, err))
+		}
+	}
+
+	return errs
+}
+
+// Enumerate reports all Bitbucket repositories to be scanned to the reporter.
+// If no repositories are configured, it will find all repositories within the
+// configured (or all accessible) workspaces or projects, while respecting the
+// configured include and ignore rules.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	bitbucketReposEnumerated.WithLabelValues(s.name).Set(0)
+
+	repos, errs := s.normalizeRepos(s.repos)
+	for _, repoErr := range errs {
+		ctx.Logger().Info("error normalizing repo", "error", repoErr)
+		if err := reporter.UnitErr(ctx, repoErr); err != nil {
+			return err
+		}
+	}
+
+	// End early if we had errors getting specified repos but none were validated.
+	if len(errs) > 0 && len(repos) == 0 {
+		return fmt.Errorf("all configured repos had validation issues")
+	}
+
+	// Report all repos if specified.
+	if len(repos) > 0 {
+		for _, repo := range repos {
+			unit := git.SourceUnit{Kind: git.UnitRepo, ID: repo}
+			if err := reporter.UnitOk(ctx, unit); err != nil {
+				return err
+			}
+			bitbucketReposEnumerated.WithLabelValues(s.name).Inc()
+		}
+		return nil
+	}
+
+	c := s.newClient()
+	owners, kind := s.workspaces, "workspace"
+	if !s.cloud {
+		owners, kind = s.projects, "project"
+	}
+
+	// Enumerate every owner the credential has access to if none were configured.
+	if len(owners) == 0 {
+		ctx.Logger().Info("no " + kind + "s configured, enumerating")
+		var err error
+		if s.cloud {
+			owners, err = c.listWorkspaces(ctx)
+		} else {
+			owners, err = c.listProjects(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("could not list %ss: %w", kind, err)
+		}
+	}
+
+	for _, owner := range owners {
+		ctx := context.WithValue(ctx, kind, owner)
+		err := c.listRepos(ctx, owner, func(repo repository) error {
+			if !s.repoFilter.ShouldInclude(repo.FullName) {
+				ctx.Logger().V(3).Info("skipping repository", "repo", repo.FullName, "reason", "filtered in config")
+				return nil
+			}
+			if repo.CloneURL == "" {
+				return reporter.UnitErr(ctx, fmt.Errorf("repository %q has no HTTP clone link", repo.FullName))
+			}
+			bitbucketReposEnumerated.WithLabelValues(s.name).Inc()
+			return reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repo.CloneURL})
+		})
+		if err != nil {
+			err = fmt.Errorf("error listing repositories for %s %q: %w", kind, owner, err)
+			if err := reporter.UnitErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ChunkUnit clones and reports chunks for the given Bitbucket repository unit.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repoURL, _ := unit.SourceUnitID()
+
+	path, repo, err := s.cloneRepo(ctx, repoURL)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	return s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter)
+}
+
+// generateLink crafts a link to the commit the finding was introduced in. Cloud
+// links are delegated to giturl; Data Center links are derived from the
+// /scm/<project>/<repo>.git clone URL.
+func (s *Source) generateLink(repository, commit, file string, line int64) string {
+	if s.cloud {
+		return giturl.GenerateLink(repository, commit, file, line)
+	}
+
+	u, err := url.Parse(repository)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.TrimSuffix(u.Path, ".git"), "/")
+	if len(parts) < 3 || parts[len(parts)-3] != "scm" {
+		return ""
+	}
+	project, slug := parts[len(parts)-2], parts[len(parts)-1]
+	u.Path = strings.Join(parts[:len(parts)-3], "/") + "/projects/" + strings.ToUpper(project) + "/repos/" + slug + "/commits/" + commit
+	u.User = nil
+	return u.String()
+}
+
+// repoOwner returns the workspace (Cloud) or project (Data Center) segment of a
+// repository clone URL.
+func repoOwner(repository string) string {
+	u, err := url.Parse(repository)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+// normalizeRepos normalizes the configured repository URLs. Bitbucket Cloud
+// repositories are cloned over HTTPS, while Data Center repositories may also
+// use the scheme of the configured endpoint.
+func (s *Source) normalizeRepos(repos []string) ([]string, []error) {
+	allowHTTP := !s.cloud && strings.HasPrefix(s.apiURL, "http://")
+
+	// Optimistically allocate space for all valid repositories.
+	validRepos := make([]string, 0, len(repos))
+	var errs []error
+	for _, r := range repos {
+		var repo string
+		var err error
+		if allowHTTP && strings.HasPrefix(r, "http://") {
+			repo, err = giturl.NormalizeOrgRepoURL("Bitbucket", r)
+		} else {
+			repo, err = giturl.NormalizeBitbucketRepo(r)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to normalize bitbucket repo url %q: %w", r, err))
+			continue
+		}
+
+		validRepos = append(validRepos, repo)
+	}
+	return validRepos, errs
+}
@@ -0,0 +1,308 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func initSource(t *testing.T, conn *sourcespb.Bitbucket) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - bitbucket", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func unitIDs(units []sources.SourceUnit) []string {
+	ids := make([]string, 0, len(units))
+	for _, unit := range units {
+		id, _ := unit.SourceUnitID()
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint  string
+		wantURL   string
+		wantCloud bool
+	}{
+		{endpoint: "", wantURL: cloudAPIURL, wantCloud: true},
+		{endpoint: "https://bitbucket.org", wantURL: cloudAPIURL, wantCloud: true},
+		{endpoint: "api.bitbucket.org", wantURL: cloudAPIURL, wantCloud: true},
+		{endpoint: "http://127.0.0.1:8080/2.0/", wantURL: "http://127.0.0.1:8080/2.0", wantCloud: true},
+		{endpoint: "https://git.example.com", wantURL: "https://git.example.com/rest/api/1.0", wantCloud: false},
+		{endpoint: "https://example.com/bitbucket/", wantURL: "https://example.com/bitbucket/rest/api/1.0", wantCloud: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			gotURL, gotCloud, err := parseEndpoint(tt.endpoint)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantURL, gotURL)
+			assert.Equal(t, tt.wantCloud, gotCloud)
+		})
+	}
+}
+
+func TestEnumerate_Cloud(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/2.0/user/permissions/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer super-secret", r.Header.Get("Authorization"))
+		_, _ = fmt.Fprint(w, `{"values": [{"workspace": {"slug": "acme"}}]}`)
+	})
+	mux.HandleFunc("/2.0/repositories/acme", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = fmt.Fprint(w, `{"values": [
+				{"full_name": "acme/archived", "links": {"clone": [{"name": "https", "href": "https://someone@bitbucket.org/acme/archived.git"}]}}
+			]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"values": [
+			{"full_name": "acme/api", "links": {"clone": [{"name": "https", "href": "https://someone@bitbucket.org/acme/api.git"}, {"name": "ssh", "href": "git@bitbucket.org:acme/api.git"}]}},
+			{"full_name": "acme/web", "links": {"clone": [{"name": "https", "href": "https://someone@bitbucket.org/acme/web.git"}]}}
+		], "next": "%s/2.0/repositories/acme?page=2"}`, server.URL)
+	})
+
+	s := initSource(t, &sourcespb.Bitbucket{
+		Endpoint:    server.URL + "/2.0",
+		Credential:  &sourcespb.Bitbucket_Token{Token: "super-secret"},
+		IgnoreRepos: []string{"acme/arch*"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []string{
+		"https://bitbucket.org/acme/api.git",
+		"https://bitbucket.org/acme/web.git",
+	}, unitIDs(reporter.Units))
+}
+
+func TestEnumerate_DataCenter(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/rest/api/1.0/projects", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "dev", user)
+		assert.Equal(t, "app-password", pass)
+		_, _ = fmt.Fprint(w, `{"values": [{"key": "PLAT"}, {"key": "OPS"}], "isLastPage": true}`)
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PLAT/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "1" {
+			_, _ = fmt.Fprintf(w, `{"values": [
+				{"slug": "gateway", "project": {"key": "PLAT"}, "links": {"clone": [{"name": "http", "href": "%s/scm/plat/gateway.git"}]}}
+			], "isLastPage": true}`, server.URL)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"values": [
+			{"slug": "core", "project": {"key": "PLAT"}, "links": {"clone": [{"name": "http", "href": "%s/scm/plat/core.git"}]}}
+		], "isLastPage": false, "nextPageStart": 1}`, server.URL)
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/OPS/repos", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"values": [
+			{"slug": "infra", "project": {"key": "OPS"}, "links": {"clone": [{"name": "http", "href": "%s/scm/ops/infra.git"}]}}
+		], "isLastPage": true}`, server.URL)
+	})
+
+	s := initSource(t, &sourcespb.Bitbucket{
+		Endpoint: server.URL,
+		Credential: &sourcespb.Bitbucket_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: "dev", Password: "app-password"},
+		},
+		IncludeRepos: []string{"PLAT/*"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []string{
+		server.URL + "/scm/plat/core.git",
+		server.URL + "/scm/plat/gateway.git",
+	}, unitIDs(reporter.Units))
+}
+
+func TestEnumerate_ConfiguredRepos(t *testing.T) {
+	s := initSource(t, &sourcespb.Bitbucket{
+		Credential:   &sourcespb.Bitbucket_Token{Token: "super-secret"},
+		Repositories: []string{"https://bitbucket.org/acme/api", "http://bitbucket.org/acme/insecure"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Len(t, reporter.UnitErrs, 1)
+	assert.Equal(t, []string{"https://bitbucket.org/acme/api.git"}, unitIDs(reporter.Units))
+}
+
+func TestEnumerate_DataCenterHTTPRepos(t *testing.T) {
+	s := initSource(t, &sourcespb.Bitbucket{
+		Endpoint:     "http://bitbucket.internal",
+		Credential:   &sourcespb.Bitbucket_Token{Token: "http-token"},
+		Repositories: []string{"http://bitbucket.internal/scm/plat/core.git", "ftp://bitbucket.internal/scm/plat/core.git"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Len(t, reporter.UnitErrs, 1)
+	assert.Equal(t, []string{"http://bitbucket.internal/scm/plat/core.git"}, unitIDs(reporter.Units))
+}
+
+// newGitRepo creates a bare repository with a single commit adding the given
+// file, under root.
+func newGitRepo(t *testing.T, root, name, file, content string) {
+	t.Helper()
+
+	work := t.TempDir()
+	runGit := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Dev", "-c", "user.email=dev@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit(work, "init", "--quiet")
+	require.NoError(t, os.WriteFile(filepath.Join(work, file), []byte(content), 0o644))
+	runGit(work, "add", file)
+	runGit(work, "commit", "--quiet", "-m", "add "+file)
+	runGit(root, "clone", "--quiet", "--bare", work, name)
+}
+
+func TestChunkUnit_DataCenterToken(t *testing.T) {
+	gitPath, err := exec.LookPath("git")
+	require.NoError(t, err)
+	t.Setenv("GIT_TERMINAL_PROMPT", "0")
+
+	root := t.TempDir()
+	newGitRepo(t, root, "core.git", "config.env", "PASSWORD=hunter2\n")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// HTTP access tokens authenticate as their own user, whose name Data
+	// Center expects along with the token when cloning.
+	mux.HandleFunc("/rest/api/1.0/application-properties", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer http-token", r.Header.Get("Authorization"))
+		w.Header().Set("X-AUSERNAME", "bot-plat")
+		_, _ = fmt.Fprint(w, `{"version": "8.19.0"}`)
+	})
+	backend := &cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Root: "/scm/plat",
+		Env:  []string{"GIT_PROJECT_ROOT=" + root, "GIT_HTTP_EXPORT_ALL=1"},
+	}
+	mux.HandleFunc("/scm/plat/", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "bot-plat" || pass != "http-token" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Bitbucket"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		backend.ServeHTTP(w, r)
+	})
+
+	s := initSource(t, &sourcespb.Bitbucket{
+		Endpoint:     server.URL,
+		Credential:   &sourcespb.Bitbucket_Token{Token: "http-token"},
+		Repositories: []string{server.URL + "/scm/plat/core.git"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	require.Empty(t, reporter.UnitErrs)
+	require.Len(t, reporter.Units, 1)
+
+	require.NoError(t, s.ChunkUnit(context.Background(), reporter.Units[0], &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	var found bool
+	for _, chunk := range reporter.Chunks {
+		if strings.Contains(string(chunk.Data), "PASSWORD=hunter2") {
+			found = true
+			assert.Equal(t, "config.env", chunk.SourceMetadata.GetBitbucket().GetFile())
+		}
+	}
+	assert.True(t, found, "the secret of the cloned repository was not reported")
+}
+
+func TestEnumerate_OAuth(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/2.0/repositories/acme", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+		_, _ = fmt.Fprint(w, `{"values": [
+			{"full_name": "acme/api", "links": {"clone": [{"name": "https", "href": "https://bitbucket.org/acme/api.git"}]}}
+		]}`)
+	})
+
+	s := initSource(t, &sourcespb.Bitbucket{
+		Endpoint: server.URL + "/2.0",
+		Credential: &sourcespb.Bitbucket_Oauth{
+			Oauth: &credentialspb.Oauth2{RefreshToken: "refresh-token", AccessToken: "access-token"},
+		},
+		Workspaces: []string{"acme"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []string{"https://bitbucket.org/acme/api.git"}, unitIDs(reporter.Units))
+}
+
+func TestInit_OAuthWithoutAccessToken(t *testing.T) {
+	anyConn, err := anypb.New(&sourcespb.Bitbucket{
+		Credential: &sourcespb.Bitbucket_Oauth{Oauth: &credentialspb.Oauth2{RefreshToken: "refresh-token"}},
+	})
+	require.NoError(t, err)
+
+	s := &Source{}
+	assert.Error(t, s.Init(context.Background(), "test - bitbucket", 0, 0, false, anyConn, 1))
+}
+
+func TestGenerateLink(t *testing.T) {
+	cloud := &Source{cloud: true}
+	assert.Equal(t,
+		"https://bitbucket.org/acme/api/commits/abc123",
+		cloud.generateLink("https://bitbucket.org/acme/api.git", "abc123", "main.go", 3),
+	)
+
+	server := &Source{}
+	assert.Equal(t,
+		"https://git.example.com/projects/PLAT/repos/core/commits/abc123",
+		server.generateLink("https://git.example.com/scm/plat/core.git", "abc123", "main.go", 3),
+	)
+	assert.Equal(t, "", server.generateLink("https://git.example.com/core.git", "abc123", "main.go", 3))
+}
+
+func TestRepoOwner(t *testing.T) {
+	assert.Equal(t, "acme", repoOwner("https://bitbucket.org/acme/api.git"))
+	assert.Equal(t, "plat", repoOwner("https://git.example.com/scm/plat/core.git"))
+	assert.Equal(t, "", repoOwner("https://bitbucket.org/api.git"))
+}
@@ -0,0 +1,286 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// cloudAPIURL is the REST API root for Bitbucket Cloud.
+	cloudAPIURL = "https://api.bitbucket.org/2.0"
+	// serverAPIPath is appended to a Bitbucket Data Center endpoint to reach its REST API.
+	serverAPIPath = "/rest/api/1.0"
+
+	pageLimit = 100
+)
+
+// repository is the subset of repository information shared by Bitbucket
+// Cloud and Bitbucket Data Center that the source cares about.
+type repository struct {
+	// FullName is "workspace/slug" for Cloud and "PROJECT/slug" for Data Center.
+	FullName string
+	CloneURL string
+}
+
+type cloneLink struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+type cloudRepo struct {
+	FullName string `json:"full_name"`
+	Links    struct {
+		Clone []cloneLink `json:"clone"`
+	} `json:"links"`
+}
+
+type cloudWorkspacePermission struct {
+	Workspace struct {
+		Slug string `json:"slug"`
+	} `json:"workspace"`
+}
+
+type serverProject struct {
+	Key string `json:"key"`
+}
+
+type serverRepo struct {
+	Slug    string        `json:"slug"`
+	Project serverProject `json:"project"`
+	Links   struct {
+		Clone []cloneLink `json:"clone"`
+	} `json:"links"`
+}
+
+// client is a minimal REST client that understands both the Bitbucket Cloud
+// (2.0) and the Bitbucket Data Center (1.0) APIs.
+type client struct {
+	baseURL    string
+	cloud      bool
+	username   string
+	password   string
+	token      string
+	httpClient *http.Client
+}
+
+// parseEndpoint determines the REST API root for the configured endpoint and
+// whether it refers to Bitbucket Cloud. An empty endpoint, bitbucket.org, or an
+// endpoint already pointing at a 2.0 API root are treated as Cloud; anything
+// else is assumed to be a Data Center instance.
+func parseEndpoint(endpoint string) (string, bool, error) {
+	if endpoint == "" {
+		return cloudAPIURL, true, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false, fmt.Errorf("could not parse endpoint %q: %w", endpoint, err)
+	}
+	// We probably didn't receive a URL with a scheme, which messed up the parsing.
+	if u.Host == "" {
+		if u, err = url.Parse("https://" + endpoint); err != nil {
+			return "", false, fmt.Errorf("could not parse endpoint %q: %w", endpoint, err)
+		}
+	}
+
+	switch {
+	case u.Host == "bitbucket.org" || u.Host == "api.bitbucket.org":
+		return cloudAPIURL, true, nil
+	case strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), "/2.0"):
+		return strings.TrimSuffix(u.String(), "/"), true, nil
+	default:
+		u.Path = strings.TrimSuffix(u.Path, "/") + serverAPIPath
+		return u.String(), false, nil
+	}
+}
+
+// do sends an authenticated GET request to the API and checks the status of
+// its response, whose body the caller must close.
+func (c *client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Bitbucket API request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Bitbucket API: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		closeBody(resp)
+		return nil, fmt.Errorf("invalid credentials or insufficient permissions, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode != http.StatusOK:
+		closeBody(resp)
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Path)
+	}
+	return resp, nil
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Bitbucket API response: %w", err)
+	}
+	return nil
+}
+
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// currentUser returns the name of the Bitbucket Data Center user the client
+// authenticates as, which Data Center reports in the X-AUSERNAME header of
+// authenticated responses. Project and repository access tokens authenticate
+// as a bot user of their own.
+func (c *client) currentUser(ctx context.Context) (string, error) {
+	resp, err := c.do(ctx, c.baseURL+"/application-properties")
+	if err != nil {
+		return "", err
+	}
+	defer closeBody(resp)
+
+	user := resp.Header.Get("X-AUSERNAME")
+	if user == "" {
+		return "", fmt.Errorf("the Bitbucket API did not report the user of the access token")
+	}
+	return user, nil
+}
+
+// paginateCloud follows the "next" links of a Bitbucket Cloud paginated
+// response, calling visit with the raw values of every page.
+func (c *client) paginateCloud(ctx context.Context, reqURL string, visit func(json.RawMessage) error) error {
+	for reqURL != "" {
+		var page struct {
+			Values []json.RawMessage `json:"values"`
+			Next   string            `json:"next"`
+		}
+		if err := c.get(ctx, reqURL, &page); err != nil {
+			return err
+		}
+		for _, v := range page.Values {
+			if err := visit(v); err != nil {
+				return err
+			}
+		}
+		reqURL = page.Next
+	}
+	return nil
+}
+
+// paginateServer follows the start/limit pagination of a Bitbucket Data Center
+// paginated response, calling visit with the raw values of every page.
+func (c *client) paginateServer(ctx context.Context, reqURL string, visit func(json.RawMessage) error) error {
+	start := 0
+	for {
+		var page struct {
+			Values        []json.RawMessage `json:"values"`
+			IsLastPage    bool              `json:"isLastPage"`
+			NextPageStart int               `json:"nextPageStart"`
+		}
+		pageURL := reqURL + "?limit=" + strconv.Itoa(pageLimit) + "&start=" + strconv.Itoa(start)
+		if err := c.get(ctx, pageURL, &page); err != nil {
+			return err
+		}
+		for _, v := range page.Values {
+			if err := visit(v); err != nil {
+				return err
+			}
+		}
+		if page.IsLastPage || page.NextPageStart <= start {
+			return nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+// listWorkspaces returns the Cloud workspaces the credential is a member of.
+func (c *client) listWorkspaces(ctx context.Context) ([]string, error) {
+	var workspaces []string
+	reqURL := fmt.Sprintf("%s/user/permissions/workspaces?pagelen=%d", c.baseURL, pageLimit)
+	err := c.paginateCloud(ctx, reqURL, func(raw json.RawMessage) error {
+		var perm cloudWorkspacePermission
+		if err := json.Unmarshal(raw, &perm); err != nil {
+			return err
+		}
+		workspaces = append(workspaces, perm.Workspace.Slug)
+		return nil
+	})
+	return workspaces, err
+}
+
+// listProjects returns the Data Center project keys visible to the credential.
+func (c *client) listProjects(ctx context.Context) ([]string, error) {
+	var projects []string
+	err := c.paginateServer(ctx, c.baseURL+"/projects", func(raw json.RawMessage) error {
+		var proj serverProject
+		if err := json.Unmarshal(raw, &proj); err != nil {
+			return err
+		}
+		projects = append(projects, proj.Key)
+		return nil
+	})
+	return projects, err
+}
+
+// listRepos calls visit for every repository in the given Cloud workspace or
+// Data Center project.
+func (c *client) listRepos(ctx context.Context, owner string, visit func(repository) error) error {
+	if c.cloud {
+		reqURL := fmt.Sprintf("%s/repositories/%s?pagelen=%d", c.baseURL, url.PathEscape(owner), pageLimit)
+		return c.paginateCloud(ctx, reqURL, func(raw json.RawMessage) error {
+			var r cloudRepo
+			if err := json.Unmarshal(raw, &r); err != nil {
+				return err
+			}
+			return visit(repository{FullName: r.FullName, CloneURL: httpCloneURL(r.Links.Clone, "https")})
+		})
+	}
+
+	reqURL := fmt.Sprintf("%s/projects/%s/repos", c.baseURL, url.PathEscape(owner))
+	return c.paginateServer(ctx, reqURL, func(raw json.RawMessage) error {
+		var r serverRepo
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return err
+		}
+		return visit(repository{FullName: r.Project.Key + "/" + r.Slug, CloneURL: httpCloneURL(r.Links.Clone, "http")})
+	})
+}
+
+// httpCloneURL returns the clone link with the provided name, stripped of any
+// user information Bitbucket embeds in it.
+func httpCloneURL(links []cloneLink, name string) string {
+	for _, link := range links {
+		if link.Name != name {
+			continue
+		}
+		u, err := url.Parse(link.Href)
+		if err != nil {
+			return link.Href
+		}
+		u.User = nil
+		return u.String()
+	}
+	return ""
+}
@@ -0,0 +1,26 @@
+package bitbucket
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+)
+
+var (
+	bitbucketReposEnumerated = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "bitbucket_repos_enumerated",
+		Help:      "Total number of Bitbucket repositories enumerated.",
+	},
+		[]string{"source_name"})
+
+	bitbucketReposScanned = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "bitbucket_repos_scanned",
+		Help:      "Total number of Bitbucket repositories scanned.",
+	},
+		[]string{"source_name"})
+)
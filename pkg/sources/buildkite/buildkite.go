@@ -0,0 +1,431 @@
+package buildkite
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_BUILDKITE
+
+	defaultEndpoint = "https://api.buildkite.com/v2"
+
+	// unitPipeline units scan the builds of a pipeline. Their ID is the
+	// organization and pipeline slugs, e.g. "acme/deploy".
+	unitPipeline sources.SourceUnitKind = "pipeline"
+
+	defaultMaxArtifactSize = 250 * 1024 * 1024 // 250 MiB
+)
+
+// Source scans the job logs, and optionally the artifacts, of the builds of
+// Buildkite pipelines. Each pipeline is a unit.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint         string
+	token            string
+	organizations    []string
+	pipelines        []string
+	includeArtifacts bool
+	client           *http.Client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Buildkite source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Buildkite
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Buildkite_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		s.token = cred.Token
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	s.endpoint = strings.TrimRight(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	s.organizations = conn.GetOrganizations()
+	s.pipelines = conn.GetPipelines()
+	s.includeArtifacts = conn.GetIncludeArtifacts()
+	s.client = common.RetryableHTTPClient(func(c *retryablehttp.Client) {
+		c.HTTPClient.CheckRedirect = stripTokenOnRedirect
+	})
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating Buildkite pipelines")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned pipeline: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Buildkite scan", "")
+
+	return nil
+}
+
+type organization struct {
+	Slug string `json:"slug"`
+}
+
+type pipeline struct {
+	Slug string `json:"slug"`
+}
+
+type user struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type build struct {
+	Number    int64  `json:"number"`
+	WebURL    string `json:"web_url"`
+	CreatedAt string `json:"created_at"`
+	Creator   *user  `json:"creator"`
+	Author    *user  `json:"author"`
+	Jobs      []job  `json:"jobs"`
+}
+
+// email returns the email of the user who created the build, or of the
+// author of the built commit.
+func (b build) email() string {
+	switch {
+	case b.Creator != nil && b.Creator.Email != "":
+		return b.Creator.Email
+	case b.Author != nil:
+		return b.Author.Email
+	default:
+		return ""
+	}
+}
+
+type job struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	WebURL    string `json:"web_url"`
+	RawLogURL string `json:"raw_log_url"`
+}
+
+type artifact struct {
+	ID          string `json:"id"`
+	JobID       string `json:"job_id"`
+	Path        string `json:"path"`
+	FileSize    int64  `json:"file_size"`
+	DownloadURL string `json:"download_url"`
+}
+
+// Enumerate reports a unit for every pipeline of the configured
+// organizations, or of every organization the token can access, that matches
+// the pipeline filter.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	orgs := s.organizations
+	if len(orgs) == 0 {
+		err := paginate(ctx, s, s.endpoint+"/organizations", func(org organization) error {
+			orgs = append(orgs, org.Slug)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not list organizations: %w", err)
+		}
+	}
+
+	for _, org := range orgs {
+		pipelinesURL := fmt.Sprintf("%s/organizations/%s/pipelines", s.endpoint, url.PathEscape(org))
+		err := paginate(ctx, s, pipelinesURL, func(p pipeline) error {
+			if !s.includePipeline(org, p.Slug) {
+				return nil
+			}
+			return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitPipeline, ID: org + "/" + p.Slug})
+		})
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not list the pipelines of %q: %w", org, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// includePipeline reports whether a pipeline matches the pipeline filter.
+func (s *Source) includePipeline(org, slug string) bool {
+	if len(s.pipelines) == 0 {
+		return true
+	}
+	for _, p := range s.pipelines {
+		if p == slug || p == org+"/"+slug {
+			return true
+		}
+	}
+	return false
+}
+
+// ChunkUnit scans the job logs, and the artifacts when enabled, of every
+// build of a pipeline.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	id, _ := unit.SourceUnitID()
+	org, slug, ok := strings.Cut(id, "/")
+	if !ok {
+		return fmt.Errorf("invalid pipeline unit %q", id)
+	}
+	ctx = context.WithValues(ctx, "org", org, "pipeline", slug)
+	ctx.Logger().V(2).Info("scanning pipeline")
+
+	pipelineURL := fmt.Sprintf("%s/organizations/%s/pipelines/%s", s.endpoint, url.PathEscape(org), url.PathEscape(slug))
+	err := paginate(ctx, s, pipelineURL+"/builds", func(b build) error {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		return s.scanBuild(ctx, org, slug, pipelineURL, b, reporter)
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list the builds of %q: %w", id, err))
+	}
+	return nil
+}
+
+func (s *Source) scanBuild(ctx context.Context, org, slug, pipelineURL string, b build, reporter sources.ChunkReporter) error {
+	metadata := func(j job) *source_metadatapb.MetaData {
+		return &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Buildkite{
+				Buildkite: &source_metadatapb.Buildkite{
+					Org:         org,
+					Pipeline:    slug,
+					Link:        sanitizer.UTF8(j.WebURL),
+					Email:       sanitizer.UTF8(b.email()),
+					BuildNumber: b.Number,
+					Timestamp:   b.CreatedAt,
+					JobId:       j.ID,
+					JobName:     sanitizer.UTF8(j.Name),
+				},
+			},
+		}
+	}
+
+	jobs := make(map[string]job, len(b.Jobs))
+	for _, j := range b.Jobs {
+		jobs[j.ID] = j
+		// Only script jobs run commands, and have logs.
+		if j.Type != "script" || j.RawLogURL == "" {
+			continue
+		}
+		ctx.Logger().V(3).Info("scanning job log", "build", b.Number, "job", j.ID)
+		if err := s.scanURL(ctx, j.RawLogURL, metadata(j), reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not scan the log of job %s of build %d: %w", j.ID, b.Number, err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.includeArtifacts {
+		return nil
+	}
+	artifactsURL := fmt.Sprintf("%s/builds/%d/artifacts", pipelineURL, b.Number)
+	err := paginate(ctx, s, artifactsURL, func(a artifact) error {
+		if a.FileSize > defaultMaxArtifactSize {
+			ctx.Logger().V(3).Info("skipping artifact over the size limit", "path", a.Path, "size", a.FileSize)
+			return nil
+		}
+		if common.SkipFile(a.Path) {
+			ctx.Logger().V(5).Info("skipping artifact with incompatible extension", "path", a.Path)
+			return nil
+		}
+		ctx.Logger().V(3).Info("scanning artifact", "build", b.Number, "path", a.Path)
+
+		j, ok := jobs[a.JobID]
+		if !ok {
+			j = job{ID: a.JobID, WebURL: b.WebURL}
+		}
+		meta := metadata(j)
+		meta.GetBuildkite().Artifact = sanitizer.UTF8(a.Path)
+		if err := s.scanURL(ctx, a.DownloadURL, meta, reporter); err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("could not scan artifact %q of build %d: %w", a.Path, b.Number, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list the artifacts of build %d: %w", b.Number, err))
+	}
+	return nil
+}
+
+// stripTokenOnRedirect keeps the token from being sent to the pre-signed
+// URLs artifacts are downloaded from.
+func stripTokenOnRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after 10 redirects")
+	}
+	if req.URL.Host != via[0].URL.Host {
+		req.Header.Del("Authorization")
+	}
+	return nil
+}
+
+// scanURL scans the content of a job log or artifact.
+func (s *Source) scanURL(ctx context.Context, u string, metadata *source_metadatapb.MetaData, reporter sources.ChunkReporter) error {
+	resp, err := s.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	return handlers.HandleFile(ctx, resp.Body, chunkSkel, reporter)
+}
+
+func (s *Source) get(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, u, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
+
+// paginate calls visit for every item of a paginated API collection,
+// following the next page links.
+func paginate[T any](ctx context.Context, s *Source, u string, visit func(T) error) error {
+	next := u + "?per_page=100"
+	for next != "" {
+		resp, err := s.get(ctx, next)
+		if err != nil {
+			return err
+		}
+		var items []T
+		err = json.NewDecoder(resp.Body).Decode(&items)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode response from %s: %w", next, err)
+		}
+
+		for _, item := range items {
+			if err := visit(item); err != nil {
+				return err
+			}
+		}
+
+		next = nextLink(resp.Header.Get("Link"))
+	}
+	return nil
+}
+
+// nextLink returns the URL of the next page from a Link header, e.g.
+// <https://api.buildkite.com/v2/organizations?page=2>; rel="next".
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		target, params, _ := strings.Cut(link, ";")
+		if strings.Contains(params, `rel="next"`) {
+			return strings.Trim(strings.TrimSpace(target), "<>")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,220 @@
+package buildkite
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	// Artifacts are downloaded from pre-signed URLs on another host.
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/artifacts/env.txt":
+			_, _ = fmt.Fprint(w, "DEPLOY_TOKEN=from-artifact")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(storage.Close)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	handle := func(path string, fn func(w http.ResponseWriter, r *http.Request)) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer bk-token", r.Header.Get("Authorization"))
+			fn(w, r)
+		})
+	}
+
+	handle("/v2/organizations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[{"slug": "acme"}, {"slug": "oss"}]`)
+	})
+	handle("/v2/organizations/acme/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = fmt.Fprint(w, `[{"slug": "release"}]`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/organizations/acme/pipelines?page=2&per_page=100>; rel="next", <%[1]s/v2/organizations/acme/pipelines?page=2&per_page=100>; rel="last"`, server.URL))
+		_, _ = fmt.Fprint(w, `[{"slug": "deploy"}]`)
+	})
+	handle("/v2/organizations/oss/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[{"slug": "docs"}]`)
+	})
+	handle("/v2/organizations/acme/pipelines/deploy/builds", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[{
+			"number": 42,
+			"web_url": "https://buildkite.com/acme/deploy/builds/42",
+			"created_at": "2024-05-01T10:00:00Z",
+			"creator": {"name": "Ada", "email": "ada@acme.test"},
+			"jobs": [
+				{"id": "job-1", "type": "script", "name": ":rocket: Deploy", "web_url": "https://buildkite.com/acme/deploy/builds/42#job-1", "raw_log_url": "%[1]s/v2/logs/job-1"},
+				{"id": "job-2", "type": "waiter"},
+				{"id": "job-3", "type": "script", "name": "Test", "raw_log_url": "%[1]s/v2/logs/job-3"}
+			]
+		}]`, server.URL)
+	})
+	handle("/v2/logs/job-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "$ ./deploy.sh\nAWS_SECRET_ACCESS_KEY=from-log")
+	})
+	handle("/v2/logs/job-3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	handle("/v2/organizations/acme/pipelines/deploy/builds/42/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[
+			{"id": "artifact-1", "job_id": "job-1", "path": "out/env.txt", "file_size": 26, "download_url": "%[1]s/v2/download/artifact-1"},
+			{"id": "artifact-2", "job_id": "job-1", "path": "out/logo.png", "file_size": 1024, "download_url": "%[1]s/v2/download/artifact-2"}
+		]`, server.URL)
+	})
+	handle("/v2/download/artifact-1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/artifacts/env.txt", http.StatusFound)
+	})
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.Buildkite) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - buildkite", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.Buildkite
+		want []string
+	}{
+		{
+			name: "all organizations",
+			conn: &sourcespb.Buildkite{},
+			want: []string{"acme/deploy", "acme/release", "oss/docs"},
+		},
+		{
+			name: "organization",
+			conn: &sourcespb.Buildkite{Organizations: []string{"oss"}},
+			want: []string{"oss/docs"},
+		},
+		{
+			name: "pipelines",
+			conn: &sourcespb.Buildkite{Pipelines: []string{"acme/release", "docs"}},
+			want: []string{"acme/release", "oss/docs"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conn.Endpoint = server.URL + "/v2"
+			tt.conn.Credential = &sourcespb.Buildkite_Token{Token: "bk-token"}
+			s := initSource(t, tt.conn)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+
+			var got []string
+			for _, unit := range reporter.Units {
+				id, kind := unit.SourceUnitID()
+				assert.Equal(t, unitPipeline, kind)
+				got = append(got, id)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name             string
+		includeArtifacts bool
+		wantData         []string
+	}{
+		{
+			name:     "job logs",
+			wantData: []string{"$ ./deploy.sh\nAWS_SECRET_ACCESS_KEY=from-log"},
+		},
+		{
+			name:             "job logs and artifacts",
+			includeArtifacts: true,
+			wantData:         []string{"$ ./deploy.sh\nAWS_SECRET_ACCESS_KEY=from-log", "DEPLOY_TOKEN=from-artifact"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, &sourcespb.Buildkite{
+				Endpoint:         server.URL + "/v2",
+				Credential:       &sourcespb.Buildkite_Token{Token: "bk-token"},
+				IncludeArtifacts: tt.includeArtifacts,
+			})
+
+			reporter := sourcestest.TestReporter{}
+			unit := sources.CommonSourceUnit{Kind: unitPipeline, ID: "acme/deploy"}
+			require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+			// The log of job-3 is missing.
+			assert.Len(t, reporter.ChunkErrs, 1)
+
+			var data []string
+			for _, chunk := range reporter.Chunks {
+				data = append(data, string(chunk.Data))
+			}
+			assert.Equal(t, tt.wantData, data)
+
+			meta := reporter.Chunks[0].SourceMetadata.GetBuildkite()
+			assert.Equal(t, "acme", meta.GetOrg())
+			assert.Equal(t, "deploy", meta.GetPipeline())
+			assert.Equal(t, int64(42), meta.GetBuildNumber())
+			assert.Equal(t, "job-1", meta.GetJobId())
+			assert.Equal(t, ":rocket: Deploy", meta.GetJobName())
+			assert.Equal(t, "https://buildkite.com/acme/deploy/builds/42#job-1", meta.GetLink())
+			assert.Equal(t, "ada@acme.test", meta.GetEmail())
+			assert.Equal(t, "2024-05-01T10:00:00Z", meta.GetTimestamp())
+			assert.Empty(t, meta.GetArtifact())
+
+			if tt.includeArtifacts {
+				assert.Equal(t, "out/env.txt", reporter.Chunks[1].SourceMetadata.GetBuildkite().GetArtifact())
+			}
+		})
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: ""},
+		{header: `<https://api.buildkite.com/v2/organizations?page=3>; rel="last"`, want: ""},
+		{
+			header: `<https://api.buildkite.com/v2/organizations?page=1>; rel="prev", <https://api.buildkite.com/v2/organizations?page=3>; rel="next"`,
+			want:   "https://api.buildkite.com/v2/organizations?page=3",
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, nextLink(tt.header), tt.header)
+	}
+}
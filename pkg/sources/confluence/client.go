@@ -0,0 +1,208 @@
+package confluence
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const pageLimit = 50
+
+type links struct {
+	WebUI    string `json:"webui"`
+	Download string `json:"download"`
+	Next     string `json:"next"`
+}
+
+type space struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type version struct {
+	Number int    `json:"number"`
+	When   string `json:"when"`
+	By     struct {
+		Email       string `json:"email"`
+		DisplayName string `json:"displayName"`
+	} `json:"by"`
+}
+
+// content is a page, blog post, comment or attachment.
+type content struct {
+	ID      string  `json:"id"`
+	Type    string  `json:"type"`
+	Title   string  `json:"title"`
+	Version version `json:"version"`
+	Body    struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Extensions struct {
+		MediaType string `json:"mediaType"`
+		FileSize  int64  `json:"fileSize"`
+	} `json:"extensions"`
+	Links links `json:"_links"`
+}
+
+type listResponse[T any] struct {
+	Results []T   `json:"results"`
+	Links   links `json:"_links"`
+}
+
+// client is a minimal Confluence REST API client. It works with both
+// Confluence Cloud (where the base URL includes the /wiki context path) and
+// Confluence Data Center.
+type client struct {
+	baseURL    string
+	username   string
+	password   string
+	token      string
+	httpClient *http.Client
+}
+
+func (c *client) newRequest(ctx context.Context, reqURL string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "" || c.password != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return req, nil
+}
+
+func (c *client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, reqURL)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+	return resp, nil
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Confluence API response: %w", err)
+	}
+	return nil
+}
+
+// paginate requests every page of a listing, starting at path, and calls fn
+// with each result. Next page links are relative to the base URL.
+func paginate[T any](ctx context.Context, c *client, path string, fn func(T) error) error {
+	reqURL := c.baseURL + path
+	for reqURL != "" {
+		var page listResponse[T]
+		if err := c.get(ctx, reqURL, &page); err != nil {
+			return err
+		}
+		for _, result := range page.Results {
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+
+		reqURL = ""
+		if page.Links.Next != "" && len(page.Results) > 0 {
+			reqURL = c.baseURL + page.Links.Next
+		}
+	}
+	return nil
+}
+
+// listSpaces returns every space of the given type visible to the credential.
+// An empty type lists all spaces.
+func (c *client) listSpaces(ctx context.Context, spaceType string) ([]space, error) {
+	query := url.Values{"limit": {strconv.Itoa(pageLimit)}}
+	if spaceType != "" {
+		query.Set("type", spaceType)
+	}
+
+	var spaces []space
+	err := paginate(ctx, c, "/rest/api/space?"+query.Encode(), func(s space) error {
+		spaces = append(spaces, s)
+		return nil
+	})
+	return spaces, err
+}
+
+// listContent calls fn with every current page or blog post of a space,
+// including its storage format body and version.
+func (c *client) listContent(ctx context.Context, spaceKey, contentType string, fn func(content) error) error {
+	query := url.Values{
+		"spaceKey": {spaceKey},
+		"type":     {contentType},
+		"expand":   {"body.storage,version"},
+		"limit":    {strconv.Itoa(pageLimit)},
+	}
+	return paginate(ctx, c, "/rest/api/content?"+query.Encode(), fn)
+}
+
+// getVersion returns a historical version of a page or blog post.
+func (c *client) getVersion(ctx context.Context, id string, number int) (content, error) {
+	query := url.Values{
+		"status":  {"historical"},
+		"version": {strconv.Itoa(number)},
+		"expand":  {"body.storage,version"},
+	}
+	var result content
+	err := c.get(ctx, c.baseURL+"/rest/api/content/"+url.PathEscape(id)+"?"+query.Encode(), &result)
+	return result, err
+}
+
+// listComments calls fn with every comment of a page or blog post, including
+// replies.
+func (c *client) listComments(ctx context.Context, id string, fn func(content) error) error {
+	query := url.Values{
+		"expand": {"body.storage,version"},
+		"depth":  {"all"},
+		"limit":  {strconv.Itoa(pageLimit)},
+	}
+	return paginate(ctx, c, "/rest/api/content/"+url.PathEscape(id)+"/child/comment?"+query.Encode(), fn)
+}
+
+// listAttachments calls fn with every attachment of a page or blog post.
+func (c *client) listAttachments(ctx context.Context, id string, fn func(content) error) error {
+	query := url.Values{
+		"expand": {"version"},
+		"limit":  {strconv.Itoa(pageLimit)},
+	}
+	return paginate(ctx, c, "/rest/api/content/"+url.PathEscape(id)+"/child/attachment?"+query.Encode(), fn)
+}
+
+// download returns the contents of an attachment. The caller is responsible
+// for closing the returned reader.
+func (c *client) download(ctx context.Context, downloadPath string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, c.baseURL+downloadPath)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,387 @@
+package confluence
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE
+
+	unitSpace sources.SourceUnitKind = "space"
+
+	// Locations of the scanned data within a space.
+	locationPage       = "page"
+	locationBlogPost   = "blogpost"
+	locationComment    = "comment"
+	locationAttachment = "attachment"
+)
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint           string
+	spacesScope        sourcespb.Confluence_GetAllSpacesScope
+	spaces             []string
+	spaceFilter        *glob.Filter
+	includeAttachments bool
+	skipHistory        bool
+	client             *client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Confluence source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Confluence
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	endpoint, err := url.Parse(conn.GetEndpoint())
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return fmt.Errorf("invalid Confluence endpoint %q", conn.GetEndpoint())
+	}
+	s.endpoint = strings.TrimRight(endpoint.String(), "/")
+
+	// Confluence Cloud rate limits API requests, and reports the time to wait
+	// in the Retry-After header of 429 responses.
+	opts := []func(*roundtripper.RoundTripper){
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithLogging(),
+		roundtripper.WithRetryable(roundtripper.WithShouldRetry5XXDuration(10 * time.Second)),
+	}
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	s.client = &client{
+		baseURL:    s.endpoint,
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Confluence_BasicAuth:
+		// Confluence Cloud expects the account email and an API token.
+		s.client.username = cred.BasicAuth.GetUsername()
+		s.client.password = cred.BasicAuth.GetPassword()
+	case *sourcespb.Confluence_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		s.client.token = cred.Token
+	case *sourcespb.Confluence_Unauthenticated:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	spaceFilter, err := glob.NewGlobFilter(glob.WithExcludeGlobs(conn.GetIgnoreSpaces()...))
+	if err != nil {
+		return fmt.Errorf("could not compile space patterns: %w", err)
+	}
+	s.spaceFilter = spaceFilter
+
+	s.spacesScope = conn.GetSpacesScope()
+	s.spaces = conn.GetSpaces()
+	s.includeAttachments = conn.GetIncludeAttachments()
+	s.skipHistory = conn.GetSkipHistory()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned space: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Confluence scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every configured space, or every space in the
+// configured scope if none are configured. Spaces matching the ignore globs
+// are skipped.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	keys := s.spaces
+	if len(keys) == 0 {
+		ctx.Logger().Info("no spaces configured, enumerating", "scope", s.spacesScope.String())
+		var spaceType string
+		switch s.spacesScope {
+		case sourcespb.Confluence_GLOBAL:
+			spaceType = "global"
+		case sourcespb.Confluence_PERSONAL:
+			spaceType = "personal"
+		}
+		found, err := s.client.listSpaces(ctx, spaceType)
+		if err != nil {
+			return fmt.Errorf("could not list spaces: %w", err)
+		}
+		for _, sp := range found {
+			keys = append(keys, sp.Key)
+		}
+	}
+
+	for _, key := range keys {
+		if !s.spaceFilter.ShouldInclude(key) {
+			ctx.Logger().V(3).Info("skipping space", "space", key)
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitSpace, ID: key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit scans the pages and blog posts of a space, along with their
+// comments and, if configured, their history and attachments.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	spaceKey, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "space", spaceKey)
+
+	for _, contentType := range []string{locationPage, locationBlogPost} {
+		err := s.client.listContent(ctx, spaceKey, contentType, func(c content) error {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			return s.scanContent(ctx, spaceKey, c, reporter)
+		})
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not list %ss: %w", contentType, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanContent scans a page or blog post. Errors that only affect part of the
+// content are reported to the reporter; the returned error stops the scan.
+func (s *Source) scanContent(ctx context.Context, spaceKey string, c content, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "page_id", c.ID)
+	ctx.Logger().V(3).Info("scanning content", "type", c.Type, "title", c.Title)
+
+	location := locationPage
+	if c.Type == locationBlogPost {
+		location = locationBlogPost
+	}
+	if err := s.reportContent(ctx, spaceKey, c, c, location, s.link(c.Links.WebUI), reporter); err != nil {
+		return err
+	}
+
+	if !s.skipHistory {
+		for number := 1; number < c.Version.Number; number++ {
+			old, err := s.client.getVersion(ctx, c.ID, number)
+			if err != nil {
+				if err := reporter.ChunkErr(ctx, fmt.Errorf("could not get version %d of %q: %w", number, c.ID, err)); err != nil {
+					return err
+				}
+				continue
+			}
+			link := s.endpoint + "/pages/viewpage.action?" + url.Values{
+				"pageId":      {c.ID},
+				"pageVersion": {strconv.Itoa(number)},
+			}.Encode()
+			if err := s.reportContent(ctx, spaceKey, c, old, location, link, reporter); err != nil {
+				return err
+			}
+		}
+	}
+
+	err := s.client.listComments(ctx, c.ID, func(comment content) error {
+		return s.reportContent(ctx, spaceKey, c, comment, locationComment, s.link(comment.Links.WebUI), reporter)
+	})
+	if err != nil {
+		if err := reporter.ChunkErr(ctx, fmt.Errorf("could not list comments of %q: %w", c.ID, err)); err != nil {
+			return err
+		}
+	}
+
+	if s.includeAttachments {
+		err := s.client.listAttachments(ctx, c.ID, func(attachment content) error {
+			if err := s.scanAttachment(ctx, spaceKey, c, attachment, reporter); err != nil {
+				return reporter.ChunkErr(ctx, err)
+			}
+			return nil
+		})
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not list attachments of %q: %w", c.ID, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reportContent reports the title and text of data, which is either the page
+// itself, one of its historical versions, or one of its comments.
+func (s *Source) reportContent(ctx context.Context, spaceKey string, page, data content, location, link string, reporter sources.ChunkReporter) error {
+	var text bytes.Buffer
+	if location != locationComment {
+		text.WriteString(data.Title)
+		text.WriteByte('\n')
+	}
+	text.Write(storageToText(data.Body.Storage.Value))
+	if len(bytes.TrimSpace(text.Bytes())) == 0 {
+		return nil
+	}
+
+	metadata := s.metadata(spaceKey, page, data.Version, location, link)
+	chunkReader := sources.NewChunkReader()
+	for chunkData := range chunkReader(ctx, &text) {
+		if err := chunkData.Error(); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+			continue
+		}
+		chunk := sources.Chunk{
+			SourceType:     s.Type(),
+			SourceName:     s.name,
+			SourceID:       s.SourceID(),
+			JobID:          s.JobID(),
+			Data:           chunkData.Bytes(),
+			SourceMetadata: metadata,
+			Verify:         s.verify,
+		}
+		if err := reporter.ChunkOk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanAttachment(ctx context.Context, spaceKey string, page, attachment content, reporter sources.ChunkReporter) error {
+	if attachment.Links.Download == "" {
+		return nil
+	}
+	ctx.Logger().V(3).Info("scanning attachment", "file", attachment.Title)
+
+	body, err := s.client.download(ctx, attachment.Links.Download)
+	if err != nil {
+		return fmt.Errorf("could not download attachment %q: %w", attachment.Title, err)
+	}
+	defer body.Close()
+
+	metadata := s.metadata(spaceKey, page, attachment.Version, locationAttachment, s.link(attachment.Links.Download))
+	metadata.GetConfluence().File = sanitizer.UTF8(attachment.Title)
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+
+	if err := handlers.HandleFile(ctx, body, chunkSkel, reporter); err != nil {
+		return fmt.Errorf("could not scan attachment %q: %w", attachment.Title, err)
+	}
+	return nil
+}
+
+func (s *Source) metadata(spaceKey string, page content, v version, location, link string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Confluence{
+			Confluence: &source_metadatapb.Confluence{
+				Page:      sanitizer.UTF8(page.Title),
+				PageId:    page.ID,
+				Space:     sanitizer.UTF8(spaceKey),
+				Version:   strconv.Itoa(v.Number),
+				Link:      link,
+				Email:     sanitizer.UTF8(v.By.Email),
+				Timestamp: v.When,
+				Location:  location,
+			},
+		},
+	}
+}
+
+// link returns an absolute URL for a link relative to the endpoint.
+func (s *Source) link(path string) string {
+	if path == "" {
+		return ""
+	}
+	return s.endpoint + path
+}
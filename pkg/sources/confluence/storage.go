@@ -0,0 +1,63 @@
+package confluence
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// blockElements end a line of text when they are closed, so that the words of
+// adjacent paragraphs, table cells and list items aren't glued together.
+var blockElements = map[string]struct{}{
+	"p": {}, "div": {}, "br": {}, "li": {}, "tr": {}, "td": {}, "th": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+	"pre": {}, "blockquote": {}, "hr": {},
+	"ac:plain-text-body": {}, "ac:parameter": {}, "ac:structured-macro": {},
+}
+
+// linkAttributes hold URLs, which may embed credentials in their user info or
+// query string.
+var linkAttributes = map[string]struct{}{
+	"href": {}, "src": {}, "ri:value": {},
+}
+
+// storageToText converts Confluence storage format XHTML to plain text. Text
+// nodes and CDATA sections (used by code and no-format macros) are kept, as
+// are link targets.
+func storageToText(storage string) []byte {
+	var buf bytes.Buffer
+
+	z := html.NewTokenizer(strings.NewReader(storage))
+	z.AllowCDATA(true)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// The tokenizer only fails at the end of the input, as it is
+			// lenient towards malformed markup.
+			return bytes.TrimSpace(buf.Bytes())
+		case html.TextToken:
+			buf.Write(z.Text())
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				if _, ok := linkAttributes[string(key)]; ok && len(val) > 0 {
+					buf.WriteByte(' ')
+					buf.Write(val)
+					buf.WriteByte(' ')
+				}
+			}
+			if _, ok := blockElements[string(name)]; ok && tt == html.SelfClosingTagToken {
+				buf.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if _, ok := blockElements[string(name)]; ok {
+				buf.WriteByte('\n')
+			}
+		}
+	}
+}
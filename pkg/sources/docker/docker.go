@@ -87,22 +87,20 @@ type historyEntryInfo struct {
 	index       int
 	entry       v1.History
 	layerDigest string
-	base        string
-	tag         string
-}
-
-type layerInfo struct {
-	digest v1.Hash
-	base   string
-	tag    string
 }
 
 // Chunks emits data over a channel that is decoded and scanned for secrets.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
 	ctx = context.WithValues(ctx, "source_type", s.Type(), "source_name", s.name)
 
-	workers := new(errgroup.Group)
-	workers.SetLimit(s.concurrency)
+	scanner := &ImageScanner{
+		SourceType:  s.Type(),
+		SourceName:  s.name,
+		SourceID:    s.SourceID(),
+		JobID:       s.JobID(),
+		Verify:      s.verify,
+		Concurrency: s.concurrency,
+	}
 
 	for _, image := range s.conn.GetImages() {
 		if common.IsDone(ctx) {
@@ -117,48 +115,22 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 
 		ctx = context.WithValues(ctx, "image", imgInfo.base, "tag", imgInfo.tag)
 
-		ctx.Logger().V(2).Info("scanning image history")
-
-		layers, err := imgInfo.image.Layers()
-		if err != nil {
-			ctx.Logger().Error(err, "error getting image layers")
-			return nil
-		}
-
-		historyEntries, err := getHistoryEntries(ctx, imgInfo, layers)
-		if err != nil {
-			ctx.Logger().Error(err, "error getting image history entries")
-			return nil
-		}
-
-		for _, historyEntry := range historyEntries {
-			if err := s.processHistoryEntry(ctx, historyEntry, chunksChan); err != nil {
-				ctx.Logger().Error(err, "error processing history entry")
-				return nil
+		metadata := func(file, layer string) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Docker{
+					Docker: &source_metadatapb.Docker{
+						File:  file,
+						Image: imgInfo.base,
+						Tag:   imgInfo.tag,
+						Layer: layer,
+					},
+				},
 			}
-			dockerHistoryEntriesScanned.WithLabelValues(s.name).Inc()
-		}
-
-		ctx.Logger().V(2).Info("scanning image layers")
-
-		for _, layer := range layers {
-			workers.Go(func() error {
-				if err := s.processLayer(ctx, layer, imgInfo, chunksChan); err != nil {
-					ctx.Logger().Error(err, "error processing layer")
-					return nil
-				}
-				dockerLayersScanned.WithLabelValues(s.name).Inc()
-
-				return nil
-			})
 		}
-
-		if err := workers.Wait(); err != nil {
-			ctx.Logger().Error(err, "error processing layers")
+		if err := scanner.ScanImage(ctx, imgInfo.image, metadata, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning image")
 			return nil
 		}
-
-		dockerImagesScanned.WithLabelValues(s.name).Inc()
 	}
 
 	return nil
@@ -208,10 +180,71 @@ func (s *Source) processImage(ctx context.Context, image string) (imageInfo, err
 	return imgInfo, nil
 }
 
+// MetadataFunc returns the metadata of the chunks found in an image, given
+// the path of the scanned file and the digest of its layer.
+type MetadataFunc func(file, layer string) *source_metadatapb.MetaData
+
+// ImageScanner scans the history and the layers of container images. It is
+// shared by the sources pulling images from registries.
+type ImageScanner struct {
+	SourceType  sourcespb.SourceType
+	SourceName  string
+	SourceID    sources.SourceID
+	JobID       sources.JobID
+	Verify      bool
+	Concurrency int
+}
+
+// ScanImage scans the history entries and the layers of an image. Layers are
+// scanned concurrently, and the errors scanning them are logged.
+func (sc *ImageScanner) ScanImage(ctx context.Context, image v1.Image, metadata MetadataFunc, chunksChan chan *sources.Chunk) error {
+	ctx.Logger().V(2).Info("scanning image history")
+
+	layers, err := image.Layers()
+	if err != nil {
+		return fmt.Errorf("error getting image layers: %w", err)
+	}
+
+	historyEntries, err := getHistoryEntries(ctx, image, layers)
+	if err != nil {
+		return fmt.Errorf("error getting image history entries: %w", err)
+	}
+
+	for _, historyEntry := range historyEntries {
+		if err := sc.processHistoryEntry(ctx, historyEntry, metadata, chunksChan); err != nil {
+			return fmt.Errorf("error processing history entry: %w", err)
+		}
+		dockerHistoryEntriesScanned.WithLabelValues(sc.SourceName).Inc()
+	}
+
+	ctx.Logger().V(2).Info("scanning image layers")
+
+	workers := new(errgroup.Group)
+	workers.SetLimit(sc.Concurrency)
+	for _, layer := range layers {
+		workers.Go(func() error {
+			if err := sc.processLayer(ctx, layer, metadata, chunksChan); err != nil {
+				ctx.Logger().Error(err, "error processing layer")
+				return nil
+			}
+			dockerLayersScanned.WithLabelValues(sc.SourceName).Inc()
+
+			return nil
+		})
+	}
+
+	if err := workers.Wait(); err != nil {
+		return fmt.Errorf("error processing layers: %w", err)
+	}
+
+	dockerImagesScanned.WithLabelValues(sc.SourceName).Inc()
+	return nil
+}
+
 // getHistoryEntries collates an image's configuration history together with the
 // corresponding layer digests for any non-empty layers.
-func getHistoryEntries(ctx context.Context, imgInfo imageInfo, layers []v1.Layer) ([]historyEntryInfo, error) {
-	config, err := imgInfo.image.ConfigFile()
+func getHistoryEntries(ctx context.Context, image v1.Image, layers []v1.Layer) ([]historyEntryInfo, error) {
+	config, err := image.ConfigFile()
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +255,6 @@ func getHistoryEntries(ctx context.Context, imgInfo imageInfo, layers []v1.Layer
 	layerIndex := 0
 	for historyIndex, entry := range history {
 		e := historyEntryInfo{
-			base:  imgInfo.base,
-			tag:   imgInfo.tag,
 			entry: entry,
 			index: historyIndex,
 		}
@@ -255,27 +286,19 @@ func getHistoryEntries(ctx context.Context, imgInfo imageInfo, layers []v1.Layer
 }
 
 // processHistoryEntry processes a history entry from the image configuration metadata.
-func (s *Source) processHistoryEntry(ctx context.Context, historyInfo historyEntryInfo, chunksChan chan *sources.Chunk) error {
+func (sc *ImageScanner) processHistoryEntry(ctx context.Context, historyInfo historyEntryInfo, metadata MetadataFunc, chunksChan chan *sources.Chunk) error {
 	// Make up an identifier for this entry that is moderately sensible. There is
 	// no file name to use here, so the path tries to be a little descriptive.
 	entryPath := fmt.Sprintf("image-metadata:history:%d:created-by", historyInfo.index)
 
 	chunk := &sources.Chunk{
-		SourceType: s.Type(),
-		SourceName: s.name,
-		SourceID:   s.SourceID(),
-		SourceMetadata: &source_metadatapb.MetaData{
-			Data: &source_metadatapb.MetaData_Docker{
-				Docker: &source_metadatapb.Docker{
-					File:  entryPath,
-					Image: historyInfo.base,
-					Tag:   historyInfo.tag,
-					Layer: historyInfo.layerDigest,
-				},
-			},
-		},
-		Verify: s.verify,
-		Data:   []byte(historyInfo.entry.CreatedBy),
+		SourceType:     sc.SourceType,
+		SourceName:     sc.SourceName,
+		SourceID:       sc.SourceID,
+		JobID:          sc.JobID,
+		SourceMetadata: metadata(entryPath, historyInfo.layerDigest),
+		Verify:         sc.Verify,
+		Data:           []byte(historyInfo.entry.CreatedBy),
 	}
 
 	ctx.Logger().V(2).Info("scanning image history entry", "index", historyInfo.index, "layer", historyInfo.layerDigest)
@@ -284,19 +307,13 @@ func (s *Source) processHistoryEntry(ctx context.Context, historyInfo historyEnt
 }
 
 // processLayer processes an individual layer of an image.
-func (s *Source) processLayer(ctx context.Context, layer v1.Layer, imgInfo imageInfo, chunksChan chan *sources.Chunk) error {
-	layerInfo := layerInfo{
-		base: imgInfo.base,
-		tag:  imgInfo.tag,
-	}
-
-	var err error
-	layerInfo.digest, err = layer.Digest()
+func (sc *ImageScanner) processLayer(ctx context.Context, layer v1.Layer, metadata MetadataFunc, chunksChan chan *sources.Chunk) error {
+	digest, err := layer.Digest()
 	if err != nil {
 		return err
 	}
 
-	ctx.Logger().WithValues("layer", layerInfo.digest.String()).V(2).Info("scanning layer")
+	ctx.Logger().WithValues("layer", digest.String()).V(2).Info("scanning layer")
 
 	rc, err := layer.Compressed()
 	if err != nil {
@@ -325,8 +342,8 @@ func (s *Source) processLayer(ctx context.Context, layer v1.Layer, imgInfo image
 			return err
 		}
 
-		info := chunkProcessingInfo{size: header.Size, name: header.Name, reader: tarReader, layer: layerInfo}
-		if err := s.processChunk(ctx, info, chunksChan); err != nil {
+		info := chunkProcessingInfo{size: header.Size, name: header.Name, reader: tarReader, layer: digest.String()}
+		if err := sc.processChunk(ctx, info, metadata, chunksChan); err != nil {
 			return err
 		}
 	}
@@ -338,11 +355,11 @@ type chunkProcessingInfo struct {
 	size   int64
 	name   string
 	reader io.Reader
-	layer  layerInfo
+	layer  string
 }
 
 // processChunk processes an individual chunk of a layer.
-func (s *Source) processChunk(ctx context.Context, info chunkProcessingInfo, chunksChan chan *sources.Chunk) error {
+func (sc *ImageScanner) processChunk(ctx context.Context, info chunkProcessingInfo, metadata MetadataFunc, chunksChan chan *sources.Chunk) error {
 	const filesizeLimitBytes int64 = 50 * 1024 * 1024 // 50MB
 	if info.size > filesizeLimitBytes {
 		ctx.Logger().V(4).Info("skipping large file", "file", info.name, "size", info.size)
@@ -359,20 +376,12 @@ func (s *Source) processChunk(ctx context.Context, info chunkProcessingInfo, chu
 		}
 
 		chunk := &sources.Chunk{
-			SourceType: s.Type(),
-			SourceName: s.name,
-			SourceID:   s.SourceID(),
-			SourceMetadata: &source_metadatapb.MetaData{
-				Data: &source_metadatapb.MetaData_Docker{
-					Docker: &source_metadatapb.Docker{
-						File:  "/" + info.name,
-						Image: info.layer.base,
-						Tag:   info.layer.tag,
-						Layer: info.layer.digest.String(),
-					},
-				},
-			},
-			Verify: s.verify,
+			SourceType:     sc.SourceType,
+			SourceName:     sc.SourceName,
+			SourceID:       sc.SourceID,
+			JobID:          sc.JobID,
+			SourceMetadata: metadata("/"+info.name, info.layer),
+			Verify:         sc.Verify,
 		}
 		chunk.Data = data.Bytes()
 
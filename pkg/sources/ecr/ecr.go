@@ -0,0 +1,394 @@
+package ecr
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/docker"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_ECR
+
+	defaultAWSRegion = "us-east-1"
+)
+
+// Source scans the images of Amazon ECR private registries. Repositories are
+// enumerated in every configured region, and for every configured role, and
+// their images are scanned like the images of the Docker source.
+type Source struct {
+	name        string
+	sourceID    sources.SourceID
+	jobID       sources.JobID
+	verify      bool
+	concurrency int
+	conn        sourcespb.ECR
+
+	repoFilter *glob.Filter
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized ECR source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.concurrency = concurrency
+
+	if err := anypb.UnmarshalTo(connection, &s.conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	switch s.conn.GetCredential().(type) {
+	case *sourcespb.ECR_AccessKey, *sourcespb.ECR_SessionToken, *sourcespb.ECR_CloudEnvironment:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if s.conn.GetMaxTags() < 0 {
+		return fmt.Errorf("max tags must not be negative")
+	}
+
+	repoFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(s.conn.GetIncludeRepositories()...),
+		glob.WithExcludeGlobs(s.conn.GetExcludeRepositories()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile repository patterns: %w", err)
+	}
+	s.repoFilter = repoFilter
+
+	return nil
+}
+
+// newClient returns an ECR client for the region, assuming the role when one
+// is given. The default region of the AWS configuration is used when the
+// region is empty.
+func (s *Source) newClient(region, roleArn string) (*ecr.ECR, error) {
+	cfg := aws.NewConfig()
+	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
+	if region != "" {
+		cfg.Region = aws.String(region)
+	}
+	if endpoint := s.conn.GetEndpoint(); endpoint != "" {
+		cfg.Endpoint = aws.String(endpoint)
+	}
+
+	switch cred := s.conn.GetCredential().(type) {
+	case *sourcespb.ECR_SessionToken:
+		cfg.Credentials = credentials.NewStaticCredentials(cred.SessionToken.Key, cred.SessionToken.Secret, cred.SessionToken.SessionToken)
+	case *sourcespb.ECR_AccessKey:
+		cfg.Credentials = credentials.NewStaticCredentials(cred.AccessKey.Key, cred.AccessKey.Secret, "")
+	default:
+		// In all other cases, the AWS SDK will follow its normal waterfall logic to pick up credentials.
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            *cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(sess.Config.Region) == "" {
+		sess.Config.Region = aws.String(defaultAWSRegion)
+	}
+
+	if roleArn != "" {
+		creds := stscreds.NewCredentialsWithClient(sts.New(sess), roleArn, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = "trufflehog"
+		})
+		return ecr.New(sess, &aws.Config{Credentials: creds}), nil
+	}
+	return ecr.New(sess), nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	roles := s.conn.GetRoles()
+	if len(roles) == 0 {
+		roles = []string{""}
+	}
+	regions := s.conn.GetRegions()
+	if len(regions) == 0 {
+		regions = []string{""}
+	}
+	registries := s.conn.GetRegistries()
+	if len(registries) == 0 {
+		// The registry of the account the credentials belong to.
+		registries = []string{""}
+	}
+
+	scanErrs := sources.NewScanErrors()
+	for _, role := range roles {
+		for _, region := range regions {
+			client, err := s.newClient(region, role)
+			if err != nil {
+				return fmt.Errorf("could not create ECR client: %w", err)
+			}
+			for _, registryID := range registries {
+				if common.IsDone(ctx) {
+					return nil
+				}
+				ctx := context.WithValues(ctx, "role", role, "region", aws.StringValue(client.Config.Region), "registry", registryID)
+				if err := s.scanRegistry(ctx, client, registryID, chunksChan); err != nil {
+					ctx.Logger().Error(err, "error scanning registry")
+					scanErrs.Add(err)
+				}
+			}
+		}
+	}
+
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	return nil
+}
+
+// registryAuth is an authenticated ECR registry endpoint.
+type registryAuth struct {
+	region   string
+	host     string
+	insecure bool
+	auth     authn.Authenticator
+}
+
+func (s *Source) scanRegistry(ctx context.Context, client *ecr.ECR, registryID string, chunksChan chan *sources.Chunk) error {
+	reg, err := authenticate(ctx, client, registryID)
+	if err != nil {
+		return err
+	}
+
+	input := &ecr.DescribeRepositoriesInput{}
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+	var repos []*ecr.Repository
+	err = client.DescribeRepositoriesPagesWithContext(ctx, input, func(page *ecr.DescribeRepositoriesOutput, _ bool) bool {
+		for _, repo := range page.Repositories {
+			repoName := aws.StringValue(repo.RepositoryName)
+			if s.repoFilter.ShouldInclude(repoName) {
+				repos = append(repos, repo)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("could not list repositories: %w", err)
+	}
+
+	scanErrs := sources.NewScanErrors()
+	for i, repo := range repos {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		repoName := aws.StringValue(repo.RepositoryName)
+		s.SetProgressComplete(i, len(repos), fmt.Sprintf("Repository: %s", repoName), "")
+		if err := s.scanRepository(ctx, client, reg, repo, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning repository", "repository", repoName)
+			scanErrs.Add(err)
+		}
+	}
+	s.SetProgressComplete(len(repos), len(repos), "Completed ECR registry scan", "")
+
+	if scanErrs.Count() > 0 {
+		return fmt.Errorf("could not scan %d repositories", scanErrs.Count())
+	}
+	return nil
+}
+
+// authenticate gets the address of the registry and the credentials to pull
+// its images.
+func authenticate(ctx context.Context, client *ecr.ECR, registryID string) (registryAuth, error) {
+	input := &ecr.GetAuthorizationTokenInput{}
+	if registryID != "" {
+		input.RegistryIds = []*string{aws.String(registryID)}
+	}
+	out, err := client.GetAuthorizationTokenWithContext(ctx, input)
+	if err != nil {
+		return registryAuth{}, fmt.Errorf("could not get registry authorization token: %w", err)
+	}
+	if len(out.AuthorizationData) == 0 {
+		return registryAuth{}, fmt.Errorf("no registry authorization data returned")
+	}
+	data := out.AuthorizationData[0]
+
+	token, err := base64.StdEncoding.DecodeString(aws.StringValue(data.AuthorizationToken))
+	if err != nil {
+		return registryAuth{}, fmt.Errorf("invalid registry authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(token), ":")
+	if !ok {
+		return registryAuth{}, fmt.Errorf("invalid registry authorization token")
+	}
+
+	endpoint, err := url.Parse(aws.StringValue(data.ProxyEndpoint))
+	if err != nil || endpoint.Host == "" {
+		return registryAuth{}, fmt.Errorf("invalid registry endpoint %q", aws.StringValue(data.ProxyEndpoint))
+	}
+
+	return registryAuth{
+		region:   aws.StringValue(client.Config.Region),
+		host:     endpoint.Host,
+		insecure: endpoint.Scheme == "http",
+		auth:     &authn.Basic{Username: username, Password: password},
+	}, nil
+}
+
+// image is an image of a repository to scan, with the most recent of its tags.
+type image struct {
+	digest string
+	tag    string
+}
+
+func (s *Source) scanRepository(ctx context.Context, client *ecr.ECR, reg registryAuth, repo *ecr.Repository, chunksChan chan *sources.Chunk) error {
+	repoName := aws.StringValue(repo.RepositoryName)
+	input := &ecr.DescribeImagesInput{
+		RegistryId:     repo.RegistryId,
+		RepositoryName: repo.RepositoryName,
+	}
+	var details []*ecr.ImageDetail
+	err := client.DescribeImagesPagesWithContext(ctx, input, func(page *ecr.DescribeImagesOutput, _ bool) bool {
+		details = append(details, page.ImageDetails...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("could not list the images of %q: %w", repoName, err)
+	}
+
+	scanner := &docker.ImageScanner{
+		SourceType:  s.Type(),
+		SourceName:  s.name,
+		SourceID:    s.SourceID(),
+		JobID:       s.JobID(),
+		Verify:      s.verify,
+		Concurrency: s.concurrency,
+	}
+
+	var nameOpts []name.Option
+	if reg.insecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+	scanErrs := sources.NewScanErrors()
+	for _, img := range selectImages(details, int(s.conn.GetMaxTags())) {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		ref, err := name.NewDigest(fmt.Sprintf("%s/%s@%s", reg.host, repoName, img.digest), nameOpts...)
+		if err != nil {
+			ctx.Logger().Error(err, "invalid image reference", "digest", img.digest)
+			scanErrs.Add(err)
+			continue
+		}
+		ctx := context.WithValues(ctx, "image", ref.String(), "tag", img.tag)
+		ctx.Logger().V(2).Info("scanning image")
+
+		remoteImage, err := remote.Image(ref, remote.WithAuth(reg.auth), remote.WithContext(ctx))
+		if err != nil {
+			ctx.Logger().Error(err, "could not get image")
+			scanErrs.Add(err)
+			continue
+		}
+
+		imageName := reg.host + "/" + repoName
+		if img.tag != "" {
+			imageName += ":" + img.tag
+		}
+		link := fmt.Sprintf("https://%[1]s.console.aws.amazon.com/ecr/repositories/private/%[2]s/%[3]s/_/image/%[4]s/details?region=%[1]s",
+			reg.region, aws.StringValue(repo.RegistryId), repoName, img.digest)
+		metadata := func(file, layer string) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Ecr{
+					Ecr: &source_metadatapb.ECR{
+						File:     file,
+						Layer:    layer,
+						Image:    imageName,
+						Registry: aws.StringValue(repo.RegistryId),
+						Region:   reg.region,
+						Link:     link,
+						Tag:      img.tag,
+						Digest:   img.digest,
+					},
+				},
+			}
+		}
+		if err := scanner.ScanImage(ctx, remoteImage, metadata, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning image")
+			scanErrs.Add(err)
+		}
+	}
+
+	if scanErrs.Count() > 0 {
+		return fmt.Errorf("could not scan %d images of %q", scanErrs.Count(), repoName)
+	}
+	return nil
+}
+
+// selectImages returns the images of a repository to scan, most recently
+// pushed first. When maxTags is positive, only the images of the maxTags
+// most recently pushed tags are returned, and untagged images are skipped.
+func selectImages(details []*ecr.ImageDetail, maxTags int) []image {
+	sort.SliceStable(details, func(i, j int) bool {
+		return aws.TimeValue(details[i].ImagePushedAt).After(aws.TimeValue(details[j].ImagePushedAt))
+	})
+
+	var (
+		images []image
+		tags   int
+	)
+	for _, detail := range details {
+		if maxTags > 0 && tags >= maxTags {
+			break
+		}
+		var tag string
+		if len(detail.ImageTags) > 0 {
+			tag = aws.StringValue(detail.ImageTags[0])
+		} else if maxTags > 0 {
+			continue
+		}
+		tags += len(detail.ImageTags)
+		images = append(images, image{digest: aws.StringValue(detail.ImageDigest), tag: tag})
+	}
+	return images
+}
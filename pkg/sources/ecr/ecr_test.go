@@ -0,0 +1,236 @@
+package ecr
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// pushImage pushes an image with a single layer holding the file to the
+// registry, and returns its digest.
+func pushImage(t *testing.T, host, repo, file, content string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: file, Mode: 0o600, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	require.NoError(t, err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(host+"/"+repo+":latest", name.Insecure)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+// newTestServers starts a registry holding an image of the "api" repository,
+// and a fake ECR API describing the repositories of the registry. The most
+// recent image of the repository is untagged and missing from the registry.
+func newTestServers(t *testing.T) (ecrEndpoint, apiDigest string) {
+	t.Helper()
+
+	reg := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(reg.Close)
+	host := strings.TrimPrefix(reg.URL, "http://")
+	apiDigest = pushImage(t, host, "api", "app/.env", "AWS_SECRET_ACCESS_KEY=from-layer")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		switch target := r.Header.Get("X-Amz-Target"); target {
+		case "AmazonEC2ContainerRegistry_V20150921.GetAuthorizationToken":
+			resp = map[string]any{"authorizationData": []any{map[string]any{
+				"authorizationToken": base64.StdEncoding.EncodeToString([]byte("AWS:password")),
+				"proxyEndpoint":      reg.URL,
+			}}}
+		case "AmazonEC2ContainerRegistry_V20150921.DescribeRepositories":
+			resp = map[string]any{"repositories": []any{
+				map[string]any{"registryId": "123456789012", "repositoryName": "api"},
+				map[string]any{"registryId": "123456789012", "repositoryName": "sandbox/tmp"},
+			}}
+		case "AmazonEC2ContainerRegistry_V20150921.DescribeImages":
+			var req struct {
+				RepositoryName string `json:"repositoryName"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.RepositoryName != "api" {
+				resp = map[string]any{"imageDetails": []any{}}
+				break
+			}
+			resp = map[string]any{"imageDetails": []any{
+				map[string]any{"imageDigest": apiDigest, "imageTags": []string{"latest"}, "imagePushedAt": 1714557600},
+				map[string]any{"imageDigest": "sha256:" + strings.Repeat("0", 64), "imagePushedAt": 1714561200},
+			}}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"__type": "UnknownOperationException", "message": %q}`, target)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(api.Close)
+
+	return api.URL, apiDigest
+}
+
+func initSource(t *testing.T, conn *sourcespb.ECR) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - ecr", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestChunks(t *testing.T) {
+	endpoint, digest := newTestServers(t)
+
+	tests := []struct {
+		name     string
+		conn     *sourcespb.ECR
+		wantData []string
+	}{
+		{
+			// The images that follow the missing one are still scanned.
+			name:     "all repositories",
+			conn:     &sourcespb.ECR{},
+			wantData: []string{"AWS_SECRET_ACCESS_KEY=from-layer"},
+		},
+		{
+			name:     "excluded repository",
+			conn:     &sourcespb.ECR{ExcludeRepositories: []string{"ap*"}},
+			wantData: nil,
+		},
+		{
+			name:     "included repository",
+			conn:     &sourcespb.ECR{IncludeRepositories: []string{"api"}, MaxTags: 1},
+			wantData: []string{"AWS_SECRET_ACCESS_KEY=from-layer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conn.Endpoint = endpoint
+			tt.conn.Regions = []string{"eu-west-1"}
+			tt.conn.Credential = &sourcespb.ECR_AccessKey{AccessKey: &credentialspb.KeySecret{Key: "AKIA", Secret: "secret"}}
+			s := initSource(t, tt.conn)
+
+			chunksChan := make(chan *sources.Chunk, 16)
+			require.NoError(t, s.Chunks(context.Background(), chunksChan))
+			close(chunksChan)
+
+			var chunks []*sources.Chunk
+			var data []string
+			for chunk := range chunksChan {
+				// Skip the chunks of the image history.
+				if chunk.SourceMetadata.GetEcr().GetFile() != "/app/.env" {
+					continue
+				}
+				chunks = append(chunks, chunk)
+				data = append(data, string(chunk.Data))
+			}
+			assert.Equal(t, tt.wantData, data)
+			if len(chunks) == 0 {
+				return
+			}
+
+			meta := chunks[0].SourceMetadata.GetEcr()
+			assert.Equal(t, "123456789012", meta.GetRegistry())
+			assert.Equal(t, "eu-west-1", meta.GetRegion())
+			assert.Equal(t, "latest", meta.GetTag())
+			assert.Equal(t, digest, meta.GetDigest())
+			assert.True(t, strings.HasSuffix(meta.GetImage(), "/api:latest"), meta.GetImage())
+			assert.Equal(t, "https://eu-west-1.console.aws.amazon.com/ecr/repositories/private/123456789012/api/_/image/"+digest+"/details?region=eu-west-1", meta.GetLink())
+		})
+	}
+}
+
+func TestSelectImages(t *testing.T) {
+	pushed := func(hours int) *time.Time {
+		return aws.Time(time.Date(2024, 5, 1, hours, 0, 0, 0, time.UTC))
+	}
+	details := []*awsecr.ImageDetail{
+		{ImageDigest: aws.String("sha256:a"), ImageTags: aws.StringSlice([]string{"v1"}), ImagePushedAt: pushed(1)},
+		{ImageDigest: aws.String("sha256:b"), ImagePushedAt: pushed(2)},
+		{ImageDigest: aws.String("sha256:c"), ImageTags: aws.StringSlice([]string{"v3", "latest"}), ImagePushedAt: pushed(4)},
+		{ImageDigest: aws.String("sha256:d"), ImageTags: aws.StringSlice([]string{"v2"}), ImagePushedAt: pushed(3)},
+	}
+
+	tests := []struct {
+		maxTags int
+		want    []image
+	}{
+		{
+			maxTags: 0,
+			want: []image{
+				{digest: "sha256:c", tag: "v3"},
+				{digest: "sha256:d", tag: "v2"},
+				{digest: "sha256:b"},
+				{digest: "sha256:a", tag: "v1"},
+			},
+		},
+		{
+			maxTags: 2,
+			want:    []image{{digest: "sha256:c", tag: "v3"}},
+		},
+		{
+			maxTags: 3,
+			want:    []image{{digest: "sha256:c", tag: "v3"}, {digest: "sha256:d", tag: "v2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.maxTags), func(t *testing.T) {
+			got := selectImages(append([]*awsecr.ImageDetail(nil), details...), tt.maxTags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInit_InvalidGlob(t *testing.T) {
+	anyConn, err := anypb.New(&sourcespb.ECR{
+		Credential:          &sourcespb.ECR_CloudEnvironment{},
+		IncludeRepositories: []string{"[api"},
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	assert.Error(t, s.Init(context.Background(), "test - ecr", 0, 0, false, anyConn, 1))
+}
@@ -0,0 +1,218 @@
+package gerrit
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// xssiPrefix is prepended by Gerrit to every JSON response to prevent
+// cross-site script inclusion. It must be stripped before decoding.
+// https://gerrit-review.googlesource.com/Documentation/rest-api.html#output
+var xssiPrefix = []byte(")]}'")
+
+const (
+	projectsPageSize = 500
+	changesPageSize  = 100
+)
+
+type account struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type revision struct {
+	Number   int     `json:"_number"`
+	Created  string  `json:"created"`
+	Uploader account `json:"uploader"`
+}
+
+type changeMessage struct {
+	Message        string  `json:"message"`
+	Author         account `json:"author"`
+	Date           string  `json:"date"`
+	RevisionNumber int     `json:"_revision_number"`
+}
+
+type change struct {
+	ID          string              `json:"id"`
+	Project     string              `json:"project"`
+	Number      int                 `json:"_number"`
+	Subject     string              `json:"subject"`
+	Owner       account             `json:"owner"`
+	Revisions   map[string]revision `json:"revisions"`
+	Messages    []changeMessage     `json:"messages"`
+	MoreChanges bool                `json:"_more_changes"`
+}
+
+type comment struct {
+	Message  string  `json:"message"`
+	Author   account `json:"author"`
+	PatchSet int     `json:"patch_set"`
+	Line     int64   `json:"line"`
+	Updated  string  `json:"updated"`
+}
+
+// client talks to the Gerrit REST API. Authenticated requests are sent to the
+// "/a/" prefixed endpoints as required by Gerrit.
+type client struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+func (c *client) authenticated() bool { return c.username != "" }
+
+// apiURL returns the absolute URL for the given escaped REST path, adding the
+// "/a/" prefix for authenticated requests.
+func (c *client) apiURL(path string, query url.Values) string {
+	prefix := "/"
+	if c.authenticated() {
+		prefix = "/a/"
+	}
+	reqURL := strings.TrimRight(c.baseURL, "/") + prefix + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	return reqURL
+}
+
+func (c *client) get(ctx context.Context, reqURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gerrit API request: %w", err)
+	}
+	if c.authenticated() {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Gerrit API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Gerrit API response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials or insufficient permissions, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Path)
+	}
+	return body, nil
+}
+
+// getJSON requests the given URL and decodes the XSSI protected JSON response
+// into target.
+func (c *client) getJSON(ctx context.Context, reqURL string, target any) error {
+	body, err := c.get(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	body = bytes.TrimPrefix(bytes.TrimSpace(body), xssiPrefix)
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("failed to decode Gerrit API response: %w", err)
+	}
+	return nil
+}
+
+// listProjects returns the names of every project visible to the credential.
+// Gerrit caps the number of projects it lists at once, and flags the last
+// project of a page with _more_projects when there are more to list. The next
+// page is skipped to the number of projects listed so far, as the cap of the
+// server may be below the requested page size.
+func (c *client) listProjects(ctx context.Context) ([]string, error) {
+	var names []string
+	for {
+		query := url.Values{
+			"n": {strconv.Itoa(projectsPageSize)},
+			"S": {strconv.Itoa(len(names))},
+		}
+		var projects map[string]struct {
+			MoreProjects bool `json:"_more_projects"`
+		}
+		if err := c.getJSON(ctx, c.apiURL("projects/", query), &projects); err != nil {
+			return nil, err
+		}
+
+		page := make([]string, 0, len(projects))
+		more := false
+		for name, p := range projects {
+			page = append(page, name)
+			more = more || p.MoreProjects
+		}
+		sort.Strings(page)
+		names = append(names, page...)
+		if len(projects) == 0 || !more {
+			return names, nil
+		}
+	}
+}
+
+// listOpenChanges calls visit for every open change of the given project,
+// including all of its revisions and messages.
+func (c *client) listOpenChanges(ctx context.Context, project string, visit func(change) error) error {
+	for start := 0; ; start += changesPageSize {
+		query := url.Values{
+			"q": {"status:open project:" + quoteQuery(project)},
+			"o": {"ALL_REVISIONS", "MESSAGES", "DETAILED_ACCOUNTS"},
+			"n": {strconv.Itoa(changesPageSize)},
+			"S": {strconv.Itoa(start)},
+		}
+		var changes []change
+		if err := c.getJSON(ctx, c.apiURL("changes/", query), &changes); err != nil {
+			return err
+		}
+		for _, ch := range changes {
+			if err := visit(ch); err != nil {
+				return err
+			}
+		}
+		if len(changes) == 0 || !changes[len(changes)-1].MoreChanges {
+			return nil
+		}
+	}
+}
+
+// quoteQuery quotes a value of a change query, so that project names holding
+// spaces or query operators match as a whole.
+func quoteQuery(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// getPatch returns the formatted patch of a single revision of a change.
+func (c *client) getPatch(ctx context.Context, ch change, revisionID string) ([]byte, error) {
+	body, err := c.get(ctx, c.apiURL(changePath(ch)+"/revisions/"+revisionID+"/patch", nil))
+	if err != nil {
+		return nil, err
+	}
+	// Patches are returned base64 encoded.
+	return base64.StdEncoding.DecodeString(string(bytes.TrimSpace(body)))
+}
+
+// listComments returns the published comments of a change keyed by file path.
+func (c *client) listComments(ctx context.Context, ch change) (map[string][]comment, error) {
+	var comments map[string][]comment
+	err := c.getJSON(ctx, c.apiURL(changePath(ch)+"/comments", nil), &comments)
+	return comments, err
+}
+
+// changePath returns the REST path of a change using the unambiguous
+// "<project>~<number>" identifier.
+func changePath(ch change) string {
+	return "changes/" + url.PathEscape(ch.Project) + "~" + strconv.Itoa(ch.Number)
+}
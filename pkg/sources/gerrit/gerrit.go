@@ -0,0 +1,427 @@
+package gerrit
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	gogit "github.com/go-git/go-git/v5"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_GERRIT
+
+const (
+	// unitProject units clone and scan the git history of a project.
+	unitProject sources.SourceUnitKind = "project"
+	// unitChanges units scan the open changes of a project through the REST API.
+	unitChanges sources.SourceUnitKind = "changes"
+)
+
+// Locations reported in the metadata of the chunks produced by the source.
+const (
+	locationRepository    = "repository"
+	locationPatchset      = "patchset"
+	locationComment       = "comment"
+	locationChangeMessage = "change_message"
+)
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	user     string
+	password string
+	projects []string
+
+	includeChanges  bool
+	includeComments bool
+
+	git         *git.Git
+	scanOptions *git.ScanOptions
+	client      *client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Gerrit source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Gerrit
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	endpoint, err := url.Parse(conn.GetEndpoint())
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return fmt.Errorf("invalid Gerrit endpoint %q", conn.GetEndpoint())
+	}
+	s.endpoint = strings.TrimRight(endpoint.String(), "/")
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Gerrit_BasicAuth:
+		s.user = cred.BasicAuth.GetUsername()
+		s.password = cred.BasicAuth.GetPassword()
+	case *sourcespb.Gerrit_Unauthenticated:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	s.projects = conn.GetProjects()
+	s.includeChanges = conn.GetIncludeChanges()
+	s.includeComments = conn.GetIncludeComments()
+	s.client = &client{
+		baseURL:    s.endpoint,
+		username:   s.user,
+		password:   s.password,
+		httpClient: common.RetryableHTTPClientTimeout(60),
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gerrit{
+					Gerrit: &source_metadatapb.Gerrit{
+						Commit:    sanitizer.UTF8(commit),
+						File:      sanitizer.UTF8(file),
+						Email:     sanitizer.UTF8(email),
+						Project:   sanitizer.UTF8(s.projectFromCloneURL(repository)),
+						Timestamp: sanitizer.UTF8(timestamp),
+						Line:      line,
+						Location:  locationRepository,
+					},
+				},
+			}
+		},
+	}
+	s.git = git.NewGit(cfg)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Gerrit scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every configured project, or every project
+// visible to the credential if none are configured. When changes or comments
+// are included, an additional unit is reported per project for them.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	projects := s.projects
+	if len(projects) == 0 {
+		ctx.Logger().Info("no projects configured, enumerating")
+		var err error
+		if projects, err = s.client.listProjects(ctx); err != nil {
+			return fmt.Errorf("could not list projects: %w", err)
+		}
+		// Project listings are maps, so sort them to get a stable order.
+		sort.Strings(projects)
+	}
+
+	for _, project := range projects {
+		unit := sources.CommonSourceUnit{Kind: unitProject, ID: project}
+		if err := reporter.UnitOk(ctx, unit); err != nil {
+			return err
+		}
+		if !s.includeChanges && !s.includeComments {
+			continue
+		}
+		unit = sources.CommonSourceUnit{Kind: unitChanges, ID: project}
+		if err := reporter.UnitOk(ctx, unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit scans either the git history or the open changes of a project.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	project, kind := unit.SourceUnitID()
+	ctx = context.WithValues(ctx, "project", project, "unit_kind", kind)
+
+	switch kind {
+	case unitProject:
+		path, repo, err := s.cloneProject(ctx, project)
+		if err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("could not clone project %q: %w", project, err))
+		}
+		defer os.RemoveAll(path)
+		return s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter)
+	case unitChanges:
+		return s.scanChanges(ctx, project, reporter)
+	default:
+		return fmt.Errorf("unknown unit kind %q", kind)
+	}
+}
+
+func (s *Source) cloneProject(ctx context.Context, project string) (string, *gogit.Repository, error) {
+	cloneURL := s.cloneURL(project)
+	if s.user == "" {
+		return git.CloneRepoUsingUnauthenticated(ctx, cloneURL)
+	}
+	return git.CloneRepoUsingToken(ctx, s.password, cloneURL, s.user)
+}
+
+// cloneURL returns the HTTP clone URL of a project. Authenticated clones must
+// go through the "/a/" prefix, like the REST API.
+func (s *Source) cloneURL(project string) string {
+	if s.user == "" {
+		return s.endpoint + "/" + project
+	}
+	return s.endpoint + "/a/" + project
+}
+
+// projectFromCloneURL reverses cloneURL.
+func (s *Source) projectFromCloneURL(repository string) string {
+	project := strings.TrimPrefix(repository, s.endpoint)
+	project = strings.TrimPrefix(project, "/a/")
+	return strings.TrimSuffix(strings.TrimPrefix(project, "/"), ".git")
+}
+
+// changeLink returns the web UI link of a change patchset.
+func (s *Source) changeLink(ch change, patchset int) string {
+	link := s.endpoint + "/c/" + ch.Project + "/+/" + strconv.Itoa(ch.Number)
+	if patchset > 0 {
+		link += "/" + strconv.Itoa(patchset)
+	}
+	return link
+}
+
+func (s *Source) scanChanges(ctx context.Context, project string, reporter sources.ChunkReporter) error {
+	err := s.client.listOpenChanges(ctx, project, func(ch change) error {
+		ctx := context.WithValue(ctx, "change", ch.Number)
+		if s.includeChanges {
+			if err := s.scanPatchsets(ctx, ch, reporter); err != nil {
+				return err
+			}
+		}
+		if s.includeComments {
+			if err := s.scanComments(ctx, ch, reporter); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list changes: %w", err))
+	}
+	return nil
+}
+
+// scanPatchsets scans the formatted patch of every revision of a change.
+func (s *Source) scanPatchsets(ctx context.Context, ch change, reporter sources.ChunkReporter) error {
+	// Scan revisions in patchset order so results are reported deterministically.
+	revisionIDs := make([]string, 0, len(ch.Revisions))
+	for id := range ch.Revisions {
+		revisionIDs = append(revisionIDs, id)
+	}
+	sort.Slice(revisionIDs, func(i, j int) bool {
+		return ch.Revisions[revisionIDs[i]].Number < ch.Revisions[revisionIDs[j]].Number
+	})
+
+	for _, revisionID := range revisionIDs {
+		rev := ch.Revisions[revisionID]
+		patch, err := s.client.getPatch(ctx, ch, revisionID)
+		if err != nil {
+			err = fmt.Errorf("could not get patch for change %d patchset %d: %w", ch.Number, rev.Number, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		metadata := &source_metadatapb.Gerrit{
+			Commit:       revisionID,
+			Email:        sanitizer.UTF8(rev.Uploader.Email),
+			Project:      ch.Project,
+			Timestamp:    rev.Created,
+			ChangeNumber: int64(ch.Number),
+			Patchset:     int64(rev.Number),
+			Link:         s.changeLink(ch, rev.Number),
+			Location:     locationPatchset,
+		}
+		if err := s.reportData(ctx, patch, metadata, reporter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanComments scans the review comments and change messages of a change.
+func (s *Source) scanComments(ctx context.Context, ch change, reporter sources.ChunkReporter) error {
+	for _, msg := range ch.Messages {
+		metadata := &source_metadatapb.Gerrit{
+			Email:        sanitizer.UTF8(msg.Author.Email),
+			Project:      ch.Project,
+			Timestamp:    msg.Date,
+			ChangeNumber: int64(ch.Number),
+			Patchset:     int64(msg.RevisionNumber),
+			Link:         s.changeLink(ch, msg.RevisionNumber),
+			Location:     locationChangeMessage,
+		}
+		if err := s.reportData(ctx, []byte(msg.Message), metadata, reporter); err != nil {
+			return err
+		}
+	}
+
+	comments, err := s.client.listComments(ctx, ch)
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list comments for change %d: %w", ch.Number, err))
+	}
+
+	// Comments are keyed by file; sort them so results are reported deterministically.
+	files := make([]string, 0, len(comments))
+	for file := range comments {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		for _, c := range comments[file] {
+			metadata := &source_metadatapb.Gerrit{
+				File:         sanitizer.UTF8(file),
+				Email:        sanitizer.UTF8(c.Author.Email),
+				Project:      ch.Project,
+				Timestamp:    c.Updated,
+				Line:         c.Line,
+				ChangeNumber: int64(ch.Number),
+				Patchset:     int64(c.PatchSet),
+				Link:         s.changeLink(ch, c.PatchSet),
+				Location:     locationComment,
+			}
+			if err := s.reportData(ctx, []byte(c.Message), metadata, reporter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reportData splits data into chunks and reports them with the given metadata.
+func (s *Source) reportData(ctx context.Context, data []byte, metadata *source_metadatapb.Gerrit, reporter sources.ChunkReporter) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	chunkReader := sources.NewChunkReader()
+	for chunkData := range chunkReader(ctx, bytes.NewReader(data)) {
+		if err := chunkData.Error(); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+			continue
+		}
+		chunk := sources.Chunk{
+			SourceType: s.Type(),
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			JobID:      s.JobID(),
+			Data:       chunkData.Bytes(),
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gerrit{Gerrit: metadata},
+			},
+			Verify: s.verify,
+		}
+		if err := reporter.ChunkOk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
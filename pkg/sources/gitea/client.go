@@ -0,0 +1,218 @@
+package gitea
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// pageLimit is the number of items requested per page. Gitea caps it with its
+// MAX_RESPONSE_ITEMS setting, which defaults to 50.
+const pageLimit = 50
+
+type user struct {
+	Login string `json:"login"`
+	Email string `json:"email"`
+}
+
+type organization struct {
+	// Gitea returns the name of an organization in the username field.
+	UserName string `json:"username"`
+}
+
+type repository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Owner    user   `json:"owner"`
+	CloneURL string `json:"clone_url"`
+	HTMLURL  string `json:"html_url"`
+	Fork     bool   `json:"fork"`
+	Empty    bool   `json:"empty"`
+	Archived bool   `json:"archived"`
+	Private  bool   `json:"private"`
+	Internal bool   `json:"internal"`
+	HasWiki  bool   `json:"has_wiki"`
+}
+
+// issue is either an issue or a pull request, which share the fields scanned.
+type issue struct {
+	Number    int64     `json:"number"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	HTMLURL   string    `json:"html_url"`
+	User      user      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type comment struct {
+	ID             int64     `json:"id"`
+	Body           string    `json:"body"`
+	HTMLURL        string    `json:"html_url"`
+	IssueURL       string    `json:"issue_url"`
+	PullRequestURL string    `json:"pull_request_url"`
+	User           user      `json:"user"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// client is a minimal Gitea REST client. Forgejo serves the same API.
+type client struct {
+	baseURL    string
+	token      string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// get requests the given API path, and decodes the JSON response into target.
+func (c *client) get(ctx context.Context, path string, query url.Values, target any) error {
+	reqURL := c.baseURL + "/api/v1" + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create Gitea API request: %w", err)
+	}
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "token "+c.token)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request to Gitea API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials or insufficient permissions, status %d for %s", resp.StatusCode, req.URL.Path)
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Gitea API response: %w", err)
+	}
+	return nil
+}
+
+// list calls visit with every page of a paginated API path, until a page is
+// not full.
+func list[T any](ctx context.Context, c *client, path string, query url.Values, visit func([]T) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("limit", strconv.Itoa(pageLimit))
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var items []T
+		if err := c.get(ctx, path, query, &items); err != nil {
+			return err
+		}
+		if err := visit(items); err != nil {
+			return err
+		}
+		if len(items) < pageLimit {
+			return nil
+		}
+	}
+}
+
+// collect returns every item of a paginated API path.
+func collect[T any](ctx context.Context, c *client, path string, query url.Values) ([]T, error) {
+	var all []T
+	err := list(ctx, c, path, query, func(items []T) error {
+		all = append(all, items...)
+		return nil
+	})
+	return all, err
+}
+
+// currentUser returns the user authenticated by the credentials.
+func (c *client) currentUser(ctx context.Context) (user, error) {
+	var u user
+	err := c.get(ctx, "/user", nil, &u)
+	return u, err
+}
+
+// listOrgs returns the names of every organization visible to the client.
+func (c *client) listOrgs(ctx context.Context) ([]string, error) {
+	orgs, err := collect[organization](ctx, c, "/orgs", nil)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(orgs))
+	for _, org := range orgs {
+		names = append(names, org.UserName)
+	}
+	return names, nil
+}
+
+// listUsers returns the logins of every user visible to the client.
+func (c *client) listUsers(ctx context.Context) ([]string, error) {
+	var logins []string
+	query := url.Values{"limit": {strconv.Itoa(pageLimit)}}
+	// The user search wraps its results, so it can't use list.
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var res struct {
+			Data []user `json:"data"`
+		}
+		if err := c.get(ctx, "/users/search", query, &res); err != nil {
+			return nil, err
+		}
+		for _, u := range res.Data {
+			logins = append(logins, u.Login)
+		}
+		if len(res.Data) < pageLimit {
+			return logins, nil
+		}
+	}
+}
+
+// listOrgRepos returns every repository of an organization.
+func (c *client) listOrgRepos(ctx context.Context, org string) ([]repository, error) {
+	return collect[repository](ctx, c, "/orgs/"+url.PathEscape(org)+"/repos", nil)
+}
+
+// listUserRepos returns every repository owned by a user.
+func (c *client) listUserRepos(ctx context.Context, login string) ([]repository, error) {
+	return collect[repository](ctx, c, "/users/"+url.PathEscape(login)+"/repos", nil)
+}
+
+func repoPath(owner, name string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
+
+// getRepo returns a repository by its owner and name.
+func (c *client) getRepo(ctx context.Context, owner, name string) (repository, error) {
+	var repo repository
+	err := c.get(ctx, repoPath(owner, name), nil, &repo)
+	return repo, err
+}
+
+// listIssues calls visit with every page of the issues, or pull requests, of a
+// repository.
+func (c *client) listIssues(ctx context.Context, owner, name, kind string, visit func([]issue) error) error {
+	query := url.Values{"state": {"all"}, "type": {kind}}
+	return list(ctx, c, repoPath(owner, name)+"/issues", query, visit)
+}
+
+// listComments calls visit with every page of the comments on the issues and
+// pull requests of a repository.
+func (c *client) listComments(ctx context.Context, owner, name string, visit func([]comment) error) error {
+	return list(ctx, c, repoPath(owner, name)+"/issues/comments", nil, visit)
+}
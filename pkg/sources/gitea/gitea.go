@@ -0,0 +1,649 @@
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_GITEA
+
+// tokenCloneUser is the username sent along with an access token when
+// cloning. Gitea ignores it when the password is a token, but git requires one
+// to be set.
+const tokenCloneUser = "oauth2"
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint   string
+	authMethod string
+	token      string
+	user       string
+	password   string
+	client     *client
+
+	repos                []string
+	orgs                 []string
+	users                []string
+	includeForks         bool
+	repoFilter           *glob.Filter
+	includeIssueComments bool
+	includePRComments    bool
+	includeWikis         bool
+
+	git           *git.Git
+	scanOptions   *git.ScanOptions
+	repoInfoCache repoInfoCache
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Gitea source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Gitea
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Gitea_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		s.authMethod = "TOKEN"
+		s.token = cred.Token
+	case *sourcespb.Gitea_BasicAuth:
+		s.authMethod = "BASIC_AUTH"
+		s.user = cred.BasicAuth.Username
+		s.password = cred.BasicAuth.Password
+	case *sourcespb.Gitea_Unauthenticated:
+		s.authMethod = "UNAUTHENTICATED"
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	u, err := url.Parse(conn.GetEndpoint())
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid Gitea endpoint %q", conn.GetEndpoint())
+	}
+	s.endpoint = strings.TrimRight(u.String(), "/")
+	s.client = &client{
+		baseURL:    s.endpoint,
+		token:      s.token,
+		username:   s.user,
+		password:   s.password,
+		httpClient: common.RetryableHTTPClientTimeout(60),
+	}
+
+	s.repos = conn.GetRepositories()
+	s.orgs = conn.GetOrganizations()
+	s.users = conn.GetUsers()
+	s.includeForks = conn.GetIncludeForks()
+	s.includeIssueComments = conn.GetIncludeIssueComments()
+	s.includePRComments = conn.GetIncludePullRequestComments()
+	s.includeWikis = conn.GetIncludeWikis()
+	s.repoInfoCache = newRepoInfoCache()
+	ctx.Logger().V(3).Info("setting filter patterns", "include_repos", conn.GetIncludeRepos(), "ignore_repos", conn.GetIgnoreRepos())
+	repoFilter, err := glob.NewGlobFilter(
+		glob.WithIncludeGlobs(conn.GetIncludeRepos()...),
+		glob.WithExcludeGlobs(conn.GetIgnoreRepos()...),
+	)
+	if err != nil {
+		return fmt.Errorf("could not compile repo patterns: %w", err)
+	}
+	s.repoFilter = repoFilter
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gitea{
+					Gitea: &source_metadatapb.Gitea{
+						Commit:     sanitizer.UTF8(commit),
+						File:       sanitizer.UTF8(file),
+						Email:      sanitizer.UTF8(email),
+						Repository: sanitizer.UTF8(repository),
+						Link:       generateLink(repository, commit, file, line),
+						Timestamp:  sanitizer.UTF8(timestamp),
+						Line:       line,
+						Visibility: s.visibilityOf(repository),
+					},
+				},
+			}
+		},
+	}
+	s.git = git.NewGit(cfg)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	giteaReposScanned.WithLabelValues(s.name).Set(0)
+
+	var repos []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	s.repos = repos
+	// We must sort the repos so we can resume later if necessary.
+	slices.Sort(s.repos)
+
+	return s.scanRepos(ctx, chunksChan)
+}
+
+func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	// If there is resume information available, limit this scan to only the repos that still need scanning.
+	reposToScan, progressIndexOffset := sources.FilterReposToResume(s.repos, s.GetProgress().EncodedResumeInfo)
+	ctx.Logger().V(2).Info("filtered repos to resume", "before", len(s.repos), "after", len(reposToScan))
+	s.repos = reposToScan
+	scanErrs := sources.NewScanErrors()
+
+	for i, repo := range s.repos {
+		i, repoURL := i, repo
+		s.jobPool.Go(func() error {
+			logger := ctx.Logger().WithValues("repo", repoURL)
+			if common.IsDone(ctx) {
+				// We are returning nil instead of the scanErrors slice here because
+				// we don't want to mark this scan as errored if we cancelled it.
+				logger.V(2).Info("Skipping repo because context was cancelled")
+				return nil
+			}
+
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, repoURL)
+			// Ensure the repo is removed from the resume info after being scanned.
+			defer func(s *Source) {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
+			}(s)
+
+			logger.V(2).Info("starting scan", "num", i+1, "total", len(s.repos))
+			if err := s.scanRepo(ctx, repoURL, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			giteaReposScanned.WithLabelValues(s.name).Inc()
+
+			logger.V(2).Info("completed scan", "num", i+1, "total", len(s.repos))
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(s.repos), len(s.repos), "Completed Gitea scan", "")
+
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index int, offset int, repoURL string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	// Add the repoURL to the resume info slice.
+	s.resumeInfoSlice = append(s.resumeInfoSlice, repoURL)
+	sort.Strings(s.resumeInfoSlice)
+
+	// Make the resume info string from the slice.
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	// Add the offset to both the index and the repos to give the proper place and proper repo count.
+	s.SetProgressComplete(index+offset, len(s.repos)+offset, fmt.Sprintf("Repo: %s", repoURL), encodedResumeInfo)
+}
+
+func (s *Source) cloneRepo(ctx context.Context, repoURL string) (string, *gogit.Repository, error) {
+	switch s.authMethod {
+	case "UNAUTHENTICATED":
+		return git.CloneRepoUsingUnauthenticated(ctx, repoURL)
+	case "BASIC_AUTH":
+		return git.CloneRepoUsingToken(ctx, s.password, repoURL, s.user)
+	default:
+		return git.CloneRepoUsingToken(ctx, s.token, repoURL, tokenCloneUser)
+	}
+}
+
+// scanRepo clones and scans a repository, followed by its wiki, issues, pull
+// requests and comments when enabled.
+func (s *Source) scanRepo(ctx context.Context, repoURL string, reporter sources.ChunkReporter) error {
+	path, repo, err := s.cloneRepo(ctx, repoURL)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	if err := s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter); err != nil {
+		return err
+	}
+
+	if !s.includeWikis && !s.includeIssueComments && !s.includePRComments {
+		return nil
+	}
+	info, err := s.repoInfo(ctx, repoURL)
+	if err != nil {
+		return fmt.Errorf("error getting repository %q: %w", repoURL, err)
+	}
+
+	if s.includeWikis && info.HasWiki {
+		if err := s.scanWiki(ctx, repoURL, reporter); err != nil {
+			return err
+		}
+	}
+
+	if s.includeIssueComments || s.includePRComments {
+		if err := s.scanIssues(ctx, info, reporter); err != nil {
+			return fmt.Errorf("error scanning issues of repository %q: %w", info.FullName, err)
+		}
+	}
+	return nil
+}
+
+// scanWiki clones and scans the wiki of a repository, if it has one.
+func (s *Source) scanWiki(ctx context.Context, repoURL string, reporter sources.ChunkReporter) error {
+	wikiURL := strings.TrimSuffix(repoURL, ".git") + ".wiki.git"
+	path, repo, err := s.cloneRepo(ctx, wikiURL)
+	if err != nil {
+		// Gitea reports a wiki for every repository with the wiki unit enabled,
+		// even when no page was ever written.
+		ctx.Logger().V(2).Info("skipping wiki", "repo", repoURL, "reason", "not found", "error", err)
+		return nil
+	}
+	defer os.RemoveAll(path)
+
+	return s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter)
+}
+
+// scanIssues scans the issues, pull requests, and their comments, as enabled.
+func (s *Source) scanIssues(ctx context.Context, info repository, reporter sources.ChunkReporter) error {
+	chunkIssues := func(issues []issue) error {
+		for _, i := range issues {
+			data := i.Title + "\n" + i.Body
+			if err := s.reportText(ctx, info, i.HTMLURL, i.User, i.CreatedAt.String(), data, reporter); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if s.includeIssueComments {
+		ctx.Logger().V(2).Info("Scanning issues", "repo", info.FullName)
+		if err := s.client.listIssues(ctx, info.Owner.Login, info.Name, "issues", chunkIssues); err != nil {
+			return err
+		}
+	}
+	if s.includePRComments {
+		ctx.Logger().V(2).Info("Scanning pull requests", "repo", info.FullName)
+		if err := s.client.listIssues(ctx, info.Owner.Login, info.Name, "pulls", chunkIssues); err != nil {
+			return err
+		}
+	}
+
+	return s.client.listComments(ctx, info.Owner.Login, info.Name, func(comments []comment) error {
+		for _, c := range comments {
+			// Comments on pull requests link to the pull request instead of an issue.
+			onPR := c.PullRequestURL != ""
+			if (onPR && !s.includePRComments) || (!onPR && !s.includeIssueComments) {
+				continue
+			}
+			if err := s.reportText(ctx, info, c.HTMLURL, c.User, c.CreatedAt.String(), c.Body, reporter); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Source) reportText(ctx context.Context, info repository, link string, author user, timestamp, data string, reporter sources.ChunkReporter) error {
+	chunk := sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitea{
+				Gitea: &source_metadatapb.Gitea{
+					Link:       sanitizer.UTF8(link),
+					Username:   sanitizer.UTF8(author.Login),
+					Email:      sanitizer.UTF8(author.Email),
+					Repository: sanitizer.UTF8(info.FullName),
+					Timestamp:  sanitizer.UTF8(timestamp),
+					Visibility: visibility(info),
+				},
+			},
+		},
+		Data:   []byte(sanitizer.UTF8(data)),
+		Verify: s.verify,
+	}
+	return reporter.ChunkOk(ctx, chunk)
+}
+
+// repoInfo returns the cached details of a repository, requesting them if it
+// wasn't enumerated.
+func (s *Source) repoInfo(ctx context.Context, repoURL string) (repository, error) {
+	if info, ok := s.repoInfoCache.get(repoURL); ok {
+		return info, nil
+	}
+
+	owner, name := parseRepoURL(repoURL)
+	info, err := s.client.getRepo(ctx, owner, name)
+	if err != nil {
+		return repository{}, err
+	}
+	s.repoInfoCache.put(repoURL, info)
+	return info, nil
+}
+
+func (s *Source) visibilityOf(repoURL string) source_metadatapb.Visibility {
+	// A wiki has the visibility of its repository.
+	if strings.HasSuffix(repoURL, ".wiki.git") {
+		repoURL = strings.TrimSuffix(repoURL, ".wiki.git") + ".git"
+	}
+	info, ok := s.repoInfoCache.get(repoURL)
+	if !ok {
+		// Configured repositories are only requested from the API when needed.
+		return source_metadatapb.Visibility_unknown
+	}
+	return visibility(info)
+}
+
+func visibility(info repository) source_metadatapb.Visibility {
+	switch {
+	case info.Private:
+		return source_metadatapb.Visibility_private
+	case info.Internal:
+		// Internal repositories are visible to every signed-in user.
+		return source_metadatapb.Visibility_shared
+	default:
+		return source_metadatapb.Visibility_public
+	}
+}
+
+func (s *Source) Validate(ctx context.Context) []error {
+	var errs []error
+
+	if s.authMethod != "UNAUTHENTICATED" {
+		if _, err := s.client.currentUser(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("could not get the authenticated user: %w", err))
+		}
+	}
+
+	repos, normalizeErrs := normalizeRepos(s.repos)
+	errs = append(errs, normalizeErrs...)
+	for _, r := range repos {
+		owner, name := parseRepoURL(r)
+		if _, err := s.client.getRepo(ctx, owner, name); err != nil {
+			errs = append(errs, fmt.Errorf("could not get repository %q: %w", r, err))
+		}
+	}
+
+	return errs
+}
+
+// Enumerate reports all Gitea repositories to be scanned to the reporter. The
+// repositories of the configured organizations and users are found while
+// respecting the configured include and ignore rules. When no repository,
+// organization or user is configured, every visible organization and user is
+// enumerated.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	giteaReposEnumerated.WithLabelValues(s.name).Set(0)
+
+	repos, errs := normalizeRepos(s.repos)
+	for _, repoErr := range errs {
+		ctx.Logger().Info("error normalizing repo", "error", repoErr)
+		if err := reporter.UnitErr(ctx, repoErr); err != nil {
+			return err
+		}
+	}
+
+	// End early if we had errors getting specified repos but none were validated.
+	if len(errs) > 0 && len(repos) == 0 {
+		return fmt.Errorf("all configured repos had validation issues")
+	}
+
+	seen := make(map[string]struct{})
+	report := func(repoURL string) error {
+		if _, ok := seen[repoURL]; ok {
+			return nil
+		}
+		seen[repoURL] = struct{}{}
+		if err := reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repoURL}); err != nil {
+			return err
+		}
+		giteaReposEnumerated.WithLabelValues(s.name).Inc()
+		return nil
+	}
+
+	for _, repo := range repos {
+		if err := report(repo); err != nil {
+			return err
+		}
+	}
+
+	orgs, users := s.orgs, s.users
+	if len(repos) == 0 && len(orgs) == 0 && len(users) == 0 {
+		ctx.Logger().Info("no repositories, organizations or users configured, enumerating")
+		var err error
+		if orgs, err = s.client.listOrgs(ctx); err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("error listing organizations: %w", err)); err != nil {
+				return err
+			}
+		}
+		if users, err = s.client.listUsers(ctx); err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("error listing users: %w", err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	reportRepos := func(owner string, list func(context.Context, string) ([]repository, error)) error {
+		ctx := context.WithValue(ctx, "owner", owner)
+		ownerRepos, err := list(ctx, owner)
+		if err != nil {
+			return reporter.UnitErr(ctx, fmt.Errorf("error listing repositories of %q: %w", owner, err))
+		}
+		for _, repo := range ownerRepos {
+			switch {
+			case repo.Empty:
+				ctx.Logger().V(3).Info("skipping repository", "repo", repo.FullName, "reason", "empty")
+				continue
+			case repo.Fork && !s.includeForks:
+				ctx.Logger().V(3).Info("skipping repository", "repo", repo.FullName, "reason", "fork")
+				continue
+			case !s.repoFilter.ShouldInclude(repo.FullName):
+				ctx.Logger().V(3).Info("skipping repository", "repo", repo.FullName, "reason", "filtered in config")
+				continue
+			}
+			s.repoInfoCache.put(repo.CloneURL, repo)
+			if err := report(repo.CloneURL); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, org := range orgs {
+		if err := reportRepos(org, s.client.listOrgRepos); err != nil {
+			return err
+		}
+	}
+	for _, u := range users {
+		if err := reportRepos(u, s.client.listUserRepos); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChunkUnit clones and reports chunks for the given Gitea repository unit.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repoURL, _ := unit.SourceUnitID()
+	return s.scanRepo(ctx, repoURL, reporter)
+}
+
+// generateLink crafts a link to the file at the commit the finding was
+// introduced in, or to the commit of a wiki.
+func generateLink(repository, commit, file string, line int64) string {
+	if strings.HasSuffix(repository, ".wiki.git") {
+		return strings.TrimSuffix(repository, ".wiki.git") + "/wiki/commit/" + commit
+	}
+	base := strings.TrimSuffix(repository, ".git")
+	if file == "" {
+		return base + "/commit/" + commit
+	}
+	link := base + "/src/commit/" + commit + "/" + strings.ReplaceAll(file, "%", "%25")
+	if line > 0 {
+		link += "#L" + strconv.FormatInt(line, 10)
+	}
+	return link
+}
+
+// parseRepoURL returns the owner and name of a Gitea repository URL, e.g.
+// https://gitea.example.com/<owner>/<repo>.git.
+func parseRepoURL(repoURL string) (owner, name string) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 {
+		return "", ""
+	}
+	return parts[len(parts)-2], strings.TrimSuffix(parts[len(parts)-1], ".git")
+}
+
+// normalizeRepos validates the configured repository URLs, strips any user
+// information from them, and adds the .git suffix of Gitea clone URLs.
+func normalizeRepos(repos []string) ([]string, []error) {
+	// Optimistically allocate space for all valid repositories.
+	validRepos := make([]string, 0, len(repos))
+	var errs []error
+	for _, r := range repos {
+		u, err := url.Parse(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse gitea url %q: %w", r, err))
+			continue
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			errs = append(errs, fmt.Errorf("gitea url %q must use http or https", r))
+			continue
+		}
+		if owner, name := parseRepoURL(r); owner == "" || name == "" {
+			errs = append(errs, fmt.Errorf("gitea url %q is missing the /<owner>/<repo> path", r))
+			continue
+		}
+		u.User = nil
+		u.Path = strings.TrimRight(u.Path, "/")
+		if !strings.HasSuffix(u.Path, ".git") {
+			u.Path += ".git"
+		}
+		validRepos = append(validRepos, u.String())
+	}
+	return validRepos, errs
+}
+
+type repoInfoCache struct {
+	mu    sync.RWMutex
+	cache map[string]repository
+}
+
+func newRepoInfoCache() repoInfoCache {
+	return repoInfoCache{
+		cache: make(map[string]repository),
+	}
+}
+
+func (r *repoInfoCache) put(repoURL string, info repository) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[repoURL] = info
+}
+
+func (r *repoInfoCache) get(repoURL string) (repository, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.cache[repoURL]
+	return info, ok
+}
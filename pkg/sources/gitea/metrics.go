@@ -0,0 +1,26 @@
+package gitea
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+)
+
+var (
+	giteaReposEnumerated = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "gitea_repos_enumerated",
+		Help:      "Total number of Gitea repositories enumerated.",
+	},
+		[]string{"source_name"})
+
+	giteaReposScanned = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: common.MetricsNamespace,
+		Subsystem: common.MetricsSubsystem,
+		Name:      "gitea_repos_scanned",
+		Help:      "Total number of Gitea repositories scanned.",
+	},
+		[]string{"source_name"})
+)
@@ -0,0 +1,205 @@
+package googledrive
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	drive "google.golang.org/api/drive/v3"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultMaxFileSize = 250 * 1024 * 1024 // 250 MiB
+
+	workspaceMimeTypePrefix = "application/vnd.google-apps."
+
+	fileListFields = "nextPageToken, files(id, name, mimeType, size, modifiedTime, webViewLink, shared, driveId, " +
+		"owners(displayName, emailAddress), lastModifyingUser(displayName, emailAddress), permissions(type))"
+	revisionListFields = "nextPageToken, revisions(id, modifiedTime, lastModifyingUser(displayName, emailAddress), exportLinks)"
+)
+
+// exportFormats are the formats Google Workspace files are exported to.
+// Spreadsheets are exported to Excel workbooks, as CSV exports only hold their
+// first sheet. The other Google Workspace types, such as folders and forms,
+// have no content to scan.
+var exportFormats = map[string]string{
+	"application/vnd.google-apps.document":     "text/plain",
+	"application/vnd.google-apps.spreadsheet":  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.google-apps.presentation": "text/plain",
+	"application/vnd.google-apps.script":       "application/vnd.google-apps.script+json",
+}
+
+// visibility returns who can see a file. The permissions of a file are only
+// listed to its owners and editors, so a file shared with anyone is reported
+// as shared to the other users.
+func visibility(f *drive.File) source_metadatapb.Visibility {
+	for _, p := range f.Permissions {
+		if p.Type == "anyone" {
+			return source_metadatapb.Visibility_public
+		}
+	}
+	if f.Shared || f.DriveId != "" {
+		return source_metadatapb.Visibility_shared
+	}
+	return source_metadatapb.Visibility_private
+}
+
+func (s *Source) metadata(f *drive.File, rev *drive.Revision) *source_metadatapb.MetaData {
+	var owner string
+	if len(f.Owners) > 0 {
+		owner = f.Owners[0].EmailAddress
+	}
+	modifiedBy, timestamp := f.LastModifyingUser, f.ModifiedTime
+	var revisionID string
+	if rev != nil {
+		modifiedBy, timestamp, revisionID = rev.LastModifyingUser, rev.ModifiedTime, rev.Id
+	}
+	var modifiedByName, modifiedByEmail string
+	if modifiedBy != nil {
+		modifiedByName, modifiedByEmail = modifiedBy.DisplayName, modifiedBy.EmailAddress
+	}
+
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_GoogleDrive{
+			GoogleDrive: &source_metadatapb.GoogleDrive{
+				File:           sanitizer.UTF8(f.Name),
+				Link:           sanitizer.UTF8(f.WebViewLink),
+				Email:          sanitizer.UTF8(modifiedByEmail),
+				Timestamp:      timestamp,
+				Shared:         f.Shared,
+				LastModifiedBy: sanitizer.UTF8(modifiedByName),
+				FileId:         f.Id,
+				Owner:          sanitizer.UTF8(owner),
+				Visibility:     visibility(f),
+				RevisionId:     revisionID,
+				DriveId:        f.DriveId,
+			},
+		},
+	}
+}
+
+// scanFile scans the content of a file, exporting Google Workspace files to
+// text, followed by its previous revisions when enabled.
+func (s *Source) scanFile(ctx context.Context, c *client, f *drive.File, reporter sources.ChunkReporter) error {
+	exportFormat, isExported := exportFormats[f.MimeType]
+	if strings.HasPrefix(f.MimeType, workspaceMimeTypePrefix) && !isExported {
+		return nil
+	}
+	if f.Size > defaultMaxFileSize {
+		ctx.Logger().V(3).Info("skipping file over the size limit", "file", f.Name, "size", f.Size)
+		return nil
+	}
+	if !isExported && common.SkipFile(f.Name) {
+		ctx.Logger().V(5).Info("skipping file with incompatible extension", "file", f.Name)
+		return nil
+	}
+	ctx.Logger().V(3).Info("scanning file", "file", f.Name, "id", f.Id)
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	if isExported {
+		resp, err = c.svc.Files.Export(f.Id, exportFormat).Context(ctx).Download()
+	} else {
+		resp, err = c.svc.Files.Get(f.Id).SupportsAllDrives(true).AcknowledgeAbuse(true).Context(ctx).Download()
+	}
+	if err != nil {
+		return fmt.Errorf("could not download %q (%s): %w", f.Name, f.Id, err)
+	}
+	if err := s.handleContent(ctx, resp.Body, s.metadata(f, nil), reporter); err != nil {
+		return fmt.Errorf("could not scan %q (%s): %w", f.Name, f.Id, err)
+	}
+
+	if !s.includeRevisions {
+		return nil
+	}
+	return s.scanRevisions(ctx, c, f, exportFormat, reporter)
+}
+
+// scanRevisions scans the previous revisions of a file. The last revision is
+// the current content of the file, which has already been scanned.
+func (s *Source) scanRevisions(ctx context.Context, c *client, f *drive.File, exportFormat string, reporter sources.ChunkReporter) error {
+	var revisions []*drive.Revision
+	err := c.svc.Revisions.List(f.Id).PageSize(200).Fields(revisionListFields).Pages(ctx, func(list *drive.RevisionList) error {
+		revisions = append(revisions, list.Revisions...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not list the revisions of %q (%s): %w", f.Name, f.Id, err)
+	}
+	if len(revisions) > 0 {
+		revisions = revisions[:len(revisions)-1]
+	}
+
+	for _, rev := range revisions {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.scanRevision(ctx, c, f, rev, exportFormat, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanRevision(ctx context.Context, c *client, f *drive.File, rev *drive.Revision, exportFormat string, reporter sources.ChunkReporter) error {
+	var body io.ReadCloser
+	if exportFormat != "" {
+		// Revisions of Google Workspace files can only be exported through
+		// their export links.
+		link := rev.ExportLinks[exportFormat]
+		if link == "" {
+			return nil
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return fmt.Errorf("could not export revision %s of %q: %w", rev.Id, f.Name, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("could not export revision %s of %q: unexpected status %d", rev.Id, f.Name, resp.StatusCode)
+		}
+		body = resp.Body
+	} else {
+		resp, err := c.svc.Revisions.Get(f.Id, rev.Id).AcknowledgeAbuse(true).Context(ctx).Download()
+		if err != nil {
+			return fmt.Errorf("could not download revision %s of %q: %w", rev.Id, f.Name, err)
+		}
+		body = resp.Body
+	}
+
+	ctx.Logger().V(3).Info("scanning revision", "file", f.Name, "revision", rev.Id)
+	if err := s.handleContent(ctx, body, s.metadata(f, rev), reporter); err != nil {
+		return fmt.Errorf("could not scan revision %s of %q: %w", rev.Id, f.Name, err)
+	}
+	return nil
+}
+
+func (s *Source) handleContent(ctx context.Context, body io.ReadCloser, metadata *source_metadatapb.MetaData, reporter sources.ChunkReporter) error {
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
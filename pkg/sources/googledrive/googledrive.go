@@ -0,0 +1,333 @@
+package googledrive
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/sync/errgroup"
+	drive "google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_GOOGLE_DRIVE
+
+	// A user unit is the My Drive of a user, and a shared drive unit is a
+	// shared drive of the organization.
+	unitUser        sources.SourceUnitKind = "user"
+	unitSharedDrive sources.SourceUnitKind = "shared_drive"
+
+	// me is the ID of the user unit of the authenticated identity, when no
+	// user is impersonated.
+	me = "me"
+)
+
+// Source scans the files of Google Drive, authenticating as a service account
+// or with OAuth credentials. With domain-wide delegation, the service account
+// impersonates each configured user in turn.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	users            []string
+	includeRevisions bool
+	endpoint         string
+	newHTTPClient    func(ctx context.Context, user string) (*http.Client, error)
+
+	mu      sync.Mutex
+	clients map[string]*client
+	// driveUsers records the user through which a shared drive was found.
+	driveUsers map[string]string
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// client is a Drive API client acting as a user.
+type client struct {
+	user string
+	svc  *drive.Service
+	http *http.Client
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Google Drive source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+	s.clients = make(map[string]*client)
+	s.driveUsers = make(map[string]string)
+
+	var conn sourcespb.GoogleDrive
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+	s.users = conn.GetUsers()
+	s.includeRevisions = conn.GetIncludeRevisions()
+	s.endpoint = conn.GetEndpoint()
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.GoogleDrive_JsonServiceAccount:
+		return s.initServiceAccount([]byte(cred.JsonServiceAccount))
+	case *sourcespb.GoogleDrive_ServiceAccountFile:
+		b, err := os.ReadFile(cred.ServiceAccountFile)
+		if err != nil {
+			return fmt.Errorf("error reading service account file: %w", err)
+		}
+		return s.initServiceAccount(b)
+	case *sourcespb.GoogleDrive_Oauth:
+		if len(s.users) > 0 {
+			return fmt.Errorf("users can only be impersonated by a service account")
+		}
+		tok := &oauth2.Token{AccessToken: cred.Oauth.GetAccessToken(), RefreshToken: cred.Oauth.GetRefreshToken()}
+		conf := &oauth2.Config{
+			ClientID:     cred.Oauth.GetClientId(),
+			ClientSecret: cred.Oauth.GetClientSecret(),
+			Scopes:       []string{drive.DriveReadonlyScope},
+			Endpoint:     endpoints.Google,
+		}
+		s.newHTTPClient = func(ctx context.Context, _ string) (*http.Client, error) {
+			return conf.Client(ctx, tok), nil
+		}
+		return nil
+	case *sourcespb.GoogleDrive_RefreshToken:
+		return fmt.Errorf("a refresh token can't be used without its OAuth client, use OAuth credentials instead")
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+}
+
+// initServiceAccount authenticates as a service account. When users are
+// configured, the service account impersonates them through domain-wide
+// delegation.
+func (s *Source) initServiceAccount(key []byte) error {
+	conf, err := google.JWTConfigFromJSON(key, drive.DriveReadonlyScope)
+	if err != nil {
+		return fmt.Errorf("invalid service account: %w", err)
+	}
+	s.newHTTPClient = func(ctx context.Context, user string) (*http.Client, error) {
+		userConf := *conf
+		userConf.Subject = user
+		return userConf.Client(ctx), nil
+	}
+	return nil
+}
+
+// client returns the Drive API client acting as the user, or as the
+// authenticated identity when the user is empty.
+func (s *Source) client(ctx context.Context, user string) (*client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.clients[user]; ok {
+		return c, nil
+	}
+
+	httpClient, err := s.newHTTPClient(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	opts := []option.ClientOption{option.WithHTTPClient(httpClient)}
+	if s.endpoint != "" {
+		opts = append(opts, option.WithEndpoint(s.endpoint))
+	}
+	svc, err := drive.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create Drive client: %w", err)
+	}
+
+	c := &client{user: user, svc: svc, http: httpClient}
+	s.clients[user] = c
+	return c, nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating Google Drive")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned drive: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Google Drive scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for the My Drive of every configured user, or of
+// the authenticated identity, followed by the shared drives they can access.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	users := s.users
+	if len(users) == 0 {
+		users = []string{""}
+	}
+
+	for _, user := range users {
+		unitID := user
+		if unitID == "" {
+			unitID = me
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitUser, ID: unitID}); err != nil {
+			return err
+		}
+
+		c, err := s.client(ctx, user)
+		if err != nil {
+			return err
+		}
+		err = c.svc.Drives.List().PageSize(100).Fields("nextPageToken, drives(id, name)").Pages(ctx, func(list *drive.DriveList) error {
+			for _, d := range list.Drives {
+				s.mu.Lock()
+				_, seen := s.driveUsers[d.Id]
+				if !seen {
+					s.driveUsers[d.Id] = user
+				}
+				s.mu.Unlock()
+				if seen {
+					continue
+				}
+				if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitSharedDrive, ID: d.Id}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not list the shared drives of %q: %w", unitID, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ChunkUnit scans every file of a My Drive or of a shared drive.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	id, kind := unit.SourceUnitID()
+
+	var (
+		user string
+		call func(*client) *drive.FilesListCall
+	)
+	switch kind {
+	case unitUser:
+		if id != me {
+			user = id
+		}
+		call = func(c *client) *drive.FilesListCall {
+			// Only the files owned by an impersonated user are scanned, so
+			// that the files shared between users are scanned once.
+			q := "trashed = false"
+			if user != "" {
+				q += " and 'me' in owners"
+			}
+			return c.svc.Files.List().Corpora("user").Q(q)
+		}
+	case unitSharedDrive:
+		s.mu.Lock()
+		user = s.driveUsers[id]
+		s.mu.Unlock()
+		if user == "" && len(s.users) > 0 {
+			user = s.users[0]
+		}
+		call = func(c *client) *drive.FilesListCall {
+			return c.svc.Files.List().Corpora("drive").DriveId(id).
+				IncludeItemsFromAllDrives(true).SupportsAllDrives(true).Q("trashed = false")
+		}
+	default:
+		return fmt.Errorf("unknown unit kind %q", kind)
+	}
+
+	c, err := s.client(ctx, user)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	ctx = context.WithValues(ctx, "unit", id)
+	ctx.Logger().V(2).Info("scanning drive")
+
+	err = call(c).PageSize(1000).Fields(fileListFields).Pages(ctx, func(list *drive.FileList) error {
+		for _, f := range list.Files {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			if err := s.scanFile(ctx, c, f, reporter); err != nil {
+				if err := reporter.ChunkErr(ctx, err); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not list the files of %q: %w", id, err))
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package googledrive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	drive "google.golang.org/api/drive/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// newTestServer fakes the Drive API and the token endpoint of the service
+// account. The access token of a request identifies the impersonated user.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.FormValue("assertion"), ".")
+		require.Len(t, parts, 3)
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var claims struct {
+			Sub string `json:"sub"`
+		}
+		require.NoError(t, json.Unmarshal(payload, &claims))
+		if claims.Sub == "" {
+			claims.Sub = "me"
+		}
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%s", "token_type": "Bearer", "expires_in": 3600}`, claims.Sub)
+	})
+
+	user := func(r *http.Request) string {
+		return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-")
+	}
+
+	mux.HandleFunc("/drive/v3/drives", func(w http.ResponseWriter, r *http.Request) {
+		switch user(r) {
+		case "alice@example.com":
+			_, _ = fmt.Fprint(w, `{"drives": [{"id": "drive-eng", "name": "Engineering"}]}`)
+		case "bob@example.com":
+			_, _ = fmt.Fprint(w, `{"drives": [{"id": "drive-eng", "name": "Engineering"}, {"id": "drive-ops", "name": "Ops"}]}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"drives": []}`)
+		}
+	})
+
+	mux.HandleFunc("/drive/v3/files", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Contains(t, q.Get("q"), "trashed = false")
+		switch {
+		case q.Get("corpora") == "drive" && q.Get("driveId") == "drive-eng":
+			assert.Equal(t, "alice@example.com", user(r))
+			_, _ = fmt.Fprint(w, `{"files": [
+				{"id": "file-creds", "name": "creds.txt", "mimeType": "text/plain", "size": "24", "driveId": "drive-eng",
+				 "webViewLink": "https://drive.google.com/file/d/file-creds/view", "modifiedTime": "2024-05-02T10:00:00Z"}
+			]}`)
+		case q.Get("corpora") == "user" && user(r) == "alice@example.com":
+			assert.Contains(t, q.Get("q"), "'me' in owners")
+			_, _ = fmt.Fprint(w, `{"files": [
+				{"id": "folder-1", "name": "Projects", "mimeType": "application/vnd.google-apps.folder"},
+				{"id": "doc-1", "name": "Notes", "mimeType": "application/vnd.google-apps.document",
+				 "webViewLink": "https://docs.google.com/document/d/doc-1/edit", "modifiedTime": "2024-05-01T10:00:00Z", "shared": true,
+				 "owners": [{"displayName": "Alice", "emailAddress": "alice@example.com"}],
+				 "lastModifyingUser": {"displayName": "Bob", "emailAddress": "bob@example.com"},
+				 "permissions": [{"type": "user"}, {"type": "anyone"}]},
+				{"id": "file-1", "name": "deploy.env", "mimeType": "text/plain", "size": "19",
+				 "owners": [{"displayName": "Alice", "emailAddress": "alice@example.com"}],
+				 "permissions": [{"type": "user"}]},
+				{"id": "file-2", "name": "logo.png", "mimeType": "image/png", "size": "1024"}
+			]}`)
+		case q.Get("corpora") == "user" && user(r) == "me":
+			assert.NotContains(t, q.Get("q"), "owners")
+			_, _ = fmt.Fprint(w, `{"files": [
+				{"id": "sheet-1", "name": "Inventory", "mimeType": "application/vnd.google-apps.spreadsheet"}
+			]}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"files": []}`)
+		}
+	})
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer token-"))
+			_, _ = fmt.Fprint(w, body)
+		})
+	}
+	handle("/drive/v3/files/doc-1/export", "aws_key: from-current-doc")
+	mux.HandleFunc("/drive/v3/files/sheet-1/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", r.URL.Query().Get("mimeType"))
+		_, _ = w.Write(newWorkbook(t))
+	})
+	handle("/drive/v3/files/file-1", "TOKEN=from-current")
+	handle("/drive/v3/files/file-creds", "password=from-shared-drive")
+	handle("/drive/v3/files/doc-1/revisions", fmt.Sprintf(`{"revisions": [
+		{"id": "rev-1", "modifiedTime": "2024-04-01T10:00:00Z", "lastModifyingUser": {"displayName": "Alice", "emailAddress": "alice@example.com"},
+		 "exportLinks": {"text/plain": "%[1]s/export/doc-1/rev-1"}},
+		{"id": "rev-2", "exportLinks": {"text/plain": "%[1]s/export/doc-1/rev-2"}}
+	]}`, server.URL))
+	handle("/export/doc-1/rev-1", "aws_key: from-old-doc")
+	handle("/drive/v3/files/file-1/revisions", `{"revisions": [{"id": "rev-a"}, {"id": "rev-b"}]}`)
+	handle("/drive/v3/files/file-1/revisions/rev-a", "TOKEN=from-old")
+	handle("/drive/v3/files/file-creds/revisions", `{"revisions": [{"id": "rev-only"}]}`)
+
+	return server
+}
+
+// newWorkbook returns an Excel workbook of two sheets, with a secret in the
+// second one.
+func newWorkbook(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range [][2]string{
+		{"[Content_Types].xml", `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"/>`},
+		{"xl/workbook.xml", `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>
+			<sheet name="Hosts" sheetId="1" r:id="rId1"/>
+			<sheet name="Credentials" sheetId="2" r:id="rId2"/>
+		</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<Relationships>
+			<Relationship Id="rId1" Target="worksheets/sheet1.xml"/>
+			<Relationship Id="rId2" Target="worksheets/sheet2.xml"/>
+		</Relationships>`},
+		{"xl/worksheets/sheet1.xml", `<worksheet><sheetData><row r="1">
+			<c r="A1" t="inlineStr"><is><t>db</t></is></c>
+		</row></sheetData></worksheet>`},
+		{"xl/worksheets/sheet2.xml", `<worksheet><sheetData><row r="1">
+			<c r="A1" t="inlineStr"><is><t>from-sheet</t></is></c>
+		</row></sheetData></worksheet>`},
+	} {
+		f, err := w.Create(file[0])
+		require.NoError(t, err)
+		_, err = f.Write([]byte(file[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func serviceAccount(t *testing.T, tokenURL string) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	sa, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "scanner@project.iam.gserviceaccount.com",
+		"private_key_id": "key-1",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      tokenURL,
+	})
+	require.NoError(t, err)
+	return string(sa)
+}
+
+func initSource(t *testing.T, server *httptest.Server, conn *sourcespb.GoogleDrive) *Source {
+	t.Helper()
+
+	conn.Endpoint = server.URL + "/drive/v3/"
+	conn.Credential = &sourcespb.GoogleDrive_JsonServiceAccount{JsonServiceAccount: serviceAccount(t, server.URL+"/token")}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test - google drive", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.GoogleDrive
+		want []sources.CommonSourceUnit
+	}{
+		{
+			name: "service account",
+			conn: &sourcespb.GoogleDrive{},
+			want: []sources.CommonSourceUnit{{Kind: unitUser, ID: "me"}},
+		},
+		{
+			name: "domain-wide delegation",
+			conn: &sourcespb.GoogleDrive{Users: []string{"alice@example.com", "bob@example.com"}},
+			want: []sources.CommonSourceUnit{
+				{Kind: unitUser, ID: "alice@example.com"},
+				{Kind: unitSharedDrive, ID: "drive-eng"},
+				{Kind: unitUser, ID: "bob@example.com"},
+				{Kind: unitSharedDrive, ID: "drive-ops"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, server, tt.conn)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+
+			var got []sources.CommonSourceUnit
+			for _, unit := range reporter.Units {
+				got = append(got, unit.(sources.CommonSourceUnit))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name      string
+		conn      *sourcespb.GoogleDrive
+		unit      sources.CommonSourceUnit
+		wantData  []string
+		wantRevID []string
+	}{
+		{
+			name:     "user drive",
+			conn:     &sourcespb.GoogleDrive{Users: []string{"alice@example.com"}},
+			unit:     sources.CommonSourceUnit{Kind: unitUser, ID: "alice@example.com"},
+			wantData: []string{"aws_key: from-current-doc", "TOKEN=from-current"},
+		},
+		{
+			name:      "user drive with revisions",
+			conn:      &sourcespb.GoogleDrive{Users: []string{"alice@example.com"}, IncludeRevisions: true},
+			unit:      sources.CommonSourceUnit{Kind: unitUser, ID: "alice@example.com"},
+			wantData:  []string{"aws_key: from-current-doc", "aws_key: from-old-doc", "TOKEN=from-current", "TOKEN=from-old"},
+			wantRevID: []string{"", "rev-1", "", "rev-a"},
+		},
+		{
+			// Every sheet of spreadsheets is scanned.
+			name:     "service account drive",
+			conn:     &sourcespb.GoogleDrive{},
+			unit:     sources.CommonSourceUnit{Kind: unitUser, ID: "me"},
+			wantData: []string{"Hosts!A1: db\n", "Credentials!A1: from-sheet\n"},
+		},
+		{
+			name:     "shared drive",
+			conn:     &sourcespb.GoogleDrive{Users: []string{"alice@example.com"}, IncludeRevisions: true},
+			unit:     sources.CommonSourceUnit{Kind: unitSharedDrive, ID: "drive-eng"},
+			wantData: []string{"password=from-shared-drive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, server, tt.conn)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.ChunkUnit(context.Background(), tt.unit, &reporter))
+			assert.Empty(t, reporter.ChunkErrs)
+
+			var data, revIDs []string
+			for _, chunk := range reporter.Chunks {
+				// The XML parts of workbooks are scanned as well, and left out here.
+				if bytes.HasPrefix(chunk.Data, []byte("<")) {
+					continue
+				}
+				data = append(data, string(chunk.Data))
+				revIDs = append(revIDs, chunk.SourceMetadata.GetGoogleDrive().GetRevisionId())
+			}
+			assert.Equal(t, tt.wantData, data)
+			if tt.wantRevID != nil {
+				assert.Equal(t, tt.wantRevID, revIDs)
+			}
+		})
+	}
+}
+
+func TestChunkUnit_Metadata(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, server, &sourcespb.GoogleDrive{Users: []string{"alice@example.com"}, IncludeRevisions: true})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitUser, ID: "alice@example.com"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	require.Len(t, reporter.Chunks, 4)
+
+	current := reporter.Chunks[0].SourceMetadata.GetGoogleDrive()
+	assert.Equal(t, "Notes", current.GetFile())
+	assert.Equal(t, "doc-1", current.GetFileId())
+	assert.Equal(t, "https://docs.google.com/document/d/doc-1/edit", current.GetLink())
+	assert.Equal(t, "alice@example.com", current.GetOwner())
+	assert.Equal(t, "Bob", current.GetLastModifiedBy())
+	assert.Equal(t, "bob@example.com", current.GetEmail())
+	assert.Equal(t, "2024-05-01T10:00:00Z", current.GetTimestamp())
+	assert.True(t, current.GetShared())
+	assert.Equal(t, source_metadatapb.Visibility_public, current.GetVisibility())
+
+	old := reporter.Chunks[1].SourceMetadata.GetGoogleDrive()
+	assert.Equal(t, "rev-1", old.GetRevisionId())
+	assert.Equal(t, "Alice", old.GetLastModifiedBy())
+	assert.Equal(t, "2024-04-01T10:00:00Z", old.GetTimestamp())
+
+	private := reporter.Chunks[2].SourceMetadata.GetGoogleDrive()
+	assert.Equal(t, source_metadatapb.Visibility_private, private.GetVisibility())
+}
+
+func TestVisibility(t *testing.T) {
+	tests := []struct {
+		name string
+		file *drive.File
+		want source_metadatapb.Visibility
+	}{
+		{name: "owner only", file: &drive.File{Permissions: []*drive.Permission{{Type: "user"}}}, want: source_metadatapb.Visibility_private},
+		{name: "shared", file: &drive.File{Shared: true}, want: source_metadatapb.Visibility_shared},
+		{name: "shared drive", file: &drive.File{DriveId: "drive-eng"}, want: source_metadatapb.Visibility_shared},
+		{name: "anyone with the link", file: &drive.File{Shared: true, Permissions: []*drive.Permission{{Type: "anyone"}}}, want: source_metadatapb.Visibility_public},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, visibility(tt.file))
+		})
+	}
+}
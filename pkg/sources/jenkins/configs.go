@@ -0,0 +1,230 @@
+package jenkins
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// errNotAvailable is returned for content that doesn't exist or can't be read
+// with the credential, such as the replay of a freestyle build, or the
+// environment of a build when the EnvInject plugin isn't installed.
+var errNotAvailable = errors.New("not available")
+
+// textareaPat matches the editors of the replay page of a pipeline build,
+// which hold the Jenkinsfile and the scripts it loaded.
+var textareaPat = regexp.MustCompile(`(?s)<textarea[^>]*?\bname="([^"]*)"[^>]*>(.*?)</textarea>`)
+
+// getBody requests a path of the Jenkins server, and returns the body of the
+// response along with its URL.
+func (s *Source) getBody(ctx context.Context, reqPath, rawQuery string) ([]byte, string, error) {
+	reqURL := *s.url
+	reqURL.Path = reqPath
+	reqURL.RawQuery = rawQuery
+	link := reqURL.String()
+
+	req, err := s.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		return nil, link, err
+	}
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, link, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound:
+		return nil, link, errNotAvailable
+	case resp.StatusCode != http.StatusOK:
+		return nil, link, fmt.Errorf("received non-200 status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	return body, link, err
+}
+
+// chunkConfig sends the config.xml of a job or folder, which holds the shell
+// steps, parameter defaults and inline pipeline script of jobs.
+func (s *Source) chunkConfig(ctx context.Context, job JenkinsJob, chunksChan chan *sources.Chunk) {
+	logger := s.log.WithValues("project", job.Name)
+	parsedUrl, err := url.Parse(job.Url)
+	if err != nil {
+		logger.Error(err, "Failed to parse Jenkins project URL, skipping config", "url", job.Url)
+		return
+	}
+
+	config, link, err := s.getBody(ctx, path.Join(parsedUrl.Path, "config.xml"), "")
+	if errors.Is(err, errNotAvailable) {
+		logger.V(2).Info("Jenkins config is not available, skipping config")
+		return
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get Jenkins config, skipping config")
+		return
+	}
+	s.sendChunk(ctx, chunksChan, &source_metadatapb.Jenkins{
+		ProjectName: job.Name,
+		Link:        link,
+		Kind:        kindConfig,
+	}, config)
+}
+
+// chunkBuildScripts sends the Jenkinsfile of a pipeline build, which may come
+// from SCM, and the scripts it loaded. They are read from the replay page of
+// the build, and only sent once per project when unchanged across builds.
+func (s *Source) chunkBuildScripts(ctx context.Context, build JenkinsBuild, projectName string, seen map[[32]byte]struct{}, chunksChan chan *sources.Chunk) {
+	logger := s.log.WithValues("build", build.Number, "project", projectName)
+	parsedUrl, err := url.Parse(build.Url)
+	if err != nil {
+		logger.Error(err, "Failed to parse Jenkins build URL, skipping scripts", "url", build.Url)
+		return
+	}
+
+	page, link, err := s.getBody(ctx, path.Join(parsedUrl.Path, "replay")+"/", "")
+	if errors.Is(err, errNotAvailable) {
+		return
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get Jenkins build replay, skipping scripts")
+		return
+	}
+
+	for _, match := range textareaPat.FindAllSubmatch(page, -1) {
+		name := strings.TrimPrefix(string(match[1]), "_.")
+		if name == "mainScript" {
+			name = "Jenkinsfile"
+		}
+		script := []byte(html.UnescapeString(string(match[2])))
+		hash := sha256.Sum256(script)
+		if _, ok := seen[hash]; ok || len(script) == 0 {
+			continue
+		}
+		seen[hash] = struct{}{}
+
+		s.sendChunk(ctx, chunksChan, &source_metadatapb.Jenkins{
+			ProjectName: projectName,
+			BuildNumber: build.Number,
+			Link:        link,
+			File:        name,
+			Kind:        kindScript,
+		}, script)
+	}
+}
+
+// chunkBuildParameters sends the parameters of a build, and the environment
+// variables injected into it by the EnvInject plugin, as NAME=value lines.
+func (s *Source) chunkBuildParameters(ctx context.Context, build JenkinsBuild, projectName string, chunksChan chan *sources.Chunk) {
+	logger := s.log.WithValues("build", build.Number, "project", projectName)
+	parsedUrl, err := url.Parse(build.Url)
+	if err != nil {
+		logger.Error(err, "Failed to parse Jenkins build URL, skipping parameters", "url", build.Url)
+		return
+	}
+
+	// Link to the pages of the build rather than to the API.
+	pageURL := func(page string) string {
+		u := *s.url
+		u.Path = path.Join(parsedUrl.Path, page) + "/"
+		return u.String()
+	}
+
+	body, _, err := s.getBody(ctx, path.Join(parsedUrl.Path, "api/json"), "tree=actions[parameters[name,value]]")
+	if err != nil && !errors.Is(err, errNotAvailable) {
+		logger.Error(err, "Failed to get Jenkins build parameters")
+	}
+	if err == nil {
+		var resp struct {
+			Actions []struct {
+				Parameters []struct {
+					Name  string          `json:"name"`
+					Value json.RawMessage `json:"value"`
+				} `json:"parameters"`
+			} `json:"actions"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			logger.Error(err, "Failed to decode Jenkins build parameters")
+		}
+		params := map[string]string{}
+		for _, action := range resp.Actions {
+			for _, param := range action.Parameters {
+				// Values aren't always strings, such as those of boolean
+				// parameters.
+				var value string
+				if json.Unmarshal(param.Value, &value) != nil {
+					value = string(param.Value)
+				}
+				params[param.Name] = value
+			}
+		}
+		s.sendVariables(ctx, chunksChan, projectName, build.Number, pageURL("parameters"), kindParameters, params)
+	}
+
+	body, _, err = s.getBody(ctx, path.Join(parsedUrl.Path, "injectedEnvVars/api/json"), "")
+	if errors.Is(err, errNotAvailable) {
+		return
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get Jenkins build environment")
+		return
+	}
+	var resp struct {
+		EnvMap map[string]string `json:"envMap"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		logger.Error(err, "Failed to decode Jenkins build environment")
+		return
+	}
+	s.sendVariables(ctx, chunksChan, projectName, build.Number, pageURL("injectedEnvVars"), kindEnvironment, resp.EnvMap)
+}
+
+// sendVariables sends variables as sorted NAME=value lines, so that detectors
+// looking for several values find them in the same chunk.
+func (s *Source) sendVariables(ctx context.Context, chunksChan chan *sources.Chunk, projectName string, buildNumber int64, link, kind string, variables map[string]string) {
+	if len(variables) == 0 {
+		return
+	}
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var data strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&data, "%s=%s\n", name, variables[name])
+	}
+	s.sendChunk(ctx, chunksChan, &source_metadatapb.Jenkins{
+		ProjectName: projectName,
+		BuildNumber: buildNumber,
+		Link:        link,
+		Kind:        kind,
+	}, []byte(data.String()))
+}
+
+func (s *Source) sendChunk(ctx context.Context, chunksChan chan *sources.Chunk, metadata *source_metadatapb.Jenkins, data []byte) {
+	_ = common.CancellableWrite(ctx, chunksChan, &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		JobID:      s.JobID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Jenkins{Jenkins: metadata},
+		},
+		Data:   data,
+		Verify: s.verify,
+	})
+}
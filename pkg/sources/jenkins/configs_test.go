@@ -0,0 +1,120 @@
+package jenkins
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// newTestServer returns a stand-in for a Jenkins server with a pipeline in a
+// folder, which has two builds running the same Jenkinsfile.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	routes := map[string]string{
+		"/api/json": `{"jobs": [
+			{"_class": "com.cloudbees.hudson.plugins.folder.Folder", "name": "team", "url": "{{server}}/job/team/"}
+		]}`,
+		"/job/team/api/json": `{"jobs": [
+			{"_class": "org.jenkinsci.plugins.workflow.job.WorkflowJob", "name": "deploy", "url": "{{server}}/job/team/job/deploy/"}
+		]}`,
+		"/job/team/config.xml": `<folder><password>from-folder</password></folder>`,
+		"/job/team/job/deploy/api/json": `{"builds": [
+			{"number": 2, "url": "{{server}}/job/team/job/deploy/2/"},
+			{"number": 1, "url": "{{server}}/job/team/job/deploy/1/"}
+		]}`,
+		"/job/team/job/deploy/config.xml":    `<flow-definition><scm>git</scm></flow-definition>`,
+		"/job/team/job/deploy/2/consoleText": "from-log",
+		"/job/team/job/deploy/1/consoleText": "from-log",
+		"/job/team/job/deploy/2/replay/": `<form>
+			<textarea name="_.mainScript" class="editor">sh &#39;deploy --token from-scm&#39;</textarea>
+			<textarea name="_.Script1">def helper() {}</textarea>
+		</form>`,
+		"/job/team/job/deploy/1/replay/": `<form><textarea name="_.mainScript">sh &#39;deploy --token from-scm&#39;</textarea></form>`,
+		"/job/team/job/deploy/2/api/json": `{"actions": [{}, {"parameters": [
+			{"name": "TOKEN", "value": "from-parameter"},
+			{"name": "DRY_RUN", "value": false}
+		]}]}`,
+		"/job/team/job/deploy/2/injectedEnvVars/api/json": `{"envMap": {"API_KEY": "from-env"}}`,
+	}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/json") {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		_, _ = fmt.Fprint(w, strings.ReplaceAll(body, "{{server}}", server.URL))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSource_ChunksConfigs(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.Jenkins
+		want []string
+	}{
+		{
+			name: "logs only",
+			conn: &sourcespb.Jenkins{},
+			want: []string{
+				"log deploy#2 : from-log",
+				"log deploy#1 : from-log",
+			},
+		},
+		{
+			name: "configs and parameters",
+			conn: &sourcespb.Jenkins{IncludeConfigs: true, IncludeBuildParameters: true},
+			want: []string{
+				"config team#0 : <folder><password>from-folder</password></folder>",
+				"config deploy#0 : <flow-definition><scm>git</scm></flow-definition>",
+				"log deploy#2 : from-log",
+				"script deploy#2 Jenkinsfile: sh 'deploy --token from-scm'",
+				"script deploy#2 Script1: def helper() {}",
+				"parameters deploy#2 : DRY_RUN=false\nTOKEN=from-parameter\n",
+				"environment deploy#2 : API_KEY=from-env\n",
+				"log deploy#1 : from-log",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conn.Endpoint = server.URL
+			tt.conn.Credential = &sourcespb.Jenkins_Unauthenticated{}
+			conn, err := anypb.New(tt.conn)
+			require.NoError(t, err)
+			s := &Source{}
+			require.NoError(t, s.Init(context.Background(), "test - jenkins", 0, 0, false, conn, 1))
+
+			chunksChan := make(chan *sources.Chunk, 64)
+			require.NoError(t, s.Chunks(context.Background(), chunksChan))
+			close(chunksChan)
+
+			var got []string
+			for chunk := range chunksChan {
+				meta := chunk.SourceMetadata.GetJenkins()
+				assert.True(t, strings.HasPrefix(meta.GetLink(), server.URL+"/job/team/"), meta.GetLink())
+				got = append(got, fmt.Sprintf("%s %s#%d %s: %s", meta.GetKind(), meta.GetProjectName(), meta.GetBuildNumber(), meta.GetFile(), chunk.Data))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package jenkins
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// initHome prepares the source to scan a local JENKINS_HOME, such as the
+// backup of a controller. Without its keys, encrypted values are scanned as
+// they are.
+func (s *Source) initHome(home string) error {
+	info, err := os.Stat(home)
+	if err != nil {
+		return fmt.Errorf("could not access JENKINS_HOME: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("JENKINS_HOME %q is not a directory", home)
+	}
+	s.home = home
+
+	s.secrets, err = loadSecretDecrypter(home)
+	if err != nil {
+		s.log.Info("encrypted values will not be decrypted", "error", err.Error())
+	}
+	return nil
+}
+
+// chunkHome scans the global configuration files, including credentials.xml,
+// the configuration of every user and node, and then the configuration and
+// builds of every job of the JENKINS_HOME.
+func (s *Source) chunkHome(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	reporter := sources.ChanReporter{Ch: chunksChan}
+
+	configs, err := filepath.Glob(filepath.Join(s.home, "*.xml"))
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		s.chunkHomeFile(ctx, config, "", 0, kindConfig, reporter)
+	}
+
+	// Users keep their API tokens in their config.xml, and nodes the
+	// credentials used to launch their agents.
+	for _, dir := range []string{"users", "nodes"} {
+		names, err := readDirNames(filepath.Join(s.home, dir))
+		if err != nil {
+			s.log.Error(err, "Failed to read Jenkins "+dir+", skipping "+dir)
+		}
+		for _, name := range names {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.chunkHomeFile(ctx, filepath.Join(s.home, dir, name, "config.xml"), "", 0, kindConfig, reporter)
+		}
+	}
+
+	jobs, err := readDirNames(filepath.Join(s.home, "jobs"))
+	if err != nil {
+		return fmt.Errorf("could not read the jobs of JENKINS_HOME: %w", err)
+	}
+	for i, name := range jobs {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		s.SetProgressComplete(i, len(jobs), fmt.Sprintf("Project: %s", name), "")
+		s.chunkHomeJob(ctx, filepath.Join(s.home, "jobs", name), name, reporter)
+	}
+
+	s.SetProgressComplete(len(jobs), len(jobs), fmt.Sprintf("Done scanning source %s", s.name), "")
+	return nil
+}
+
+// chunkHomeJob scans the config.xml of a job or folder, the build.xml and log
+// of its builds, and the jobs it contains. build.xml holds the parameters of
+// the build and, for pipelines, the script that ran.
+func (s *Source) chunkHomeJob(ctx context.Context, dir, projectName string, reporter sources.ChunkReporter) {
+	s.chunkHomeFile(ctx, filepath.Join(dir, "config.xml"), projectName, 0, kindConfig, reporter)
+
+	builds, err := readBuilds(filepath.Join(dir, "builds"))
+	if err != nil {
+		s.log.Error(err, "Failed to read Jenkins builds, skipping builds", "project", projectName)
+	}
+	for _, build := range builds {
+		for _, file := range []struct{ name, kind string }{
+			{"build.xml", kindBuild},
+			{"log", kindLog},
+			{"log.gz", kindLog},
+		} {
+			if common.IsDone(ctx) {
+				return
+			}
+			s.chunkHomeFile(ctx, filepath.Join(build.dir, file.name), projectName, build.number, file.kind, reporter)
+		}
+	}
+
+	// Folders and organizations keep their jobs in jobs, and multibranch
+	// projects in branches.
+	for _, children := range []string{"jobs", "branches"} {
+		names, err := readDirNames(filepath.Join(dir, children))
+		if err != nil {
+			s.log.Error(err, "Failed to read Jenkins jobs, skipping jobs", "project", projectName)
+		}
+		for _, name := range names {
+			if common.IsDone(ctx) {
+				return
+			}
+			s.chunkHomeJob(ctx, filepath.Join(dir, children, name), path.Join(projectName, name), reporter)
+		}
+	}
+}
+
+// chunkHomeFile scans a file of the JENKINS_HOME, which it skips if missing.
+// The encrypted values of XML files are decrypted when the keys are available.
+func (s *Source) chunkHomeFile(ctx context.Context, filePath, projectName string, buildNumber int64, kind string, reporter sources.ChunkReporter) {
+	logger := s.log.WithValues("file", filePath)
+	f, err := os.Open(filePath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			logger.Error(err, "Failed to open Jenkins file, skipping file")
+		}
+		return
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	if s.secrets != nil && strings.HasSuffix(filePath, ".xml") {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			logger.Error(err, "Failed to read Jenkins file, skipping file")
+			return
+		}
+		reader = bytes.NewReader(s.secrets.decryptAll(data))
+	}
+
+	relPath, err := filepath.Rel(s.home, filePath)
+	if err != nil {
+		relPath = filePath
+	}
+	chunkSkel := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		JobID:      s.JobID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Jenkins{
+				Jenkins: &source_metadatapb.Jenkins{
+					ProjectName: projectName,
+					BuildNumber: buildNumber,
+					File:        filepath.ToSlash(relPath),
+					Kind:        kind,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if err := handlers.HandleFile(ctx, reader, chunkSkel, reporter); err != nil {
+		logger.Error(err, "Failed to handle Jenkins file")
+	}
+}
+
+// legacyBuildPattern matches the names of the build directories of Jenkins
+// before 1.597, which are named by the timestamp of the build.
+var legacyBuildPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}_\d{2}-\d{2}-\d{2}$`)
+
+// homeBuild is the directory of a build, and its number if known.
+type homeBuild struct {
+	number int64
+	dir    string
+}
+
+// readBuilds returns the builds in the builds directory of a job, or none if
+// it doesn't exist. Builds are kept in directories named by their number or,
+// before Jenkins 1.597, in directories named by their timestamp that symbolic
+// links named by their number point to. Builds are returned once, by number
+// when linked to. Other symbolic links, such as lastSuccessfulBuild, point to
+// numbered builds.
+func readBuilds(dir string) ([]homeBuild, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var builds []homeBuild
+	linked := make(map[string]bool)
+	for _, entry := range entries {
+		number, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		buildDir := filepath.Join(dir, entry.Name())
+		if info, err := os.Stat(buildDir); err != nil || !info.IsDir() {
+			continue
+		}
+		if target, err := filepath.EvalSymlinks(buildDir); err == nil {
+			linked[target] = true
+		}
+		builds = append(builds, homeBuild{number: number, dir: buildDir})
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || !legacyBuildPattern.MatchString(entry.Name()) {
+			continue
+		}
+		buildDir := filepath.Join(dir, entry.Name())
+		if target, err := filepath.EvalSymlinks(buildDir); err == nil && linked[target] {
+			continue
+		}
+		builds = append(builds, homeBuild{dir: buildDir})
+	}
+	return builds, nil
+}
+
+// readDirNames returns the sorted names of the directories in dir, or none if
+// it doesn't exist.
+func readDirNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,168 @@
+package jenkins
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// pad adds PKCS #5 padding.
+func pad(data []byte) []byte {
+	n := aes.BlockSize - len(data)%aes.BlockSize
+	return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+// encryptECB encrypts data followed by secretMagic the way Jenkins stores
+// confidential keys and legacy secrets.
+func encryptECB(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	plaintext := pad(append(append([]byte{}, data...), secretMagic...))
+	ciphertext := make([]byte, len(plaintext))
+	for i := 0; i < len(plaintext); i += aes.BlockSize {
+		block.Encrypt(ciphertext[i:i+aes.BlockSize], plaintext[i:i+aes.BlockSize])
+	}
+	return ciphertext
+}
+
+// encryptSecret encrypts a value in the current format of hudson.util.Secret.
+func encryptSecret(t *testing.T, key []byte, value string) string {
+	t.Helper()
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	iv := bytes.Repeat([]byte{7}, aes.BlockSize)
+	plaintext := pad([]byte(value))
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
+
+	payload := []byte{secretPayloadV1}
+	payload = binary.BigEndian.AppendUint32(payload, uint32(len(iv)))
+	payload = binary.BigEndian.AppendUint32(payload, uint32(len(ciphertext)))
+	payload = append(append(payload, iv...), ciphertext...)
+	return "{" + base64.StdEncoding.EncodeToString(payload) + "}"
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// newTestHome returns a JENKINS_HOME with a credential, a pipeline in a folder
+// and a build of it. It leaves out the keys when withKeys is false.
+func newTestHome(t *testing.T, withKeys bool) string {
+	t.Helper()
+
+	home := t.TempDir()
+	masterKey := []byte("0123456789abcdef0123456789abcdef\n")
+	secretKey := bytes.Repeat([]byte{42}, 256)
+	key := secretKey[:aes.BlockSize]
+	legacyKey := []byte("legacy-secret-key")
+	if withKeys {
+		writeFile(t, filepath.Join(home, "secrets", "master.key"), masterKey)
+		writeFile(t, filepath.Join(home, "secrets", "hudson.util.Secret"), encryptECB(t, aes128Key(masterKey), secretKey))
+		writeFile(t, filepath.Join(home, "secret.key"), legacyKey)
+	}
+
+	writeFile(t, filepath.Join(home, "credentials.xml"), []byte(`<com.cloudbees.plugins.credentials.SystemCredentialsProvider>
+  <username>deploy</username>
+  <password>`+encryptSecret(t, key, "from-credentials")+`</password>
+  <legacy>`+base64.StdEncoding.EncodeToString(encryptECB(t, aes128Key(legacyKey), []byte("from-legacy")))+`</legacy>
+</com.cloudbees.plugins.credentials.SystemCredentialsProvider>`))
+	writeFile(t, filepath.Join(home, "jobs", "team", "config.xml"), []byte("<folder/>"))
+	writeFile(t, filepath.Join(home, "jobs", "team", "jobs", "deploy", "config.xml"), []byte(`<flow-definition>
+  <script>sh 'deploy --token from-script'</script>
+</flow-definition>`))
+	writeFile(t, filepath.Join(home, "jobs", "team", "jobs", "deploy", "builds", "3", "build.xml"), []byte(`<run>
+  <value>`+encryptSecret(t, key, "from-parameter")+`</value>
+</run>`))
+	writeFile(t, filepath.Join(home, "jobs", "team", "jobs", "deploy", "builds", "3", "log"), []byte("+ deploy --token from-log\n"))
+	require.NoError(t, os.Symlink("3", filepath.Join(home, "jobs", "team", "jobs", "deploy", "builds", "lastSuccessfulBuild")))
+
+	// Jenkins before 1.597 named build directories by timestamp, and linked
+	// to them by number.
+	legacyBuilds := filepath.Join(home, "jobs", "legacy", "builds")
+	writeFile(t, filepath.Join(legacyBuilds, "2014-01-02_03-04-05", "log"), []byte("+ deploy --token from-legacy-log\n"))
+	require.NoError(t, os.Symlink("2014-01-02_03-04-05", filepath.Join(legacyBuilds, "5")))
+	require.NoError(t, os.Symlink("5", filepath.Join(legacyBuilds, "lastSuccessfulBuild")))
+	writeFile(t, filepath.Join(legacyBuilds, "2013-12-31_23-59-59", "log"), []byte("+ deploy --token from-unlinked-log\n"))
+
+	writeFile(t, filepath.Join(home, "users", "admin_2840493850", "config.xml"), []byte(`<user>
+  <apiToken>`+encryptSecret(t, key, "from-user")+`</apiToken>
+</user>`))
+	writeFile(t, filepath.Join(home, "nodes", "agent-1", "config.xml"), []byte(`<slave>
+  <launcher><password>`+encryptSecret(t, key, "from-node")+`</password></launcher>
+</slave>`))
+	return home
+}
+
+// scanHome returns the data of the chunks of the JENKINS_HOME by file.
+func scanHome(t *testing.T, home string) map[string]string {
+	t.Helper()
+
+	conn, err := anypb.New(&sourcespb.Jenkins{Home: home})
+	require.NoError(t, err)
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test - jenkins", 0, 0, false, conn, 1))
+
+	chunksChan := make(chan *sources.Chunk, 64)
+	require.NoError(t, s.Chunks(context.Background(), chunksChan))
+	close(chunksChan)
+
+	got := map[string]string{}
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetJenkins()
+		got[meta.GetFile()] += string(chunk.Data)
+		switch meta.GetFile() {
+		case "jobs/team/jobs/deploy/builds/3/log":
+			assert.Equal(t, "team/deploy", meta.GetProjectName())
+			assert.Equal(t, int64(3), meta.GetBuildNumber())
+		case "jobs/legacy/builds/5/log":
+			assert.Equal(t, "legacy", meta.GetProjectName())
+			assert.Equal(t, int64(5), meta.GetBuildNumber())
+		case "jobs/legacy/builds/2013-12-31_23-59-59/log":
+			assert.Equal(t, int64(0), meta.GetBuildNumber())
+		}
+	}
+	return got
+}
+
+func TestSource_ChunksHome(t *testing.T) {
+	got := scanHome(t, newTestHome(t, true))
+
+	assert.Len(t, got, 9)
+	assert.Contains(t, got["credentials.xml"], "<password>from-credentials</password>")
+	assert.Contains(t, got["credentials.xml"], "<legacy>from-legacy</legacy>")
+	assert.Contains(t, got["jobs/team/config.xml"], "<folder/>")
+	assert.Contains(t, got["jobs/team/jobs/deploy/config.xml"], "from-script")
+	assert.Contains(t, got["jobs/team/jobs/deploy/builds/3/build.xml"], "<value>from-parameter</value>")
+	assert.Contains(t, got["jobs/team/jobs/deploy/builds/3/log"], "from-log")
+	assert.Contains(t, got["jobs/legacy/builds/5/log"], "from-legacy-log")
+	assert.Contains(t, got["jobs/legacy/builds/2013-12-31_23-59-59/log"], "from-unlinked-log")
+	assert.Contains(t, got["users/admin_2840493850/config.xml"], "<apiToken>from-user</apiToken>")
+	assert.Contains(t, got["nodes/agent-1/config.xml"], "<password>from-node</password>")
+}
+
+func TestSource_ChunksHomeWithoutKeys(t *testing.T) {
+	got := scanHome(t, newTestHome(t, false))
+
+	assert.Contains(t, got["credentials.xml"], "<password>{AQAAAB")
+	assert.Contains(t, got["jobs/team/jobs/deploy/builds/3/log"], "from-log")
+}
@@ -27,6 +27,16 @@ const (
 	SourceType = sourcespb.SourceType_SOURCE_TYPE_JENKINS
 )
 
+// Kinds of content, which the metadata of chunks tells apart.
+const (
+	kindLog         = "log"
+	kindConfig      = "config"
+	kindScript      = "script"
+	kindParameters  = "parameters"
+	kindEnvironment = "environment"
+	kindBuild       = "build"
+)
+
 type Source struct {
 	name     string
 	sourceId sources.SourceID
@@ -38,6 +48,13 @@ type Source struct {
 	header   *header
 	log      logr.Logger
 	client   *http.Client
+	// home is the local JENKINS_HOME to scan instead of the API.
+	home    string
+	secrets *secretDecrypter
+	// includeConfigs and includeBuildParameters enable scanning more than
+	// the console output of builds.
+	includeConfigs         bool
+	includeBuildParameters bool
 	sources.Progress
 }
 
@@ -78,6 +95,13 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
 	}
 
+	s.includeConfigs = conn.GetIncludeConfigs()
+	s.includeBuildParameters = conn.GetIncludeBuildParameters()
+
+	if home := conn.GetHome(); home != "" {
+		return s.initHome(home)
+	}
+
 	// Initialize the Jenkins client with a custom HTTP client.
 	var opts []func(*roundtripper.RoundTripper)
 
@@ -200,6 +224,8 @@ func (s *Source) RecursivelyGetJenkinsObjectsForPath(ctx context.Context, absolu
 			if err != nil {
 				return jobs, err
 			}
+			jobs.Folders = append(jobs.Folders, job)
+			jobs.Folders = append(jobs.Folders, objects.Folders...)
 			jobs.Jobs = append(jobs.Jobs, objects.Jobs...)
 		} else {
 			if job.Class == "hudson.model.FreeStyleProject" ||
@@ -292,11 +318,24 @@ func (s *Source) GetJenkinsBuilds(ctx context.Context, jobAbsolutePath string) (
 
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	if s.home != "" {
+		return s.chunkHome(ctx, chunksChan)
+	}
+
 	jobs, err := s.GetJenkinsJobs(ctx)
 	if err != nil {
 		return errors.WrapPrefix(err, "Failed to get Jenkins job response", 0)
 	}
 
+	if s.includeConfigs {
+		for _, folder := range jobs.Folders {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.chunkConfig(ctx, folder, chunksChan)
+		}
+	}
+
 	for i, project := range jobs.Jobs {
 		if common.IsDone(ctx) {
 			return nil
@@ -312,18 +351,30 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 		projectURL := *s.url
 		projectURL.Path = parsedUrl.Path
 
+		if s.includeConfigs {
+			s.chunkConfig(ctx, project, chunksChan)
+		}
+
 		builds, err := s.GetJenkinsBuilds(ctx, projectURL.Path)
 		if err != nil {
 			s.log.Error(err, "Failed to get Jenkins build response, skipping project", "project", project.Name)
 			continue
 		}
 
+		// Scripts are usually the same across builds.
+		seenScripts := map[[32]byte]struct{}{}
 		for _, build := range builds.Builds {
 			if common.IsDone(ctx) {
 				return nil
 			}
 
 			s.chunkBuild(ctx, build, project.Name, chunksChan)
+			if s.includeConfigs {
+				s.chunkBuildScripts(ctx, build, project.Name, seenScripts, chunksChan)
+			}
+			if s.includeBuildParameters {
+				s.chunkBuildParameters(ctx, build, project.Name, chunksChan)
+			}
 		}
 	}
 
@@ -383,6 +434,7 @@ func (s *Source) chunkBuild(_ context.Context, build JenkinsBuild, projectName s
 					ProjectName: projectName,
 					BuildNumber: build.Number,
 					Link:        buildLogURL.String(),
+					Kind:        kindLog,
 				},
 			},
 		},
@@ -392,11 +444,16 @@ func (s *Source) chunkBuild(_ context.Context, build JenkinsBuild, projectName s
 }
 
 type JenkinsJobResponse struct {
-	Jobs []struct {
-		Class string `json:"_class"`
-		Name  string `json:"name"`
-		Url   string `json:"url"`
-	} `json:"jobs"`
+	Jobs []JenkinsJob `json:"jobs"`
+	// Folders is filled by RecursivelyGetJenkinsObjectsForPath with the
+	// folders it traversed.
+	Folders []JenkinsJob `json:"-"`
+}
+
+type JenkinsJob struct {
+	Class string `json:"_class"`
+	Name  string `json:"name"`
+	Url   string `json:"url"`
 }
 
 type JenkinsBuildResponse struct {
@@ -0,0 +1,168 @@
+package jenkins
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// secretMagic ends the plaintext of values encrypted with AES/ECB, which is
+// how Jenkins tells a successful decryption apart.
+const secretMagic = "::::MAGIC::::"
+
+// secretPayloadV1 is the version byte of values encrypted with AES/CBC.
+const secretPayloadV1 = 1
+
+var (
+	// encryptedSecretPat matches values of hudson.util.Secret in the current
+	// format, such as {AQAAABAAAAAQ...}.
+	encryptedSecretPat = regexp.MustCompile(`\{AQAAAB[0-9A-Za-z+/]+={0,2}\}`)
+	// legacySecretPat matches XML element text that could be a value of
+	// hudson.util.Secret in the format used before Jenkins 2.x.
+	legacySecretPat = regexp.MustCompile(`>([0-9A-Za-z+/]{24,}={0,2})<`)
+)
+
+// secretDecrypter decrypts the values of hudson.util.Secret stored in the
+// configuration files of a JENKINS_HOME.
+type secretDecrypter struct {
+	// key is the hudson.util.Secret confidential key.
+	key []byte
+	// legacyKey is derived from secret.key, which encrypted values before the
+	// confidential store existed. It may be nil.
+	legacyKey []byte
+}
+
+// loadSecretDecrypter reads the keys of the JENKINS_HOME at home. The
+// hudson.util.Secret key is encrypted with secrets/master.key.
+func loadSecretDecrypter(home string) (*secretDecrypter, error) {
+	masterKey, err := os.ReadFile(filepath.Join(home, "secrets", "master.key"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read master key: %w", err)
+	}
+	encryptedKey, err := os.ReadFile(filepath.Join(home, "secrets", "hudson.util.Secret"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read hudson.util.Secret key: %w", err)
+	}
+	key, ok := decryptECB(aes128Key(masterKey), encryptedKey)
+	if !ok || len(key) < aes.BlockSize {
+		return nil, fmt.Errorf("could not decrypt hudson.util.Secret key with the master key")
+	}
+
+	d := &secretDecrypter{key: key[:aes.BlockSize]}
+	if legacyKey, err := os.ReadFile(filepath.Join(home, "secret.key")); err == nil {
+		d.legacyKey = aes128Key(legacyKey)
+	}
+	return d, nil
+}
+
+// decryptAll returns data with every encrypted value it contains replaced by
+// its plaintext. Values that fail to decrypt are left as they are.
+func (d *secretDecrypter) decryptAll(data []byte) []byte {
+	data = encryptedSecretPat.ReplaceAllFunc(data, func(match []byte) []byte {
+		if plaintext, ok := d.decrypt(match[1 : len(match)-1]); ok {
+			return plaintext
+		}
+		return match
+	})
+	return legacySecretPat.ReplaceAllFunc(data, func(match []byte) []byte {
+		plaintext, ok := d.decryptLegacy(match[1 : len(match)-1])
+		if !ok {
+			return match
+		}
+		return append(append([]byte(">"), plaintext...), '<')
+	})
+}
+
+// decrypt decrypts a value in the current format, without its braces: the
+// base64 encoding of a version byte, the length of the IV, the length of the
+// ciphertext, the IV and the AES/CBC ciphertext.
+func (d *secretDecrypter) decrypt(value []byte) ([]byte, bool) {
+	payload, err := base64.StdEncoding.DecodeString(string(value))
+	if err != nil || len(payload) < 9 || payload[0] != secretPayloadV1 {
+		return nil, false
+	}
+	ivLen := int(binary.BigEndian.Uint32(payload[1:5]))
+	dataLen := int(binary.BigEndian.Uint32(payload[5:9]))
+	if ivLen != aes.BlockSize || dataLen == 0 || dataLen%aes.BlockSize != 0 || len(payload) != 9+ivLen+dataLen {
+		return nil, false
+	}
+	iv, ciphertext := payload[9:9+ivLen], payload[9+ivLen:]
+
+	block, err := aes.NewCipher(d.key)
+	if err != nil {
+		return nil, false
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+	return unpad(plaintext)
+}
+
+// decryptLegacy decrypts a value in the legacy format: the base64 encoding of
+// the AES/ECB ciphertext of the value followed by secretMagic.
+func (d *secretDecrypter) decryptLegacy(value []byte) ([]byte, bool) {
+	ciphertext, err := base64.StdEncoding.DecodeString(string(value))
+	if err != nil || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, false
+	}
+	for _, key := range [][]byte{d.key, d.legacyKey} {
+		if key == nil {
+			continue
+		}
+		if plaintext, ok := decryptECB(key, ciphertext); ok {
+			return plaintext, true
+		}
+	}
+	return nil, false
+}
+
+// aes128Key derives an AES key from a key file the way Jenkins does: the
+// first 128 bits of the SHA-256 digest of its trimmed contents.
+func aes128Key(data []byte) []byte {
+	digest := sha256.Sum256(bytes.TrimSpace(data))
+	return digest[:aes.BlockSize]
+}
+
+// decryptECB decrypts AES/ECB/PKCS5Padding ciphertext, and reports whether
+// the plaintext ended with secretMagic, which it strips.
+func decryptECB(key, ciphertext []byte) ([]byte, bool) {
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, false
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, false
+	}
+	plaintext := make([]byte, len(ciphertext))
+	for i := 0; i < len(ciphertext); i += aes.BlockSize {
+		block.Decrypt(plaintext[i:i+aes.BlockSize], ciphertext[i:i+aes.BlockSize])
+	}
+	plaintext, ok := unpad(plaintext)
+	if !ok || !bytes.HasSuffix(plaintext, []byte(secretMagic)) {
+		return nil, false
+	}
+	return plaintext[:len(plaintext)-len(secretMagic)], true
+}
+
+// unpad removes PKCS #5 padding.
+func unpad(data []byte) ([]byte, bool) {
+	if len(data) == 0 {
+		return nil, false
+	}
+	n := int(data[len(data)-1])
+	if n == 0 || n > aes.BlockSize || n > len(data) {
+		return nil, false
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, false
+		}
+	}
+	return data[:len(data)-n], true
+}
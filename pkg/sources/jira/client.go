@@ -0,0 +1,322 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	pageLimit = 50
+
+	customFieldPrefix = "customfield_"
+)
+
+type user struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type comment struct {
+	ID      string   `json:"id"`
+	Author  user     `json:"author"`
+	Body    richText `json:"body"`
+	Created string   `json:"created"`
+	Updated string   `json:"updated"`
+}
+
+type commentPage struct {
+	Comments   []comment `json:"comments"`
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+}
+
+type attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Author   user   `json:"author"`
+	Created  string `json:"created"`
+	Size     int64  `json:"size"`
+	Content  string `json:"content"`
+}
+
+type changelogItem struct {
+	Field      string `json:"field"`
+	FromString string `json:"fromString"`
+	ToString   string `json:"toString"`
+}
+
+type history struct {
+	ID      string          `json:"id"`
+	Author  user            `json:"author"`
+	Created string          `json:"created"`
+	Items   []changelogItem `json:"items"`
+}
+
+// fields are the issue fields scanned by the source. Custom fields are decoded
+// separately, as their IDs differ between instances.
+type fields struct {
+	Summary     string       `json:"summary"`
+	Description richText     `json:"description"`
+	Reporter    user         `json:"reporter"`
+	Created     string       `json:"created"`
+	Updated     string       `json:"updated"`
+	Comment     commentPage  `json:"comment"`
+	Attachment  []attachment `json:"attachment"`
+}
+
+type issue struct {
+	ID        string `json:"id"`
+	Key       string `json:"key"`
+	Fields    fields `json:"fields"`
+	Changelog struct {
+		Histories []history `json:"histories"`
+	} `json:"changelog"`
+
+	// CustomFields holds the raw value of every custom field, by field ID.
+	CustomFields map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an issue along with its custom fields.
+func (i *issue) UnmarshalJSON(data []byte) error {
+	type plain issue
+	if err := json.Unmarshal(data, (*plain)(i)); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	i.CustomFields = make(map[string]json.RawMessage)
+	for id, value := range raw.Fields {
+		if strings.HasPrefix(id, customFieldPrefix) {
+			i.CustomFields[id] = value
+		}
+	}
+	return nil
+}
+
+// richText is a rich text field, which version 2 of the API returns as a wiki
+// markup string and version 3 as an Atlassian Document Format (ADF) document.
+// Documents are flattened to their text, with a line per block.
+type richText string
+
+// adfNode is a node of an ADF document. Only the attributes holding text are
+// decoded.
+type adfNode struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	Attrs struct {
+		Text string `json:"text"`
+		URL  string `json:"url"`
+	} `json:"attrs"`
+	Content []adfNode `json:"content"`
+}
+
+// UnmarshalJSON decodes a wiki markup string or an ADF document. Other values
+// are rejected, so that fields holding numbers or options can be told apart.
+func (t *richText) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*t = richText(text)
+		return nil
+	}
+
+	var doc adfNode
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.Type != "doc" {
+		return fmt.Errorf("not a rich text document")
+	}
+	var b strings.Builder
+	doc.writeText(&b)
+	*t = richText(b.String())
+	return nil
+}
+
+func (n adfNode) writeText(b *strings.Builder) {
+	switch n.Type {
+	case "text":
+		b.WriteString(n.Text)
+	case "hardBreak":
+		b.WriteByte('\n')
+	case "mention", "emoji":
+		b.WriteString(n.Attrs.Text)
+	case "inlineCard", "blockCard", "embedCard":
+		b.WriteString(n.Attrs.URL)
+	}
+	for _, child := range n.Content {
+		child.writeText(b)
+	}
+	// Nodes with content are blocks: paragraphs, list items, table cells...
+	if len(n.Content) > 0 && b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+		b.WriteByte('\n')
+	}
+}
+
+type searchResponse struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []issue `json:"issues"`
+}
+
+// jqlSearchResponse is a page of the enhanced search of Jira Cloud, which
+// doesn't count the matching issues.
+type jqlSearchResponse struct {
+	Issues        []issue `json:"issues"`
+	NextPageToken string  `json:"nextPageToken"`
+	IsLast        bool    `json:"isLast"`
+}
+
+type serverInfo struct {
+	DeploymentType string `json:"deploymentType"`
+}
+
+// client is a minimal Jira REST API client. It uses version 2 of the API,
+// which is supported by both Jira Cloud and Jira Data Center, except for
+// searches on Jira Cloud, which are only paged by token in the enhanced
+// search of version 3.
+type client struct {
+	baseURL    string
+	username   string
+	password   string
+	token      string
+	httpClient *http.Client
+}
+
+func (c *client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "" || c.password != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+	return resp, nil
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Jira API response: %w", err)
+	}
+	return nil
+}
+
+// serverInfo returns the deployment type and version of the Jira instance.
+func (c *client) serverInfo(ctx context.Context) (serverInfo, error) {
+	var info serverInfo
+	err := c.get(ctx, c.baseURL+"/rest/api/2/serverInfo", &info)
+	return info, err
+}
+
+// search returns a page of the issues matching the JQL query, starting at
+// startAt, with all of their fields and their changelog. Jira Cloud no longer
+// supports this endpoint; see searchJQL.
+func (c *client) search(ctx context.Context, jql string, startAt int) (searchResponse, error) {
+	query := url.Values{
+		"jql":        {jql},
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(pageLimit)},
+		"fields":     {"*all"},
+		"expand":     {"changelog"},
+	}
+
+	var resp searchResponse
+	err := c.get(ctx, c.baseURL+"/rest/api/2/search?"+query.Encode(), &resp)
+	return resp, err
+}
+
+// searchJQL returns a page of the issues matching the JQL query, with all of
+// their fields and their changelog, using the enhanced search of Jira Cloud.
+// The first page is requested with an empty nextPageToken.
+func (c *client) searchJQL(ctx context.Context, jql, nextPageToken string) (jqlSearchResponse, error) {
+	query := url.Values{
+		"jql":        {jql},
+		"maxResults": {strconv.Itoa(pageLimit)},
+		"fields":     {"*all"},
+		"expand":     {"changelog"},
+	}
+	if nextPageToken != "" {
+		query.Set("nextPageToken", nextPageToken)
+	}
+
+	var resp jqlSearchResponse
+	err := c.get(ctx, c.baseURL+"/rest/api/3/search/jql?"+query.Encode(), &resp)
+	return resp, err
+}
+
+// listComments returns the comments of an issue, starting at startAt. The
+// search response only embeds the first page of comments.
+func (c *client) listComments(ctx context.Context, key string, startAt int) ([]comment, error) {
+	var comments []comment
+	for {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(pageLimit)},
+		}
+		var page commentPage
+		if err := c.get(ctx, c.baseURL+"/rest/api/2/issue/"+url.PathEscape(key)+"/comment?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+		comments = append(comments, page.Comments...)
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+// download returns the contents of an attachment. The caller is responsible
+// for closing the returned reader. Credentials are only ever sent to the Jira
+// instance, so attachment URLs pointing elsewhere are rejected.
+func (c *client) download(ctx context.Context, contentURL string) (io.ReadCloser, error) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(contentURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != base.Scheme || u.Host != base.Host {
+		return nil, fmt.Errorf("attachment URL %q is not on the Jira instance", contentURL)
+	}
+
+	resp, err := c.do(ctx, contentURL)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,512 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_JIRA
+
+	defaultMaxAttachmentSize = 250 * 1024 * 1024 // 250 MiB
+
+	// timeFormat is the format of the timestamps returned by the Jira API.
+	timeFormat = "2006-01-02T15:04:05.000-0700"
+
+	// Locations of the scanned data within an issue. Custom fields use their
+	// field ID as location.
+	locationSummary     = "summary"
+	locationDescription = "description"
+	locationComment     = "comment"
+	locationChangelog   = "changelog"
+	locationAttachment  = "attachment"
+)
+
+// orderByPattern matches the ORDER BY clause of a JQL query, which is replaced
+// so that issues are scanned in the order they were last updated.
+var orderByPattern = regexp.MustCompile(`(?i)\s*\border\s+by\b.*$`)
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint          string
+	jql               string
+	projects          []string
+	ignoreProjects    []string
+	skipAttachments   bool
+	maxAttachmentSize int64
+	client            *client
+
+	jobPool *errgroup.Group
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Jira source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.JIRA
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	endpoint, err := url.Parse(conn.GetEndpoint())
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return fmt.Errorf("invalid Jira endpoint %q", conn.GetEndpoint())
+	}
+	s.endpoint = strings.TrimRight(endpoint.String(), "/")
+
+	// Jira Cloud rate limits API requests and reports the time to wait in the
+	// Retry-After header of 429 responses, which the retryable round tripper
+	// honors.
+	opts := []func(*roundtripper.RoundTripper){
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithLogging(),
+		roundtripper.WithRetryable(
+			roundtripper.WithShouldRetry5XXDuration(10*time.Second),
+			roundtripper.WithMaxRetries(5),
+		),
+	}
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	s.client = &client{
+		baseURL:    s.endpoint,
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.JIRA_BasicAuth:
+		// Jira Cloud expects the account email and an API token.
+		s.client.username = cred.BasicAuth.GetUsername()
+		s.client.password = cred.BasicAuth.GetPassword()
+	case *sourcespb.JIRA_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		s.client.token = cred.Token
+	case *sourcespb.JIRA_Oauth:
+		if cred.Oauth.GetAccessToken() == "" {
+			return fmt.Errorf("oauth access token is empty")
+		}
+		s.client.token = cred.Oauth.GetAccessToken()
+	case *sourcespb.JIRA_Unauthenticated:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	s.jql = strings.TrimSpace(orderByPattern.ReplaceAllString(conn.GetJql(), ""))
+	s.projects = conn.GetProjects()
+	s.ignoreProjects = conn.GetIgnoreProjects()
+	s.skipAttachments = conn.GetSkipAttachments()
+	s.setMaxAttachmentSize(conn.GetMaxAttachmentSize())
+
+	return nil
+}
+
+// setMaxAttachmentSize sets the maximum size of attachments that will be
+// scanned. If not set or set to a non-positive number,
+// defaultMaxAttachmentSize is used.
+func (s *Source) setMaxAttachmentSize(maxAttachmentSize int64) {
+	if maxAttachmentSize <= 0 {
+		s.maxAttachmentSize = defaultMaxAttachmentSize
+		return
+	}
+	s.maxAttachmentSize = maxAttachmentSize
+}
+
+// Chunks emits chunks of bytes over a channel.
+//
+// Issues are scanned in the order they were last updated. After each page of
+// issues, the update time of its last issue is saved as resume information,
+// so a resumed scan only queries the issues updated since.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var since time.Time
+	if resumeInfo := s.GetProgress().EncodedResumeInfo; resumeInfo != "" {
+		t, err := time.Parse(time.RFC3339, resumeInfo)
+		if err != nil {
+			ctx.Logger().Error(err, "invalid resume information, scanning all issues", "resume_info", resumeInfo)
+		} else {
+			since = t
+		}
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	scanErrs := sources.NewScanErrors()
+	var scanned int
+	scanPage := func(issues []issue) {
+		for _, is := range issues {
+			is := is
+			s.jobPool.Go(func() error {
+				if common.IsDone(ctx) {
+					return nil
+				}
+				if err := s.scanIssue(ctx, is, reporter); err != nil {
+					scanErrs.Add(err)
+				}
+				return nil
+			})
+		}
+		_ = s.jobPool.Wait()
+
+		scanned += len(issues)
+		if len(issues) > 0 {
+			resumeInfo := s.GetProgress().EncodedResumeInfo
+			if last, err := time.Parse(timeFormat, issues[len(issues)-1].Fields.Updated); err == nil {
+				resumeInfo = last.Format(time.RFC3339)
+			}
+			s.SetProgressOngoing(fmt.Sprintf("Scanned %d issues", scanned), resumeInfo)
+		}
+	}
+
+	var err error
+	if s.isCloud(ctx) {
+		err = s.searchCloud(ctx, s.buildJQL(since, time.Now()), scanPage)
+	} else {
+		err = s.searchServer(ctx, since, scanPage)
+	}
+	if err != nil {
+		return err
+	}
+
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(scanned, scanned, "Completed Jira scan", "")
+
+	return nil
+}
+
+// isCloud reports whether the endpoint is a Jira Cloud site. The deployment
+// type is read from the server info, or guessed from the host name if the
+// server info can't be read.
+func (s *Source) isCloud(ctx context.Context) bool {
+	info, err := s.client.serverInfo(ctx)
+	if err != nil {
+		ctx.Logger().V(2).Info("could not read the server info", "error", err)
+		u, err := url.Parse(s.endpoint)
+		return err == nil && strings.HasSuffix(u.Hostname(), ".atlassian.net")
+	}
+	return info.DeploymentType == "Cloud"
+}
+
+// searchCloud calls scanPage with every page of the issues matching the JQL
+// query, following the next page tokens of the enhanced search.
+func (s *Source) searchCloud(ctx context.Context, jql string, scanPage func([]issue)) error {
+	ctx.Logger().V(2).Info("searching issues", "jql", jql)
+
+	var nextPageToken string
+	for {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		page, err := s.client.searchJQL(ctx, jql, nextPageToken)
+		if err != nil {
+			return fmt.Errorf("could not search issues: %w", err)
+		}
+		scanPage(page.Issues)
+
+		if page.IsLast || page.NextPageToken == "" || len(page.Issues) == 0 {
+			return nil
+		}
+		nextPageToken = page.NextPageToken
+	}
+}
+
+// searchServer calls scanPage with every page of the issues updated since the
+// given time on Jira Server and Data Center. Rather than by offset, which gets
+// slow deep into large searches and shifts as issues are updated, each page is
+// queried from the update time of the last issue of the previous page.
+//
+// JQL compares update times to the minute, so the issues updated in the same
+// minutes as that last issue are queried again, and are skipped by key. Should
+// a whole page fall in those minutes, the query is paged by offset until it
+// moves past them.
+func (s *Source) searchServer(ctx context.Context, since time.Time, scanPage func([]issue)) error {
+	jql := s.buildJQL(since, time.Now())
+	ctx.Logger().V(2).Info("searching issues", "jql", jql)
+
+	// seen holds the update time of the scanned issues that a query from the
+	// last update time may return again.
+	seen := make(map[string]time.Time)
+	var startAt int
+	for {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		page, err := s.client.search(ctx, jql, startAt)
+		if err != nil {
+			return fmt.Errorf("could not search issues: %w", err)
+		}
+
+		issues := make([]issue, 0, len(page.Issues))
+		for _, is := range page.Issues {
+			if _, ok := seen[is.Key]; !ok {
+				issues = append(issues, is)
+			}
+		}
+		scanPage(issues)
+
+		if len(page.Issues) == 0 || startAt+len(page.Issues) >= page.Total {
+			return nil
+		}
+
+		last, err := time.Parse(timeFormat, page.Issues[len(page.Issues)-1].Fields.Updated)
+		if err != nil || len(issues) == 0 {
+			startAt += len(page.Issues)
+			continue
+		}
+		for _, is := range issues {
+			if updated, err := time.Parse(timeFormat, is.Fields.Updated); err == nil {
+				seen[is.Key] = updated
+			}
+		}
+		for key, updated := range seen {
+			if updated.Before(last.Add(-2 * time.Minute)) {
+				delete(seen, key)
+			}
+		}
+		jql = s.buildJQL(last, time.Now())
+		startAt = 0
+	}
+}
+
+// buildJQL combines the configured query and project filters, and restricts
+// the search to the issues updated since the given time, if any. The update
+// time is expressed relative to now, as absolute dates in JQL are interpreted
+// in the time zone of the user.
+func (s *Source) buildJQL(since, now time.Time) string {
+	var clauses []string
+	if s.jql != "" {
+		clauses = append(clauses, "("+s.jql+")")
+	}
+	if len(s.projects) > 0 {
+		clauses = append(clauses, "project in ("+quoteAll(s.projects)+")")
+	}
+	if len(s.ignoreProjects) > 0 {
+		clauses = append(clauses, "project not in ("+quoteAll(s.ignoreProjects)+")")
+	}
+	if !since.IsZero() {
+		// Round up, so the last scanned issue is included rather than missed.
+		minutes := int(now.Sub(since).Minutes()) + 1
+		clauses = append(clauses, fmt.Sprintf("updated >= -%dm", minutes))
+	}
+
+	jql := strings.Join(clauses, " AND ")
+	if jql != "" {
+		jql += " "
+	}
+	return jql + "ORDER BY updated ASC, key ASC"
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// scanIssue scans the fields, comments, changelog and attachments of an issue.
+func (s *Source) scanIssue(ctx context.Context, is issue, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "issue", is.Key)
+	ctx.Logger().V(3).Info("scanning issue")
+
+	link := s.endpoint + "/browse/" + url.PathEscape(is.Key)
+	reportField := func(location, data string, author user, timestamp, link string) error {
+		return s.reportData(ctx, data, &source_metadatapb.Jira{
+			Issue:     sanitizer.UTF8(is.Key),
+			Author:    sanitizer.UTF8(author.DisplayName),
+			Email:     sanitizer.UTF8(author.EmailAddress),
+			Link:      link,
+			Location:  location,
+			Timestamp: timestamp,
+		}, reporter)
+	}
+
+	f := is.Fields
+	if err := reportField(locationSummary, f.Summary, f.Reporter, f.Created, link); err != nil {
+		return err
+	}
+	if err := reportField(locationDescription, string(f.Description), f.Reporter, f.Updated, link); err != nil {
+		return err
+	}
+
+	customFields := make([]string, 0, len(is.CustomFields))
+	for id := range is.CustomFields {
+		customFields = append(customFields, id)
+	}
+	sort.Strings(customFields)
+	for _, id := range customFields {
+		// Only text fields are scanned, including rich text. Other custom
+		// fields hold numbers, dates, or options chosen from a fixed list.
+		var text richText
+		if err := json.Unmarshal(is.CustomFields[id], &text); err != nil {
+			continue
+		}
+		if err := reportField(id, string(text), f.Reporter, f.Updated, link); err != nil {
+			return err
+		}
+	}
+
+	comments := f.Comment.Comments
+	if len(comments) < f.Comment.Total {
+		more, err := s.client.listComments(ctx, is.Key, len(comments))
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not list comments of %s: %w", is.Key, err)); err != nil {
+				return err
+			}
+		}
+		comments = append(comments, more...)
+	}
+	for _, c := range comments {
+		commentLink := link + "?" + url.Values{"focusedCommentId": {c.ID}}.Encode()
+		if err := reportField(locationComment, string(c.Body), c.Author, c.Updated, commentLink); err != nil {
+			return err
+		}
+	}
+
+	for _, h := range is.Changelog.Histories {
+		var text strings.Builder
+		for _, item := range h.Items {
+			if item.FromString == "" && item.ToString == "" {
+				continue
+			}
+			fmt.Fprintf(&text, "%s: %s -> %s\n", item.Field, item.FromString, item.ToString)
+		}
+		if err := reportField(locationChangelog, text.String(), h.Author, h.Created, link); err != nil {
+			return err
+		}
+	}
+
+	if s.skipAttachments {
+		return nil
+	}
+	for _, a := range f.Attachment {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if a.Size > s.maxAttachmentSize {
+			ctx.Logger().V(3).Info("skipping attachment over the size limit", "file", a.Filename, "size", a.Size)
+			continue
+		}
+		if err := s.scanAttachment(ctx, is, a, link, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanAttachment(ctx context.Context, is issue, a attachment, link string, reporter sources.ChunkReporter) error {
+	ctx.Logger().V(3).Info("scanning attachment", "file", a.Filename)
+
+	body, err := s.client.download(ctx, a.Content)
+	if err != nil {
+		return fmt.Errorf("could not download attachment %q of %s: %w", a.Filename, is.Key, err)
+	}
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Jira{
+				Jira: &source_metadatapb.Jira{
+					Issue:     sanitizer.UTF8(is.Key),
+					Author:    sanitizer.UTF8(a.Author.DisplayName),
+					Email:     sanitizer.UTF8(a.Author.EmailAddress),
+					Link:      link,
+					Location:  locationAttachment,
+					Timestamp: a.Created,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	if err := handlers.HandleFile(ctx, body, chunkSkel, reporter); err != nil {
+		return fmt.Errorf("could not scan attachment %q of %s: %w", a.Filename, is.Key, err)
+	}
+	return nil
+}
+
+func (s *Source) reportData(ctx context.Context, data string, metadata *source_metadatapb.Jira, reporter sources.ChunkReporter) error {
+	if strings.TrimSpace(data) == "" {
+		return nil
+	}
+
+	chunkReader := sources.NewChunkReader()
+	for chunkData := range chunkReader(ctx, strings.NewReader(data)) {
+		if err := chunkData.Error(); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+			continue
+		}
+		chunk := sources.Chunk{
+			SourceType: s.Type(),
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			JobID:      s.JobID(),
+			Data:       chunkData.Bytes(),
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Jira{Jira: metadata},
+			},
+			Verify: s.verify,
+		}
+		if err := reporter.ChunkOk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
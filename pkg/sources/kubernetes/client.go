@@ -0,0 +1,229 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// pageLimit is the number of objects requested per page.
+const pageLimit = 500
+
+type objectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace"`
+	Annotations     map[string]string `json:"annotations"`
+	OwnerReferences []struct {
+		Kind       string `json:"kind"`
+		Controller bool   `json:"controller"`
+	} `json:"ownerReferences"`
+}
+
+type configMap struct {
+	Metadata   objectMeta        `json:"metadata"`
+	Data       map[string]string `json:"data"`
+	BinaryData map[string][]byte `json:"binaryData"`
+}
+
+type secret struct {
+	Metadata objectMeta `json:"metadata"`
+	Type     string     `json:"type"`
+	// The values of a secret are base64 encoded, which decoding into bytes
+	// undoes.
+	Data map[string][]byte `json:"data"`
+}
+
+type container struct {
+	Name    string   `json:"name"`
+	Command []string `json:"command"`
+	Args    []string `json:"args"`
+	Env     []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"env"`
+}
+
+type podSpec struct {
+	InitContainers []container `json:"initContainers"`
+	Containers     []container `json:"containers"`
+}
+
+type podTemplate struct {
+	Spec podSpec `json:"spec"`
+}
+
+// workload is a pod, or an object with a pod template.
+type workload struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		// Set for pods.
+		podSpec
+		// Set for deployments, stateful sets, daemon sets, replica sets and
+		// jobs.
+		Template *podTemplate `json:"template"`
+		// Set for cron jobs.
+		JobTemplate *struct {
+			Spec struct {
+				Template podTemplate `json:"template"`
+			} `json:"spec"`
+		} `json:"jobTemplate"`
+	} `json:"spec"`
+}
+
+// podSpec returns the pod spec of the workload, and its path in the object.
+func (w *workload) podSpec() (string, podSpec) {
+	switch {
+	case w.Spec.JobTemplate != nil:
+		return "spec.jobTemplate.spec.template.spec", w.Spec.JobTemplate.Spec.Template.Spec
+	case w.Spec.Template != nil:
+		return "spec.template.spec", w.Spec.Template.Spec
+	default:
+		return "spec", w.Spec.podSpec
+	}
+}
+
+type objectList[T any] struct {
+	Metadata struct {
+		Continue string `json:"continue"`
+	} `json:"metadata"`
+	Items []T `json:"items"`
+}
+
+// apiError is an error status returned by the API server.
+type apiError struct {
+	StatusCode int
+	Path       string
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("unexpected status code %d for %s: %s", e.StatusCode, e.Path, e.Message)
+}
+
+// isStatus reports whether err is an API error with the status code.
+func isStatus(err error, statusCode int) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// client is a minimal Kubernetes API client.
+type client struct {
+	cfg        *restConfig
+	transport  *http.Transport
+	httpClient *http.Client
+}
+
+func newClient(cfg *restConfig) *client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = cfg.tlsConfig
+	withTransport := func(c *retryablehttp.Client) {
+		c.HTTPClient.Transport = common.NewCustomTransport(transport)
+	}
+	return &client{
+		cfg:        cfg,
+		transport:  transport,
+		httpClient: common.RetryableHTTPClientTimeout(60, withTransport),
+	}
+}
+
+// get requests the given API path, and decodes the JSON response into target.
+// Expiring credentials that are rejected are renewed once.
+func (c *client) get(ctx context.Context, path string, query url.Values, target any) error {
+	err := c.getOnce(ctx, path, query, target)
+	if c.cfg.credentials != nil && isStatus(err, http.StatusUnauthorized) {
+		c.cfg.credentials.invalidate()
+		// Connections authenticated with the previous client certificate
+		// are not reused.
+		c.transport.CloseIdleConnections()
+		err = c.getOnce(ctx, path, query, target)
+	}
+	return err
+}
+
+func (c *client) getOnce(ctx context.Context, path string, query url.Values, target any) error {
+	reqURL := c.cfg.server + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes API request: %w", err)
+	}
+	token := c.cfg.token
+	if c.cfg.credentials != nil {
+		if token, err = c.cfg.credentials.get(ctx); err != nil {
+			return err
+		}
+	}
+	switch {
+	case token != "":
+		req.Header.Set("Authorization", "Bearer "+token)
+	case c.cfg.username != "":
+		req.SetBasicAuth(c.cfg.username, c.cfg.password)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request to Kubernetes API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Errors are described by a Status object.
+		var status struct {
+			Message string `json:"message"`
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(body, &status) != nil || status.Message == "" {
+			status.Message = string(body)
+		}
+		return &apiError{StatusCode: resp.StatusCode, Path: req.URL.Path, Message: status.Message}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Kubernetes API response: %w", err)
+	}
+	return nil
+}
+
+// list calls visit with every object of a collection, requesting it in pages.
+func list[T any](ctx context.Context, c *client, path string, visit func(*T) error) error {
+	query := url.Values{"limit": {strconv.Itoa(pageLimit)}}
+	for {
+		var page objectList[T]
+		if err := c.get(ctx, path, query, &page); err != nil {
+			return err
+		}
+		for i := range page.Items {
+			if err := visit(&page.Items[i]); err != nil {
+				return err
+			}
+		}
+		if page.Metadata.Continue == "" {
+			return nil
+		}
+		query.Set("continue", page.Metadata.Continue)
+	}
+}
+
+// listNamespaces returns the names of every namespace of the cluster.
+func (c *client) listNamespaces(ctx context.Context) ([]string, error) {
+	var names []string
+	err := list(ctx, c, "/api/v1/namespaces", func(ns *struct {
+		Metadata objectMeta `json:"metadata"`
+	}) error {
+		names = append(names, ns.Metadata.Name)
+		return nil
+	})
+	return names, err
+}
@@ -0,0 +1,365 @@
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// kubeconfig is the subset of a kubeconfig file needed to connect to a
+// cluster.
+type kubeconfig struct {
+	CurrentContext string `json:"current-context"`
+	Clusters       []struct {
+		Name    string  `json:"name"`
+		Cluster cluster `json:"cluster"`
+	} `json:"clusters"`
+	Users []struct {
+		Name string   `json:"name"`
+		User authInfo `json:"user"`
+	} `json:"users"`
+	Contexts []struct {
+		Name    string      `json:"name"`
+		Context kubeContext `json:"context"`
+	} `json:"contexts"`
+}
+
+type cluster struct {
+	Server                   string `json:"server"`
+	CertificateAuthority     string `json:"certificate-authority"`
+	CertificateAuthorityData []byte `json:"certificate-authority-data"`
+	InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify"`
+	TLSServerName            string `json:"tls-server-name"`
+}
+
+type authInfo struct {
+	Token                 string      `json:"token"`
+	TokenFile             string      `json:"tokenFile"`
+	ClientCertificate     string      `json:"client-certificate"`
+	ClientCertificateData []byte      `json:"client-certificate-data"`
+	ClientKey             string      `json:"client-key"`
+	ClientKeyData         []byte      `json:"client-key-data"`
+	Username              string      `json:"username"`
+	Password              string      `json:"password"`
+	Exec                  *execConfig `json:"exec"`
+	AuthProvider          *struct {
+		Name string `json:"name"`
+	} `json:"auth-provider"`
+}
+
+// execConfig configures a credential plugin, such as the one of EKS or GKE.
+type execConfig struct {
+	APIVersion string   `json:"apiVersion"`
+	Command    string   `json:"command"`
+	Args       []string `json:"args"`
+	Env        []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"env"`
+}
+
+type kubeContext struct {
+	Cluster   string `json:"cluster"`
+	User      string `json:"user"`
+	Namespace string `json:"namespace"`
+}
+
+// restConfig holds what is needed to make requests to the API server of a
+// cluster.
+type restConfig struct {
+	clusterName string
+	server      string
+	namespace   string
+	token       string
+	// credentials provides the token, and the client certificate, when they
+	// expire rather than being set in the kubeconfig.
+	credentials *credentialSource
+	username    string
+	password    string
+	tlsConfig   *tls.Config
+}
+
+// loadKubeconfig returns the configuration of the named context, or of the
+// current context when empty. Relative file paths are resolved against dir.
+func loadKubeconfig(ctx context.Context, data []byte, dir, contextName string) (*restConfig, error) {
+	var kc kubeconfig
+	if err := yaml.Unmarshal(data, &kc); err != nil {
+		return nil, fmt.Errorf("could not parse kubeconfig: %w", err)
+	}
+
+	if contextName == "" {
+		contextName = kc.CurrentContext
+	}
+	if contextName == "" {
+		return nil, fmt.Errorf("kubeconfig has no current context, and no context was given")
+	}
+	var kctx *kubeContext
+	for i := range kc.Contexts {
+		if kc.Contexts[i].Name == contextName {
+			kctx = &kc.Contexts[i].Context
+			break
+		}
+	}
+	if kctx == nil {
+		return nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+
+	var c *cluster
+	for i := range kc.Clusters {
+		if kc.Clusters[i].Name == kctx.Cluster {
+			c = &kc.Clusters[i].Cluster
+			break
+		}
+	}
+	if c == nil {
+		return nil, fmt.Errorf("cluster %q of context %q not found in kubeconfig", kctx.Cluster, contextName)
+	}
+	if c.Server == "" {
+		return nil, fmt.Errorf("cluster %q has no server", kctx.Cluster)
+	}
+
+	var user authInfo
+	for i := range kc.Users {
+		if kc.Users[i].Name == kctx.User {
+			user = kc.Users[i].User
+			break
+		}
+	}
+
+	cfg := &restConfig{
+		clusterName: kctx.Cluster,
+		server:      strings.TrimRight(c.Server, "/"),
+		namespace:   kctx.Namespace,
+		tlsConfig: &tls.Config{
+			InsecureSkipVerify: c.InsecureSkipTLSVerify,
+			ServerName:         c.TLSServerName,
+		},
+	}
+
+	caData, err := readData(c.CertificateAuthorityData, c.CertificateAuthority, dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read certificate authority: %w", err)
+	}
+	if len(caData) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("certificate authority of cluster %q has no valid certificate", kctx.Cluster)
+		}
+		cfg.tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case user.Exec != nil:
+		cfg.credentials = &credentialSource{exec: user.Exec}
+	case user.AuthProvider != nil:
+		return nil, fmt.Errorf("auth provider %q is not supported, use a credential plugin instead", user.AuthProvider.Name)
+	case user.Token == "" && user.TokenFile != "":
+		cfg.credentials = &credentialSource{tokenFile: resolvePath(user.TokenFile, dir)}
+	}
+	if cfg.credentials != nil {
+		// Fail early if the credentials can't be obtained.
+		if _, err := cfg.credentials.get(ctx); err != nil {
+			return nil, err
+		}
+		if cfg.credentials.cert != nil {
+			cfg.tlsConfig.GetClientCertificate = cfg.credentials.clientCertificate
+		}
+	}
+
+	cfg.token = user.Token
+	cfg.username, cfg.password = user.Username, user.Password
+
+	certData, err := readData(user.ClientCertificateData, user.ClientCertificate, dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read client certificate: %w", err)
+	}
+	keyData, err := readData(user.ClientKeyData, user.ClientKey, dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read client key: %w", err)
+	}
+	if len(certData) > 0 || len(keyData) > 0 {
+		cert, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		cfg.tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// execCredential is the token or client certificate returned by a credential
+// plugin, until it expires.
+type execCredential struct {
+	Status struct {
+		Token                 string    `json:"token"`
+		ClientCertificateData string    `json:"clientCertificateData"`
+		ClientKeyData         string    `json:"clientKeyData"`
+		ExpirationTimestamp   time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+}
+
+// run runs the credential plugin, and returns the credential it prints.
+func (e *execConfig) run(ctx context.Context) (*execCredential, error) {
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	cmd.Env = os.Environ()
+	for _, env := range e.Env {
+		cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+	}
+	info := fmt.Sprintf(`{"apiVersion": %q, "kind": "ExecCredential", "spec": {"interactive": false}}`, e.APIVersion)
+	cmd.Env = append(cmd.Env, "KUBERNETES_EXEC_INFO="+info)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("credential plugin %q failed: %w: %s", e.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var cred execCredential
+	if err := json.Unmarshal(out, &cred); err != nil {
+		return nil, fmt.Errorf("could not parse the output of credential plugin %q: %w", e.Command, err)
+	}
+	return &cred, nil
+}
+
+const (
+	// tokenFileTTL is how long the token of a token file is used before the
+	// file is read again, since tokens such as the projected token of a
+	// service account are rotated.
+	tokenFileTTL = time.Minute
+	// expiryDelta is how long before their expiry credentials are renewed.
+	expiryDelta = 10 * time.Second
+)
+
+// credentialSource provides the credentials of a credential plugin or of a
+// token file, and renews them once they expire: the plugin is run again, and
+// the file is read again.
+type credentialSource struct {
+	exec      *execConfig
+	tokenFile string
+
+	mu     sync.Mutex
+	token  string
+	cert   *tls.Certificate
+	expiry time.Time // Zero if the credentials don't expire.
+}
+
+// get returns the token to authenticate with, renewing the credentials if
+// they are expired.
+func (s *credentialSource) get(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" || s.cert != nil {
+		if s.expiry.IsZero() || time.Now().Add(expiryDelta).Before(s.expiry) {
+			return s.token, nil
+		}
+	}
+
+	if s.exec != nil {
+		cred, err := s.exec.run(ctx)
+		if err != nil {
+			return "", err
+		}
+		var cert *tls.Certificate
+		if cred.Status.ClientCertificateData != "" || cred.Status.ClientKeyData != "" {
+			c, err := tls.X509KeyPair([]byte(cred.Status.ClientCertificateData), []byte(cred.Status.ClientKeyData))
+			if err != nil {
+				return "", fmt.Errorf("invalid client certificate from credential plugin %q: %w", s.exec.Command, err)
+			}
+			cert = &c
+		}
+		if cred.Status.Token == "" && cert == nil {
+			return "", fmt.Errorf("credential plugin %q returned no token or client certificate", s.exec.Command)
+		}
+		s.token, s.cert, s.expiry = cred.Status.Token, cert, cred.Status.ExpirationTimestamp
+		return s.token, nil
+	}
+
+	token, err := os.ReadFile(s.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file: %w", err)
+	}
+	s.token, s.expiry = strings.TrimSpace(string(token)), time.Now().Add(tokenFileTTL)
+	return s.token, nil
+}
+
+// invalidate renews the credentials the next time they are requested, once
+// the API server has rejected them.
+func (s *credentialSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token, s.cert = "", nil
+}
+
+// clientCertificate returns the client certificate of the credentials, for
+// tls.Config.GetClientCertificate.
+func (s *credentialSource) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cert == nil {
+		// No certificate is sent.
+		return new(tls.Certificate), nil
+	}
+	return s.cert, nil
+}
+
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// inClusterConfig returns the configuration of the service account of the pod
+// the scan is running in. Its token is read again as it is rotated.
+func inClusterConfig(ctx context.Context) (*restConfig, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("not running in a Kubernetes cluster")
+	}
+
+	credentials := &credentialSource{tokenFile: filepath.Join(serviceAccountDir, "token")}
+	if _, err := credentials.get(ctx); err != nil {
+		return nil, fmt.Errorf("could not read service account token: %w", err)
+	}
+	caData, err := os.ReadFile(filepath.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read service account certificate authority: %w", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caData)
+	namespace, _ := os.ReadFile(filepath.Join(serviceAccountDir, "namespace"))
+
+	return &restConfig{
+		clusterName: "in-cluster",
+		server:      "https://" + net.JoinHostPort(host, port),
+		namespace:   strings.TrimSpace(string(namespace)),
+		credentials: credentials,
+		tlsConfig:   &tls.Config{RootCAs: pool},
+	}, nil
+}
+
+// readData returns data if set, or the contents of the file at path.
+func readData(data []byte, path, dir string) ([]byte, error) {
+	if len(data) > 0 || path == "" {
+		return data, nil
+	}
+	return os.ReadFile(resolvePath(path, dir))
+}
+
+func resolvePath(path, dir string) string {
+	if dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
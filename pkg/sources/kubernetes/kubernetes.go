@@ -0,0 +1,405 @@
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_KUBERNETES
+
+// resource is a namespaced kind of object holding pod specs.
+type resource struct {
+	kind string
+	// path is the API path of the collection, with %s in place of the
+	// namespace.
+	path string
+}
+
+var workloadResources = []resource{
+	{kind: "Pod", path: "/api/v1/namespaces/%s/pods"},
+	{kind: "Deployment", path: "/apis/apps/v1/namespaces/%s/deployments"},
+	{kind: "StatefulSet", path: "/apis/apps/v1/namespaces/%s/statefulsets"},
+	{kind: "DaemonSet", path: "/apis/apps/v1/namespaces/%s/daemonsets"},
+	{kind: "ReplicaSet", path: "/apis/apps/v1/namespaces/%s/replicasets"},
+	{kind: "Job", path: "/apis/batch/v1/namespaces/%s/jobs"},
+	{kind: "CronJob", path: "/apis/batch/v1/namespaces/%s/cronjobs"},
+}
+
+// isWorkloadKind reports whether objects of the kind are scanned as workloads.
+func isWorkloadKind(kind string) bool {
+	for _, r := range workloadResources {
+		if r.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	cfg             *restConfig
+	client          *client
+	namespaces      []string
+	namespaceFilter *glob.Filter
+	includeSecrets  bool
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Kubernetes source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Kubernetes
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	var err error
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Kubernetes_Kubeconfig:
+		s.cfg, err = loadKubeconfig(ctx, []byte(cred.Kubeconfig), "", conn.GetContext())
+	case *sourcespb.Kubernetes_KubeconfigFile:
+		var data []byte
+		if data, err = os.ReadFile(cred.KubeconfigFile); err != nil {
+			return fmt.Errorf("could not read kubeconfig: %w", err)
+		}
+		s.cfg, err = loadKubeconfig(ctx, data, filepath.Dir(cred.KubeconfigFile), conn.GetContext())
+	case *sourcespb.Kubernetes_InCluster:
+		s.cfg, err = inClusterConfig(ctx)
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if err != nil {
+		return err
+	}
+	s.client = newClient(s.cfg)
+
+	s.namespaces = conn.GetNamespaces()
+	s.includeSecrets = conn.GetIncludeSecrets()
+	s.namespaceFilter, err = glob.NewGlobFilter(glob.WithExcludeGlobs(conn.GetExcludeNamespaces()...))
+	if err != nil {
+		return fmt.Errorf("could not compile exclude namespace patterns: %w", err)
+	}
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var namespaces []string
+	enumReporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			namespaces = append(namespaces, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, enumReporter); err != nil {
+		return err
+	}
+
+	scanErrs := sources.NewScanErrors()
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	for i, namespace := range namespaces {
+		i, namespace := i, namespace
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.SetProgressComplete(i, len(namespaces), fmt.Sprintf("Namespace: %s", namespace), "")
+			if err := s.scanNamespace(ctx, namespace, reporter); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(namespaces), len(namespaces), "Completed Kubernetes scan", "")
+
+	return nil
+}
+
+func (s *Source) Validate(ctx context.Context) []error {
+	var errs []error
+	var version struct {
+		GitVersion string `json:"gitVersion"`
+	}
+	if err := s.client.get(ctx, "/version", nil, &version); err != nil {
+		errs = append(errs, fmt.Errorf("could not reach the API server: %w", err))
+	}
+	for _, namespace := range s.namespaces {
+		var ns struct{}
+		if err := s.client.get(ctx, "/api/v1/namespaces/"+url.PathEscape(namespace), nil, &ns); err != nil {
+			errs = append(errs, fmt.Errorf("could not get namespace %q: %w", namespace, err))
+		}
+	}
+	return errs
+}
+
+// Enumerate reports the namespaces to scan. When none are configured, every
+// namespace of the cluster is listed, or the namespace of the kubeconfig
+// context is used if listing namespaces is forbidden.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	namespaces := s.namespaces
+	if len(namespaces) == 0 {
+		var err error
+		namespaces, err = s.client.listNamespaces(ctx)
+		switch {
+		case isStatus(err, http.StatusForbidden) && s.cfg.namespace != "":
+			ctx.Logger().Info("listing namespaces is forbidden, scanning the namespace of the context", "namespace", s.cfg.namespace)
+			namespaces = []string{s.cfg.namespace}
+		case err != nil:
+			return fmt.Errorf("error listing namespaces: %w", err)
+		}
+	}
+
+	for _, namespace := range namespaces {
+		if !s.namespaceFilter.ShouldInclude(namespace) {
+			ctx.Logger().V(3).Info("skipping namespace", "namespace", namespace, "reason", "excluded in config")
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{ID: namespace}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit scans the objects of the given namespace unit.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	namespace, _ := unit.SourceUnitID()
+	return s.scanNamespace(ctx, namespace, reporter)
+}
+
+// scanNamespace scans the ConfigMaps, workloads and, if enabled, Secrets of a
+// namespace. Kinds that can't be listed are reported and skipped.
+func (s *Source) scanNamespace(ctx context.Context, namespace string, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "namespace", namespace)
+	ns := url.PathEscape(namespace)
+
+	reportErr := func(kind string, err error) error {
+		if isStatus(err, http.StatusNotFound) {
+			// The kind isn't served by this version of Kubernetes.
+			ctx.Logger().V(2).Info("skipping kind", "kind", kind, "reason", "not found")
+			return nil
+		}
+		return reporter.ChunkErr(ctx, fmt.Errorf("error listing %s objects in namespace %q: %w", kind, namespace, err))
+	}
+
+	err := list(ctx, s.client, fmt.Sprintf("/api/v1/namespaces/%s/configmaps", ns), func(cm *configMap) error {
+		if err := s.scanAnnotations(ctx, cm.Metadata, "ConfigMap", reporter); err != nil {
+			return err
+		}
+		data := make(map[string][]byte, len(cm.Data))
+		for k, v := range cm.Data {
+			data[k] = []byte(v)
+		}
+		if err := s.scanData(ctx, cm.Metadata, "ConfigMap", "data", data, reporter); err != nil {
+			return err
+		}
+		return s.scanData(ctx, cm.Metadata, "ConfigMap", "binaryData", cm.BinaryData, reporter)
+	})
+	if err != nil {
+		if err := reportErr("ConfigMap", err); err != nil {
+			return err
+		}
+	}
+
+	if s.includeSecrets {
+		err := list(ctx, s.client, fmt.Sprintf("/api/v1/namespaces/%s/secrets", ns), func(secret *secret) error {
+			if err := s.scanAnnotations(ctx, secret.Metadata, "Secret", reporter); err != nil {
+				return err
+			}
+			return s.scanData(ctx, secret.Metadata, "Secret", "data", secret.Data, reporter)
+		})
+		if err != nil {
+			if err := reportErr("Secret", err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, r := range workloadResources {
+		r := r
+		err := list(ctx, s.client, fmt.Sprintf(r.path, ns), func(w *workload) error {
+			return s.scanWorkload(ctx, w, r.kind, reporter)
+		})
+		if err != nil {
+			if err := reportErr(r.kind, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// scanWorkload scans the annotations of a workload, and the environment
+// variables and arguments of its containers. Workloads controlled by another
+// scanned workload, like the pods of a deployment, are skipped, as they share
+// the pod template of their controller.
+func (s *Source) scanWorkload(ctx context.Context, w *workload, kind string, reporter sources.ChunkReporter) error {
+	for _, owner := range w.Metadata.OwnerReferences {
+		if owner.Controller && isWorkloadKind(owner.Kind) {
+			return nil
+		}
+	}
+
+	if err := s.scanAnnotations(ctx, w.Metadata, kind, reporter); err != nil {
+		return err
+	}
+
+	prefix, spec := w.podSpec()
+	scanContainers := func(field string, containers []container) error {
+		for _, c := range containers {
+			path := fmt.Sprintf("%s.%s[%s]", prefix, field, c.Name)
+			var env strings.Builder
+			for _, e := range c.Env {
+				if e.Value != "" {
+					env.WriteString(e.Name + "=" + e.Value + "\n")
+				}
+			}
+			if env.Len() > 0 {
+				if err := s.report(ctx, w.Metadata, kind, path+".env", []byte(env.String()), reporter); err != nil {
+					return err
+				}
+			}
+			if cmd := append(append([]string{}, c.Command...), c.Args...); len(cmd) > 0 {
+				if err := s.report(ctx, w.Metadata, kind, path+".args", []byte(strings.Join(cmd, " ")), reporter); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := scanContainers("initContainers", spec.InitContainers); err != nil {
+		return err
+	}
+	return scanContainers("containers", spec.Containers)
+}
+
+// scanAnnotations scans each annotation of an object, including the
+// last-applied-configuration of kubectl, which holds the object as applied.
+func (s *Source) scanAnnotations(ctx context.Context, meta objectMeta, kind string, reporter sources.ChunkReporter) error {
+	for _, name := range sortedKeys(meta.Annotations) {
+		data := []byte(name + ": " + meta.Annotations[name])
+		if err := s.report(ctx, meta, kind, "metadata.annotations."+name, data, reporter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanData scans each value of a ConfigMap or Secret field. Single line text
+// values are scanned as a key=value line, so detectors that match on the name
+// of the key can find them, while other values are scanned as files.
+func (s *Source) scanData(ctx context.Context, meta objectMeta, kind, field string, data map[string][]byte, reporter sources.ChunkReporter) error {
+	for _, key := range sortedKeys(data) {
+		value := data[key]
+		if utf8.Valid(value) && !bytes.ContainsAny(value, "\r\n") {
+			line := append([]byte(key+"="), value...)
+			if err := s.report(ctx, meta, kind, field+"."+key, line, reporter); err != nil {
+				return err
+			}
+			continue
+		}
+
+		chunkSkel := s.chunk(meta, kind, field+"."+key, nil)
+		if err := handlers.HandleFile(ctx, bytes.NewReader(value), &chunkSkel, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error handling %s %s/%s %s.%s: %w", kind, meta.Namespace, meta.Name, field, key, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) report(ctx context.Context, meta objectMeta, kind, key string, data []byte, reporter sources.ChunkReporter) error {
+	return reporter.ChunkOk(ctx, s.chunk(meta, kind, key, data))
+}
+
+func (s *Source) chunk(meta objectMeta, kind, key string, data []byte) sources.Chunk {
+	return sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.sourceID,
+		JobID:      s.jobID,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Kubernetes{
+				Kubernetes: &source_metadatapb.Kubernetes{
+					Cluster:   sanitizer.UTF8(s.cfg.clusterName),
+					Namespace: sanitizer.UTF8(meta.Namespace),
+					Kind:      kind,
+					Name:      sanitizer.UTF8(meta.Name),
+					Key:       sanitizer.UTF8(key),
+				},
+			},
+		},
+		Data:   data,
+		Verify: s.verify,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,306 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+const testToken = "kube-token"
+
+// newTestServer returns a stand-in for a Kubernetes API server with the
+// "default" and "kube-system" namespaces. Listing stateful sets is forbidden,
+// and cron jobs aren't served.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	routes := map[string]string{
+		"/api/v1/namespaces": `{"items": [{"metadata": {"name": "default"}}, {"metadata": {"name": "kube-system"}}]}`,
+		"/api/v1/namespaces/default/configmaps": `{"items": [{
+			"metadata": {"name": "app-config", "namespace": "default", "annotations": {"owner": "team-a"}},
+			"data": {"DB_USER": "admin", "DB_PASSWORD": "hunter2", "app.properties": "mode=prod\ntoken=from-file\n"}
+		}]}`,
+		"/api/v1/namespaces/default/secrets": `{"items": [{
+			"metadata": {"name": "db", "namespace": "default"},
+			"data": {"password": "` + base64.StdEncoding.EncodeToString([]byte("from-secret")) + `"}
+		}]}`,
+		"/api/v1/namespaces/default/pods": `{"items": [
+			{"metadata": {"name": "debug", "namespace": "default"}, "spec": {"containers": [
+				{"name": "shell", "args": ["--password", "from-args"], "env": [{"name": "API_KEY", "value": "from-pod"}, {"name": "FROM_REF", "valueFrom": {}}]}
+			]}},
+			{"metadata": {"name": "web-7d9f-abcde", "namespace": "default", "ownerReferences": [{"kind": "ReplicaSet", "controller": true}]}, "spec": {"containers": [
+				{"name": "web", "env": [{"name": "API_KEY", "value": "from-deployment"}]}
+			]}}
+		]}`,
+		"/apis/apps/v1/namespaces/default/deployments": `{"items": [
+			{"metadata": {"name": "web", "namespace": "default"}, "spec": {"template": {"spec": {
+				"initContainers": [{"name": "migrate", "env": [{"name": "DB_URL", "value": "postgres://from-init"}]}],
+				"containers": [{"name": "web", "env": [{"name": "API_KEY", "value": "from-deployment"}]}]
+			}}}}
+		]}`,
+		"/apis/apps/v1/namespaces/default/statefulsets": "forbidden",
+		"/apis/batch/v1/namespaces/default/cronjobs":    "not found",
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch body, ok := routes[r.URL.Path]; {
+		case body == "forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `{"kind": "Status", "message": "statefulsets.apps is forbidden"}`)
+		case body == "not found":
+			w.WriteHeader(http.StatusNotFound)
+		case !ok:
+			_, _ = fmt.Fprint(w, `{"items": []}`)
+		default:
+			_, _ = fmt.Fprint(w, body)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testKubeconfig returns a kubeconfig for the server, using the token file
+// in the "dev" context and the credential plugin in the "prod" context.
+func testKubeconfig(server *httptest.Server, tokenFile, plugin string) string {
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: test-cluster
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: dev
+  context:
+    cluster: test-cluster
+    user: dev
+    namespace: default
+- name: prod
+  context:
+    cluster: test-cluster
+    user: prod
+users:
+- name: dev
+  user:
+    tokenFile: %s
+- name: prod
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: %s
+`, server.URL, base64.StdEncoding.EncodeToString(ca), tokenFile, plugin)
+}
+
+func initSource(t *testing.T, conn *sourcespb.Kubernetes) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - kubernetes", 0, 0, false, anyConn, 1))
+	return s
+}
+
+// writeKubeconfig writes a kubeconfig for the server next to its token file
+// and credential plugin, and returns its path.
+func writeKubeconfig(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte(testToken+"\n"), 0o600))
+	plugin := filepath.Join(dir, "plugin.sh")
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"kind\": \"ExecCredential\", \"status\": {\"token\": %q}}'\n", testToken)
+	require.NoError(t, os.WriteFile(plugin, []byte(script), 0o700))
+
+	path := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(path, []byte(testKubeconfig(server, "token", plugin)), 0o600))
+	return path
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+	kubeconfig := writeKubeconfig(t, server)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.Kubernetes
+		want []string
+	}{
+		{
+			name: "all namespaces",
+			conn: &sourcespb.Kubernetes{},
+			want: []string{"default", "kube-system"},
+		},
+		{
+			name: "excluded namespace",
+			conn: &sourcespb.Kubernetes{ExcludeNamespaces: []string{"kube-*"}},
+			want: []string{"default"},
+		},
+		{
+			name: "configured namespaces with credential plugin",
+			conn: &sourcespb.Kubernetes{Context: "prod", Namespaces: []string{"kube-system"}},
+			want: []string{"kube-system"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conn.Credential = &sourcespb.Kubernetes_KubeconfigFile{KubeconfigFile: kubeconfig}
+			s := initSource(t, tt.conn)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+			var got []string
+			for _, unit := range reporter.Units {
+				id, _ := unit.SourceUnitID()
+				got = append(got, id)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.Kubernetes{
+		Credential:     &sourcespb.Kubernetes_KubeconfigFile{KubeconfigFile: writeKubeconfig(t, server)},
+		IncludeSecrets: true,
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(context.Background(), sources.CommonSourceUnit{ID: "default"}, &reporter))
+	// Listing stateful sets is forbidden.
+	require.Len(t, reporter.ChunkErrs, 1)
+	assert.Contains(t, reporter.ChunkErrs[0].Error(), "statefulsets.apps is forbidden")
+
+	var got []string
+	for _, chunk := range reporter.Chunks {
+		meta := chunk.SourceMetadata.GetKubernetes()
+		assert.Equal(t, "test-cluster", meta.GetCluster())
+		assert.Equal(t, "default", meta.GetNamespace())
+		got = append(got, fmt.Sprintf("%s/%s %s: %s", meta.GetKind(), meta.GetName(), meta.GetKey(), strings.TrimSpace(string(chunk.Data))))
+	}
+	assert.Equal(t, []string{
+		"ConfigMap/app-config metadata.annotations.owner: owner: team-a",
+		"ConfigMap/app-config data.DB_PASSWORD: DB_PASSWORD=hunter2",
+		"ConfigMap/app-config data.DB_USER: DB_USER=admin",
+		"ConfigMap/app-config data.app.properties: mode=prod\ntoken=from-file",
+		"Secret/db data.password: password=from-secret",
+		"Pod/debug spec.containers[shell].env: API_KEY=from-pod",
+		"Pod/debug spec.containers[shell].args: --password from-args",
+		"Deployment/web spec.template.spec.initContainers[migrate].env: DB_URL=postgres://from-init",
+		"Deployment/web spec.template.spec.containers[web].env: API_KEY=from-deployment",
+	}, got)
+}
+
+func TestEnumerate_ForbiddenNamespaces(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	kubeconfig := strings.Replace(testKubeconfig(server, "", ""), "tokenFile: ", "token: "+testToken, 1)
+	s := initSource(t, &sourcespb.Kubernetes{
+		Credential: &sourcespb.Kubernetes_Kubeconfig{Kubeconfig: kubeconfig},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	require.Len(t, reporter.Units, 1)
+	id, _ := reporter.Units[0].SourceUnitID()
+	assert.Equal(t, "default", id)
+}
+
+func TestRenewCredentials(t *testing.T) {
+	server := newTestServer(t)
+
+	// The token file and the credential plugin return a stale token until
+	// they are rotated. The credentials of the plugin are already expired.
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	pluginToken := filepath.Join(dir, "plugin-token")
+	plugin := filepath.Join(dir, "plugin.sh")
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"kind\": \"ExecCredential\", \"status\": {\"token\": \"'$(cat %s)'\", \"expirationTimestamp\": \"2000-01-01T00:00:00Z\"}}'\n", pluginToken)
+	require.NoError(t, os.WriteFile(plugin, []byte(script), 0o700))
+	kubeconfig := []byte(testKubeconfig(server, "token", plugin))
+
+	for _, contextName := range []string{"dev", "prod"} {
+		t.Run(contextName, func(t *testing.T) {
+			require.NoError(t, os.WriteFile(tokenFile, []byte("stale"), 0o600))
+			require.NoError(t, os.WriteFile(pluginToken, []byte("stale"), 0o600))
+			cfg, err := loadKubeconfig(context.Background(), kubeconfig, dir, contextName)
+			require.NoError(t, err)
+			c := newClient(cfg)
+
+			_, err = c.listNamespaces(context.Background())
+			assert.True(t, isStatus(err, http.StatusUnauthorized))
+
+			require.NoError(t, os.WriteFile(tokenFile, []byte(testToken), 0o600))
+			require.NoError(t, os.WriteFile(pluginToken, []byte(testToken), 0o600))
+			namespaces, err := c.listNamespaces(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []string{"default", "kube-system"}, namespaces)
+		})
+	}
+}
+
+func TestInit_InvalidKubeconfig(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.Kubernetes
+	}{
+		{
+			name: "unknown context",
+			conn: &sourcespb.Kubernetes{
+				Credential: &sourcespb.Kubernetes_KubeconfigFile{KubeconfigFile: writeKubeconfig(t, server)},
+				Context:    "staging",
+			},
+		},
+		{
+			name: "missing token file",
+			conn: &sourcespb.Kubernetes{
+				Credential: &sourcespb.Kubernetes_Kubeconfig{Kubeconfig: testKubeconfig(server, "/nonexistent/token", "")},
+			},
+		},
+		{
+			name: "invalid exclude glob",
+			conn: &sourcespb.Kubernetes{
+				Credential:        &sourcespb.Kubernetes_KubeconfigFile{KubeconfigFile: writeKubeconfig(t, server)},
+				ExcludeNamespaces: []string{"[kube"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anyConn, err := anypb.New(tt.conn)
+			require.NoError(t, err)
+			assert.Error(t, (&Source{}).Init(context.Background(), "test - kubernetes", 0, 0, false, anyConn, 1))
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package npm
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/registry"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_NPM_UNAUTHD_PACKAGES
+
+	defaultRegistry = "https://registry.npmjs.org"
+
+	unitPackage sources.SourceUnitKind = "package"
+
+	searchPageSize = 250
+)
+
+// Source scans the tarball of every published version of npm packages. The
+// packages are listed by name, or found through the maintainers and the
+// organizations publishing them. Each package is a unit.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	registry      string
+	packages      []string
+	maintainers   []string
+	organizations []string
+	client        *registry.Client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized npm source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.NPMUnauthenticatedPackage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.registry = strings.TrimRight(conn.GetRegistry(), "/")
+	if s.registry == "" {
+		s.registry = defaultRegistry
+	}
+	if _, err := url.ParseRequestURI(s.registry); err != nil {
+		return fmt.Errorf("invalid registry URL %q: %w", s.registry, err)
+	}
+	s.packages = conn.GetPackages()
+	s.maintainers = conn.GetMaintainers()
+	s.organizations = conn.GetOrganizations()
+	if len(s.packages)+len(s.maintainers)+len(s.organizations) == 0 {
+		return fmt.Errorf("no packages, maintainers or organizations to scan")
+	}
+	s.client = &registry.Client{HTTPClient: common.RetryableHTTPClient()}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating npm packages")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned package: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed npm scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every configured package, and for every
+// package of the configured maintainers and organizations.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	seen := make(map[string]struct{})
+	reportPackage := func(name string) error {
+		if _, ok := seen[name]; ok {
+			return nil
+		}
+		seen[name] = struct{}{}
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitPackage, ID: name})
+	}
+
+	for _, name := range s.packages {
+		if err := reportPackage(name); err != nil {
+			return err
+		}
+	}
+
+	// The search API qualifiers find the packages of a maintainer, and the
+	// packages published under the scope of an organization.
+	var queries []string
+	for _, maintainer := range s.maintainers {
+		queries = append(queries, "maintainer:"+maintainer)
+	}
+	for _, org := range s.organizations {
+		queries = append(queries, "scope:"+strings.TrimPrefix(org, "@"))
+	}
+	for _, query := range queries {
+		if err := s.search(ctx, query, reportPackage); err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not search packages with %q: %w", query, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type searchResult struct {
+	Objects []struct {
+		Package struct {
+			Name string `json:"name"`
+		} `json:"package"`
+	} `json:"objects"`
+	Total int `json:"total"`
+}
+
+// search calls visit with the name of every package matching the query.
+func (s *Source) search(ctx context.Context, query string, visit func(string) error) error {
+	for from := 0; ; from += searchPageSize {
+		params := url.Values{"text": {query}, "size": {fmt.Sprint(searchPageSize)}, "from": {fmt.Sprint(from)}}
+		var result searchResult
+		if err := s.client.GetJSON(ctx, s.registry+"/-/v1/search?"+params.Encode(), &result); err != nil {
+			return err
+		}
+		for _, obj := range result.Objects {
+			if err := visit(obj.Package.Name); err != nil {
+				return err
+			}
+		}
+		if len(result.Objects) < searchPageSize || from+len(result.Objects) >= result.Total {
+			return nil
+		}
+	}
+}
+
+// packument is the registry document of a package, describing all of its
+// published versions.
+type packument struct {
+	Name     string `json:"name"`
+	Versions map[string]struct {
+		Dist struct {
+			Tarball string `json:"tarball"`
+		} `json:"dist"`
+		NpmUser struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"_npmUser"`
+	} `json:"versions"`
+	Time map[string]string `json:"time"`
+}
+
+// ChunkUnit scans the tarball of every version of a package, from the oldest
+// to the latest.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	name, _ := unit.SourceUnitID()
+	ctx = context.WithValues(ctx, "package", name)
+
+	var pkg packument
+	if err := s.client.GetJSON(ctx, s.registry+"/"+packagePath(name), &pkg); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not get package %q: %w", name, err))
+	}
+
+	versions := make([]string, 0, len(pkg.Versions))
+	for version := range pkg.Versions {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		ti, tj := pkg.Time[versions[i]], pkg.Time[versions[j]]
+		if ti != tj {
+			return ti < tj
+		}
+		return versions[i] < versions[j]
+	})
+
+	for _, version := range versions {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		v := pkg.Versions[version]
+		if v.Dist.Tarball == "" {
+			continue
+		}
+		metadata := &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Npm{
+				Npm: &source_metadatapb.NPM{
+					File:    sanitizer.UTF8(path.Base(v.Dist.Tarball)),
+					Package: sanitizer.UTF8(name),
+					Release: sanitizer.UTF8(version),
+					Link:    sanitizer.UTF8(v.Dist.Tarball),
+					Email:   sanitizer.UTF8(v.NpmUser.Email),
+				},
+			},
+		}
+		if err := s.scanArchive(ctx, v.Dist.Tarball, metadata, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not scan %s@%s: %w", name, version, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// packagePath returns the registry path of a package. The slash of scoped
+// package names is escaped.
+func packagePath(name string) string {
+	return url.PathEscape(name)
+}
+
+func (s *Source) scanArchive(ctx context.Context, archiveURL string, metadata *source_metadatapb.MetaData, reporter sources.ChunkReporter) error {
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	return s.client.ScanArchive(ctx, archiveURL, chunkSkel, reporter)
+}
@@ -0,0 +1,139 @@
+package npm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// tarball returns a gzipped tarball holding a single file, as published by
+// npm.
+func tarball(t *testing.T, name, content string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "package/" + name, Mode: 0644, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/-/v1/search", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("text") {
+		case "maintainer:alice":
+			_, _ = fmt.Fprint(w, `{"objects": [{"package": {"name": "left-pad"}}, {"package": {"name": "@acme/cli"}}], "total": 2}`)
+		case "scope:acme":
+			_, _ = fmt.Fprint(w, `{"objects": [{"package": {"name": "@acme/cli"}}, {"package": {"name": "@acme/sdk"}}], "total": 2}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"objects": [], "total": 0}`)
+		}
+	})
+	mux.HandleFunc("/@acme%2Fcli", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{
+			"name": "@acme/cli",
+			"versions": {
+				"1.1.0": {"dist": {"tarball": "%[1]s/@acme/cli/-/cli-1.1.0.tgz"}, "_npmUser": {"name": "alice", "email": "alice@acme.test"}},
+				"1.0.0": {"dist": {"tarball": "%[1]s/@acme/cli/-/cli-1.0.0.tgz"}, "_npmUser": {"name": "bob", "email": "bob@acme.test"}}
+			},
+			"time": {"created": "2024-01-01T00:00:00Z", "1.0.0": "2024-01-01T00:00:00Z", "1.1.0": "2024-02-01T00:00:00Z"}
+		}`, server.URL)
+	})
+	mux.HandleFunc("/@acme/cli/-/cli-1.0.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball(t, ".npmrc", "//registry.npmjs.org/:_authToken=from-1.0.0"))
+	})
+	mux.HandleFunc("/@acme/cli/-/cli-1.1.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball(t, "index.js", "const key = 'from-1.1.0'"))
+	})
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.NPMUnauthenticatedPackage) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - npm", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.NPMUnauthenticatedPackage{
+		Registry:      server.URL + "/",
+		Packages:      []string{"left-pad"},
+		Maintainers:   []string{"alice"},
+		Organizations: []string{"@acme"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Empty(t, reporter.UnitErrs)
+
+	var got []string
+	for _, unit := range reporter.Units {
+		id, kind := unit.SourceUnitID()
+		assert.Equal(t, unitPackage, kind)
+		got = append(got, id)
+	}
+	assert.Equal(t, []string{"left-pad", "@acme/cli", "@acme/sdk"}, got)
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.NPMUnauthenticatedPackage{Registry: server.URL, Packages: []string{"@acme/cli"}})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitPackage, ID: "@acme/cli"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	require.Len(t, reporter.Chunks, 2)
+
+	first, second := reporter.Chunks[0], reporter.Chunks[1]
+	assert.Contains(t, string(first.Data), "_authToken=from-1.0.0")
+	meta := first.SourceMetadata.GetNpm()
+	assert.Equal(t, "@acme/cli", meta.GetPackage())
+	assert.Equal(t, "1.0.0", meta.GetRelease())
+	assert.Equal(t, "cli-1.0.0.tgz", meta.GetFile())
+	assert.Equal(t, server.URL+"/@acme/cli/-/cli-1.0.0.tgz", meta.GetLink())
+	assert.Equal(t, "bob@acme.test", meta.GetEmail())
+
+	assert.Contains(t, string(second.Data), "from-1.1.0")
+	assert.Equal(t, "1.1.0", second.SourceMetadata.GetNpm().GetRelease())
+}
+
+func TestChunkUnit_MissingPackage(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.NPMUnauthenticatedPackage{Registry: server.URL, Packages: []string{"missing"}})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitPackage, ID: "missing"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	assert.Len(t, reporter.ChunkErrs, 1)
+	assert.Empty(t, reporter.Chunks)
+}
@@ -0,0 +1,319 @@
+package pypi
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/net/html"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/registry"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_PYPI_UNAUTHD_PACKAGES
+
+	defaultRegistry = "https://pypi.org"
+
+	unitPackage sources.SourceUnitKind = "package"
+)
+
+// Source scans the wheels and source distributions of every published
+// release of PyPI packages. The packages are listed by name, or found through
+// the maintainers and the organizations publishing them. Each package is a
+// unit.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	registry      string
+	packages      []string
+	maintainers   []string
+	organizations []string
+	client        *registry.Client
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized PyPI source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.PyPIUnauthenticatedPackage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.registry = strings.TrimRight(conn.GetRegistry(), "/")
+	if s.registry == "" {
+		s.registry = defaultRegistry
+	}
+	if _, err := url.ParseRequestURI(s.registry); err != nil {
+		return fmt.Errorf("invalid registry URL %q: %w", s.registry, err)
+	}
+	s.packages = conn.GetPackages()
+	s.maintainers = conn.GetMaintainers()
+	s.organizations = conn.GetOrganizations()
+	if len(s.packages)+len(s.maintainers)+len(s.organizations) == 0 {
+		return fmt.Errorf("no packages, maintainers or organizations to scan")
+	}
+	s.client = &registry.Client{HTTPClient: common.RetryableHTTPClient()}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating PyPI packages")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned package: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed PyPI scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every configured package, and for every
+// package of the configured maintainers and organizations.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	seen := make(map[string]struct{})
+	reportPackage := func(name string) error {
+		if _, ok := seen[name]; ok {
+			return nil
+		}
+		seen[name] = struct{}{}
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitPackage, ID: name})
+	}
+
+	for _, name := range s.packages {
+		if err := reportPackage(name); err != nil {
+			return err
+		}
+	}
+
+	// PyPI has no API listing the packages of a user or of an organization,
+	// they are read from their profile pages instead.
+	var profiles []string
+	for _, maintainer := range s.maintainers {
+		profiles = append(profiles, "/user/"+url.PathEscape(maintainer)+"/")
+	}
+	for _, org := range s.organizations {
+		profiles = append(profiles, "/org/"+url.PathEscape(org)+"/")
+	}
+	for _, profile := range profiles {
+		names, err := s.profilePackages(ctx, profile)
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not list the packages of %s: %w", profile, err)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, name := range names {
+			if err := reportPackage(name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// profilePackages returns the names of the packages linked from a profile
+// page.
+func (s *Source) profilePackages(ctx context.Context, profile string) ([]string, error) {
+	body, err := s.client.Get(ctx, s.registry+profile)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var names []string
+	z := html.NewTokenizer(body)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			return names, nil
+		case html.StartTagToken:
+			tok := z.Token()
+			if tok.Data != "a" {
+				continue
+			}
+			var href string
+			var isSnippet bool
+			for _, attr := range tok.Attr {
+				switch attr.Key {
+				case "href":
+					href = attr.Val
+				case "class":
+					isSnippet = strings.Contains(attr.Val, "package-snippet")
+				}
+			}
+			if name, ok := strings.CutPrefix(href, "/project/"); ok && isSnippet {
+				names = append(names, strings.Trim(name, "/"))
+			}
+		}
+	}
+}
+
+// project is the JSON API document of a package, describing the files of all
+// of its releases.
+type project struct {
+	Info struct {
+		Name            string `json:"name"`
+		AuthorEmail     string `json:"author_email"`
+		MaintainerEmail string `json:"maintainer_email"`
+	} `json:"info"`
+	Releases map[string][]releaseFile `json:"releases"`
+}
+
+type releaseFile struct {
+	Filename    string `json:"filename"`
+	URL         string `json:"url"`
+	PackageType string `json:"packagetype"`
+	UploadTime  string `json:"upload_time_iso_8601"`
+	Yanked      bool   `json:"yanked"`
+}
+
+// ChunkUnit scans the files of every release of a package, from the oldest
+// to the latest. Yanked releases are scanned too, as they remain available.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	name, _ := unit.SourceUnitID()
+	ctx = context.WithValues(ctx, "package", name)
+
+	var p project
+	if err := s.client.GetJSON(ctx, s.registry+"/pypi/"+url.PathEscape(name)+"/json", &p); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not get package %q: %w", name, err))
+	}
+	email := p.Info.MaintainerEmail
+	if email == "" {
+		email = p.Info.AuthorEmail
+	}
+
+	type release struct {
+		version string
+		file    releaseFile
+	}
+	var releases []release
+	for version, files := range p.Releases {
+		for _, f := range files {
+			releases = append(releases, release{version: version, file: f})
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		ri, rj := releases[i], releases[j]
+		if ri.file.UploadTime != rj.file.UploadTime {
+			return ri.file.UploadTime < rj.file.UploadTime
+		}
+		return ri.file.Filename < rj.file.Filename
+	})
+
+	for _, r := range releases {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		metadata := &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Pypi{
+				Pypi: &source_metadatapb.PyPi{
+					File:    sanitizer.UTF8(r.file.Filename),
+					Package: sanitizer.UTF8(name),
+					Release: sanitizer.UTF8(r.version),
+					Link:    sanitizer.UTF8(r.file.URL),
+					Email:   sanitizer.UTF8(email),
+				},
+			},
+		}
+		if err := s.scanArchive(ctx, r.file.URL, metadata, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not scan %s of %s==%s: %w", r.file.Filename, name, r.version, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanArchive(ctx context.Context, archiveURL string, metadata *source_metadatapb.MetaData, reporter sources.ChunkReporter) error {
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	return s.client.ScanArchive(ctx, archiveURL, chunkSkel, reporter)
+}
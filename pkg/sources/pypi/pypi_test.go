@@ -0,0 +1,128 @@
+package pypi
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// wheel returns a wheel holding a single file.
+func wheel(t *testing.T, name, content string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(name)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/user/alice/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<html><body>
+			<a href="/account/login/">Log in</a>
+			<a class="package-snippet" href="/project/acme-sdk/"><h3>acme-sdk</h3></a>
+			<a class="package-snippet" href="/project/acme-cli/"><h3>acme-cli</h3></a>
+		</body></html>`)
+	})
+	mux.HandleFunc("/org/acme/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<a class="package-snippet" href="/project/acme-cli/">acme-cli</a>`)
+	})
+	mux.HandleFunc("/pypi/acme-sdk/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{
+			"info": {"name": "acme-sdk", "author_email": "alice@acme.test"},
+			"releases": {
+				"0.2.0": [
+					{"filename": "acme_sdk-0.2.0-py3-none-any.whl", "url": "%[1]s/files/acme_sdk-0.2.0-py3-none-any.whl", "packagetype": "bdist_wheel", "upload_time_iso_8601": "2024-02-01T00:00:00Z"}
+				],
+				"0.1.0": [
+					{"filename": "acme_sdk-0.1.0-py3-none-any.whl", "url": "%[1]s/files/acme_sdk-0.1.0-py3-none-any.whl", "packagetype": "bdist_wheel", "upload_time_iso_8601": "2024-01-01T00:00:00Z", "yanked": true}
+				]
+			}
+		}`, server.URL)
+	})
+	mux.HandleFunc("/files/acme_sdk-0.1.0-py3-none-any.whl", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(wheel(t, "acme_sdk/settings.py", "API_KEY = 'from-0.1.0'"))
+	})
+	mux.HandleFunc("/files/acme_sdk-0.2.0-py3-none-any.whl", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(wheel(t, "acme_sdk/settings.py", "API_KEY = 'from-0.2.0'"))
+	})
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.PyPIUnauthenticatedPackage) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - pypi", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.PyPIUnauthenticatedPackage{
+		Registry:      server.URL,
+		Packages:      []string{"requests"},
+		Maintainers:   []string{"alice"},
+		Organizations: []string{"acme", "missing"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), &reporter))
+	assert.Len(t, reporter.UnitErrs, 1)
+
+	var got []string
+	for _, unit := range reporter.Units {
+		id, kind := unit.SourceUnitID()
+		assert.Equal(t, unitPackage, kind)
+		got = append(got, id)
+	}
+	assert.Equal(t, []string{"requests", "acme-sdk", "acme-cli"}, got)
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.PyPIUnauthenticatedPackage{Registry: server.URL, Packages: []string{"acme-sdk"}})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitPackage, ID: "acme-sdk"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	require.Len(t, reporter.Chunks, 2)
+
+	first, second := reporter.Chunks[0], reporter.Chunks[1]
+	assert.Contains(t, string(first.Data), "from-0.1.0")
+	meta := first.SourceMetadata.GetPypi()
+	assert.Equal(t, "acme-sdk", meta.GetPackage())
+	assert.Equal(t, "0.1.0", meta.GetRelease())
+	assert.Equal(t, "acme_sdk-0.1.0-py3-none-any.whl", meta.GetFile())
+	assert.Equal(t, server.URL+"/files/acme_sdk-0.1.0-py3-none-any.whl", meta.GetLink())
+	assert.Equal(t, "alice@acme.test", meta.GetEmail())
+
+	assert.Contains(t, string(second.Data), "from-0.2.0")
+	assert.Equal(t, "0.2.0", second.SourceMetadata.GetPypi().GetRelease())
+}
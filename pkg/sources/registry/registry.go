@@ -0,0 +1,64 @@
+// Package registry holds the HTTP helpers shared by the sources that scan the
+// packages published to a registry, such as npm and PyPI.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Client requests the metadata and the archives of packages from a registry.
+type Client struct {
+	HTTPClient *http.Client
+}
+
+// Get requests the given URL and returns the body of the response, which the
+// caller must close.
+func (c *Client) Get(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, u)
+	}
+	return resp.Body, nil
+}
+
+// GetJSON requests the given URL and decodes the JSON response into target.
+func (c *Client) GetJSON(ctx context.Context, u string, target any) error {
+	body, err := c.Get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if err := json.NewDecoder(body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", u, err)
+	}
+	return nil
+}
+
+// ScanArchive downloads the archive at archiveURL and reports the chunks of
+// its content, built from chunkSkel.
+func (c *Client) ScanArchive(ctx context.Context, archiveURL string, chunkSkel *sources.Chunk, reporter sources.ChunkReporter) error {
+	ctx.Logger().V(3).Info("scanning archive", "url", archiveURL)
+	body, err := c.Get(ctx, archiveURL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
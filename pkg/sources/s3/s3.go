@@ -1,7 +1,10 @@
 package s3
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -133,6 +136,16 @@ func (s *Source) newClient(region, roleArn string) (*s3.S3, error) {
 	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
 	cfg.Region = aws.String(region)
 
+	if endpoint := s.conn.GetEndpoint(); endpoint != "" {
+		cfg.Endpoint = aws.String(endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(s.conn.GetForcePathStyle())
+	}
+	if s.conn.GetInsecureSkipVerifyTls() {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		cfg.HTTPClient = &http.Client{Transport: transport}
+	}
+
 	switch cred := s.conn.GetCredential().(type) {
 	case *sourcespb.S3_SessionToken:
 		cfg.Credentials = credentials.NewStaticCredentials(cred.SessionToken.Key, cred.SessionToken.Secret, cred.SessionToken.SessionToken)
@@ -253,12 +266,18 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 }
 
 func (s *Source) getRegionalClientForBucket(ctx context.Context, defaultRegionClient *s3.S3, role, bucket string) (*s3.S3, error) {
+	// S3-compatible services serve every bucket from the configured endpoint, and
+	// most of them don't implement the bucket region lookup.
+	if s.conn.GetEndpoint() != "" {
+		return defaultRegionClient, nil
+	}
+
 	region, err := s3manager.GetBucketRegionWithClient(ctx, defaultRegionClient, bucket)
 	if err != nil {
 		return nil, errors.WrapPrefix(err, "could not get s3 region for bucket", 0)
 	}
 
-	if region == defaultAWSRegion {
+	if region == s.defaultRegion() {
 		return defaultRegionClient, nil
 	}
 
@@ -372,7 +391,7 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 						S3: &source_metadatapb.S3{
 							Bucket:    bucket,
 							File:      sanitizer.UTF8(*obj.Key),
-							Link:      sanitizer.UTF8(makeS3Link(bucket, *client.Config.Region, *obj.Key)),
+							Link:      sanitizer.UTF8(s.makeLink(bucket, *client.Config.Region, *obj.Key)),
 							Email:     sanitizer.UTF8(email),
 							Timestamp: sanitizer.UTF8(modified),
 						},
@@ -446,7 +465,7 @@ func (s *Source) visitRoles(ctx context.Context, f func(c context.Context, defau
 	}
 
 	for _, role := range roles {
-		client, err := s.newClient(defaultAWSRegion, role)
+		client, err := s.newClient(s.defaultRegion(), role)
 		if err != nil {
 			return errors.WrapPrefix(err, "could not create s3 client", 0)
 		}
@@ -462,6 +481,44 @@ func (s *Source) visitRoles(ctx context.Context, f func(c context.Context, defau
 	return nil
 }
 
+// defaultRegion returns the region of the clients used to list buckets.
+func (s *Source) defaultRegion() string {
+	if region := s.conn.GetRegion(); region != "" {
+		return region
+	}
+	return defaultAWSRegion
+}
+
+// makeLink returns the link to an object, on AWS or on the configured
+// S3-compatible endpoint.
+func (s *Source) makeLink(bucket, region, key string) string {
+	if endpoint := s.conn.GetEndpoint(); endpoint != "" {
+		return makeEndpointLink(endpoint, s.conn.GetForcePathStyle(), bucket, key)
+	}
+	return makeS3Link(bucket, region, key)
+}
+
+// makeEndpointLink returns the link to an object on an S3-compatible endpoint,
+// using path-style or virtual-hosted-style addressing:
+// [scheme]://[host]/[bucket]/[key] or [scheme]://[bucket].[host]/[key]
+func makeEndpointLink(endpoint string, pathStyle bool, bucket, key string) string {
+	if !strings.Contains(endpoint, "://") {
+		// The AWS SDK defaults to HTTPS for endpoints without a scheme.
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	if pathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket + "/" + key
+	} else {
+		u.Host = bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	return u.String()
+}
+
 // S3 links currently have the general format of:
 // https://[bucket].s3[.region unless us-east-1].amazonaws.com/[key]
 func makeS3Link(bucket, region, key string) string {
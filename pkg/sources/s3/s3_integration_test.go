@@ -5,11 +5,19 @@ package s3
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -184,3 +192,95 @@ func TestSource_Validate(t *testing.T) {
 		})
 	}
 }
+
+const (
+	minioUser     = "minioadmin"
+	minioPassword = "minioadmin"
+)
+
+// startMinIO runs a MinIO server and returns its endpoint.
+func startMinIO(t *testing.T, ctx context.Context) string {
+	t.Helper()
+
+	minioC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "minio/minio:latest",
+			ExposedPorts: []string{"9000/tcp"},
+			Env: map[string]string{
+				"MINIO_ROOT_USER":     minioUser,
+				"MINIO_ROOT_PASSWORD": minioPassword,
+			},
+			Cmd:        []string{"server", "/data"},
+			WaitingFor: wait.ForHTTP("/minio/health/live").WithPort("9000/tcp").WithStartupTimeout(30 * time.Second),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = minioC.Terminate(ctx) })
+
+	host, err := minioC.Host(ctx)
+	require.NoError(t, err)
+	port, err := minioC.MappedPort(ctx, "9000")
+	require.NoError(t, err)
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port())
+}
+
+func TestSource_Chunks_MinIO(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	endpoint := startMinIO(t, ctx)
+
+	// Seed the buckets with the SDK.
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String(defaultAWSRegion),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials(minioUser, minioPassword, ""),
+	})
+	require.NoError(t, err)
+	client := s3.New(sess)
+	objects := map[string]map[string]string{
+		"backups": {"db/config.env": "AWS_SECRET_ACCESS_KEY=wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"},
+		"ignored": {"notes.txt": "nothing to see here"},
+	}
+	for bucket, files := range objects {
+		_, err := client.CreateBucketWithContext(ctx, &s3.CreateBucketInput{Bucket: aws.String(bucket)})
+		require.NoError(t, err)
+		for key, data := range files {
+			_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+				Body:   strings.NewReader(data),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	conn, err := anypb.New(&sourcespb.S3{
+		Credential: &sourcespb.S3_AccessKey{
+			AccessKey: &credentialspb.KeySecret{Key: minioUser, Secret: minioPassword},
+		},
+		IgnoreBuckets:  []string{"ignored"},
+		Endpoint:       endpoint,
+		ForcePathStyle: true,
+	})
+	require.NoError(t, err)
+
+	s := Source{}
+	require.NoError(t, s.Init(ctx, "minio test source", 0, 0, false, conn, 2))
+	assert.Empty(t, s.Validate(ctx))
+
+	chunksCh := make(chan *sources.Chunk, 8)
+	require.NoError(t, s.Chunks(ctx, chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for chunk := range chunksCh {
+		chunks = append(chunks, chunk)
+	}
+	require.Len(t, chunks, 1)
+	assert.Equal(t, objects["backups"]["db/config.env"], string(chunks[0].Data))
+	assert.Equal(t, endpoint+"/backups/db/config.env", chunks[0].SourceMetadata.GetS3().GetLink())
+}
@@ -3,13 +3,17 @@ package s3
 import (
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
@@ -139,3 +143,124 @@ func TestSource_Chunks(t *testing.T) {
 		})
 	}
 }
+
+// newS3CompatibleServer returns a minimal stand-in for an S3-compatible service
+// such as MinIO, which only supports path-style requests and has no notion of
+// bucket regions.
+func newS3CompatibleServer(t *testing.T, objects map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["location"]; ok || r.Method == http.MethodHead {
+			t.Errorf("unexpected bucket region lookup: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		assert.Contains(t, r.Header.Get("Authorization"), "Credential=minioadmin/")
+
+		path := strings.TrimPrefix(r.URL.Path, "/")
+		w.Header().Set("Content-Type", "application/xml")
+		switch {
+		case path == "":
+			_, _ = fmt.Fprint(w, `<ListAllMyBucketsResult><Buckets>
+				<Bucket><Name>backups</Name></Bucket>
+				<Bucket><Name>ignored</Name></Bucket>
+			</Buckets></ListAllMyBucketsResult>`)
+		case path == "backups" && r.URL.Query().Get("list-type") == "2":
+			var contents string
+			for key, data := range objects {
+				contents += fmt.Sprintf(`<Contents><Key>%s</Key><Size>%d</Size><StorageClass>STANDARD</StorageClass>`+
+					`<LastModified>2024-01-02T03:04:05.000Z</LastModified></Contents>`, key, len(data))
+			}
+			_, _ = fmt.Fprintf(w, `<ListBucketResult><Name>backups</Name><IsTruncated>false</IsTruncated>%s</ListBucketResult>`, contents)
+		case strings.HasPrefix(path, "backups/"):
+			data, ok := objects[strings.TrimPrefix(path, "backups/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = fmt.Fprint(w, data)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSource_Chunks_S3Compatible(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := newS3CompatibleServer(t, map[string]string{
+		"db/config.env": "AWS_SECRET_ACCESS_KEY=wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY",
+	})
+
+	conn, err := anypb.New(&sourcespb.S3{
+		Credential: &sourcespb.S3_AccessKey{
+			AccessKey: &credentialspb.KeySecret{Key: "minioadmin", Secret: "minioadmin"},
+		},
+		IgnoreBuckets:  []string{"ignored"},
+		Endpoint:       server.URL,
+		ForcePathStyle: true,
+	})
+	require.NoError(t, err)
+
+	s := Source{}
+	require.NoError(t, s.Init(ctx, "s3 compatible test source", 0, 0, false, conn, 2))
+
+	chunksCh := make(chan *sources.Chunk, 8)
+	require.NoError(t, s.Chunks(ctx, chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for chunk := range chunksCh {
+		chunks = append(chunks, chunk)
+	}
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "AWS_SECRET_ACCESS_KEY=wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", string(chunks[0].Data))
+
+	meta := chunks[0].SourceMetadata.GetS3()
+	assert.Equal(t, "backups", meta.GetBucket())
+	assert.Equal(t, "db/config.env", meta.GetFile())
+	assert.Equal(t, server.URL+"/backups/db/config.env", meta.GetLink())
+	assert.Equal(t, int64(100), s.GetProgress().PercentComplete)
+}
+
+func TestSource_MakeLink(t *testing.T) {
+	tests := []struct {
+		name string
+		conn *sourcespb.S3
+		want string
+	}{
+		{
+			name: "aws us-east-1",
+			conn: &sourcespb.S3{},
+			want: "https://bucket.s3.amazonaws.com/dir/file.txt",
+		},
+		{
+			name: "path style endpoint",
+			conn: &sourcespb.S3{Endpoint: "http://127.0.0.1:9000", ForcePathStyle: true},
+			want: "http://127.0.0.1:9000/bucket/dir/file.txt",
+		},
+		{
+			name: "virtual hosted endpoint",
+			conn: &sourcespb.S3{Endpoint: "https://abc123.r2.cloudflarestorage.com"},
+			want: "https://bucket.abc123.r2.cloudflarestorage.com/dir/file.txt",
+		},
+		{
+			name: "endpoint without scheme",
+			conn: &sourcespb.S3{Endpoint: "rgw.example.com/", ForcePathStyle: true},
+			want: "https://rgw.example.com/bucket/dir/file.txt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Source{conn: tt.conn}
+			assert.Equal(t, tt.want, s.makeLink("bucket", "us-east-1", "dir/file.txt"))
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package sharepoint
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_SHAREPOINT
+
+	unitDrive sources.SourceUnitKind = "drive"
+
+	defaultMaxFileSize = 250 * 1024 * 1024 // 250 MiB
+)
+
+type site struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	WebURL      string `json:"webUrl"`
+}
+
+type drive struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"webUrl"`
+}
+
+type driveItem struct {
+	ID                   string              `json:"id"`
+	Name                 string              `json:"name"`
+	WebURL               string              `json:"webUrl"`
+	Size                 int64               `json:"size"`
+	LastModifiedDateTime string              `json:"lastModifiedDateTime"`
+	LastModifiedBy       msgraph.IdentitySet `json:"lastModifiedBy"`
+	File                 *struct{}           `json:"file"`
+	Folder               *struct{}           `json:"folder"`
+	DownloadURL          string              `json:"@microsoft.graph.downloadUrl"`
+	SharepointIDs        struct {
+		SiteURL string `json:"siteUrl"`
+	} `json:"sharepointIds"`
+}
+
+// Source scans the files of SharePoint document libraries and, optionally,
+// of the OneDrive of every user, using the Microsoft Graph API. Both are
+// drives in Graph, and each drive is a unit.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	client          *msgraph.Client
+	siteURL         string
+	includeOneDrive bool
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized SharePoint source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Sharepoint
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	var tokens oauth2.TokenSource
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Sharepoint_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		tokens = msgraph.StaticToken(cred.Token)
+	case *sourcespb.Sharepoint_Authenticated:
+		var err error
+		if tokens, err = msgraph.ClientCredentials(ctx, cred.Authenticated); err != nil {
+			return err
+		}
+	case *sourcespb.Sharepoint_Oauth:
+		tokens = msgraph.OAuth(ctx, cred.Oauth)
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	client, err := msgraph.NewClient(ctx, conn.GetEndpoint(), tokens)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	s.siteURL = conn.GetSiteUrl()
+	s.includeOneDrive = conn.GetIncludeOnedrive()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating SharePoint drives")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned drive: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed SharePoint scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every document library of the configured site,
+// or of every site in the tenant, followed by the OneDrive of every user when
+// enabled.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	sites, err := s.listSites(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A drive can be listed more than once, e.g. as a user's OneDrive and as
+	// the library of the user's personal site.
+	seen := make(map[string]struct{})
+	reportDrive := func(d drive) error {
+		if _, ok := seen[d.ID]; ok {
+			return nil
+		}
+		seen[d.ID] = struct{}{}
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitDrive, ID: d.ID})
+	}
+
+	for _, st := range sites {
+		if err := msgraph.List(ctx, s.client, "/sites/"+st.ID+"/drives?$select=id,name,webUrl", reportDrive); err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not list the drives of site %q: %w", st.WebURL, err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.includeOneDrive {
+		return nil
+	}
+	return msgraph.List(ctx, s.client, "/users?$select=id,userPrincipalName", func(u struct {
+		ID                string `json:"id"`
+		UserPrincipalName string `json:"userPrincipalName"`
+	}) error {
+		var d drive
+		err := s.client.Get(ctx, "/users/"+url.PathEscape(u.ID)+"/drive?$select=id,name,webUrl", &d)
+		switch {
+		case msgraph.IsNotFound(err):
+			// The user has no OneDrive, e.g. for lack of a license.
+			ctx.Logger().V(3).Info("user has no OneDrive", "user", u.UserPrincipalName)
+			return nil
+		case err != nil:
+			return reporter.UnitErr(ctx, fmt.Errorf("could not get the OneDrive of %q: %w", u.UserPrincipalName, err))
+		}
+		return reportDrive(d)
+	})
+}
+
+// listSites returns the configured site, or every site in the tenant.
+func (s *Source) listSites(ctx context.Context) ([]site, error) {
+	if s.siteURL != "" {
+		path, err := sitePath(s.siteURL)
+		if err != nil {
+			return nil, err
+		}
+		var st site
+		if err := s.client.Get(ctx, path, &st); err != nil {
+			return nil, fmt.Errorf("could not get site %q: %w", s.siteURL, err)
+		}
+		return []site{st}, nil
+	}
+
+	var sites []site
+	err := msgraph.List(ctx, s.client, "/sites?search=*&$select=id,displayName,webUrl", func(st site) error {
+		sites = append(sites, st)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list sites: %w", err)
+	}
+	return sites, nil
+}
+
+// sitePath returns the Graph path of a site, given its URL.
+// https://learn.microsoft.com/en-us/graph/api/site-getbypath
+func sitePath(siteURL string) (string, error) {
+	u, err := url.Parse(siteURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("invalid SharePoint site URL %q", siteURL)
+	}
+	path := "/sites/" + u.Host
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		path += ":/" + p
+	}
+	return path + "?$select=id,displayName,webUrl", nil
+}
+
+// ChunkUnit scans every file of a drive.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	driveID, _ := unit.SourceUnitID()
+	drivePath := "/drives/" + url.PathEscape(driveID)
+
+	var d drive
+	if err := s.client.Get(ctx, drivePath+"?$select=id,name,webUrl", &d); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not get drive %s: %w", driveID, err))
+	}
+	var root driveItem
+	if err := s.client.Get(ctx, drivePath+"/root?$select=id,sharepointIds", &root); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("could not get the root of drive %s: %w", driveID, err))
+	}
+	siteURL := root.SharepointIDs.SiteURL
+	ctx = context.WithValues(ctx, "site", siteURL, "drive", d.Name)
+	ctx.Logger().V(2).Info("scanning drive")
+
+	// Walk the folders breadth first, keeping track of their path.
+	type folder struct{ id, path string }
+	queue := []folder{{id: root.ID, path: d.Name}}
+	for len(queue) > 0 {
+		f := queue[0]
+		queue = queue[1:]
+
+		childrenPath := fmt.Sprintf("%s/items/%s/children", drivePath, url.PathEscape(f.id))
+		err := msgraph.List(ctx, s.client, childrenPath, func(item driveItem) error {
+			itemPath := f.path + "/" + item.Name
+			switch {
+			case item.Folder != nil:
+				queue = append(queue, folder{id: item.ID, path: itemPath})
+			case item.File != nil:
+				if err := s.scanFile(ctx, drivePath, siteURL, itemPath, item, reporter); err != nil {
+					return reporter.ChunkErr(ctx, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("could not list folder %q: %w", f.path, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanFile(ctx context.Context, drivePath, siteURL, itemPath string, item driveItem, reporter sources.ChunkReporter) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+	if item.Size > defaultMaxFileSize {
+		ctx.Logger().V(3).Info("skipping file over the size limit", "path", itemPath, "size", item.Size)
+		return nil
+	}
+	if common.SkipFile(item.Name) {
+		ctx.Logger().V(5).Info("skipping file with incompatible extension", "path", itemPath)
+		return nil
+	}
+	ctx.Logger().V(3).Info("scanning file", "path", itemPath)
+
+	var author msgraph.Identity
+	if item.LastModifiedBy.User != nil {
+		author = *item.LastModifiedBy.User
+	}
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.sourceID,
+		JobID:      s.jobID,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Sharepoint{
+				Sharepoint: &source_metadatapb.SharePoint{
+					Link:      sanitizer.UTF8(item.WebURL),
+					Timestamp: item.LastModifiedDateTime,
+					Author:    sanitizer.UTF8(author.DisplayName),
+					Email:     sanitizer.UTF8(author.Email),
+					Title:     sanitizer.UTF8(item.Name),
+					Docid:     item.ID,
+					Site:      sanitizer.UTF8(siteURL),
+					Path:      sanitizer.UTF8(itemPath),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	// The download URL is pre-authenticated and short-lived.
+	downloadURL := item.DownloadURL
+	if downloadURL == "" {
+		downloadURL = drivePath + "/items/" + url.PathEscape(item.ID) + "/content"
+	}
+	content, err := s.client.Open(ctx, downloadURL)
+	if err != nil {
+		return fmt.Errorf("could not download %q: %w", itemPath, err)
+	}
+	defer content.Close()
+
+	if err := handlers.HandleFile(ctx, content, chunkSkel, reporter); err != nil {
+		return fmt.Errorf("could not scan %q: %w", itemPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package sharepoint
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	// Downloads are served from another host, with pre-authenticated URLs.
+	downloads := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "signed", r.URL.Query().Get("tempauth"))
+		switch r.URL.Path {
+		case "/config.yml":
+			_, _ = fmt.Fprint(w, "api_key: from-root")
+		case "/nested.txt":
+			_, _ = fmt.Fprint(w, "password=from-folder")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(downloads.Close)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer graph-token", r.Header.Get("Authorization"))
+			_, _ = fmt.Fprint(w, body)
+		})
+	}
+
+	handle("/v1.0/sites", `{"value": [
+		{"id": "contoso.sharepoint.com,1,2", "displayName": "Engineering", "webUrl": "https://contoso.sharepoint.com/sites/Eng"},
+		{"id": "contoso.sharepoint.com,3,4", "displayName": "Sales", "webUrl": "https://contoso.sharepoint.com/sites/Sales"}
+	]}`)
+	handle("/v1.0/sites/contoso.sharepoint.com:/sites/Eng", `{"id": "contoso.sharepoint.com,1,2", "displayName": "Engineering", "webUrl": "https://contoso.sharepoint.com/sites/Eng"}`)
+	handle("/v1.0/sites/contoso.sharepoint.com,1,2/drives", `{"value": [{"id": "drive-docs", "name": "Documents"}]}`)
+	handle("/v1.0/sites/contoso.sharepoint.com,3,4/drives", `{"value": [{"id": "drive-sales", "name": "Documents"}]}`)
+	handle("/v1.0/users", `{"value": [{"id": "user-1", "userPrincipalName": "ada@contoso.com"}, {"id": "user-2", "userPrincipalName": "bot@contoso.com"}]}`)
+	handle("/v1.0/users/user-1/drive", `{"id": "drive-ada", "name": "OneDrive"}`)
+	mux.HandleFunc("/v1.0/users/user-2/drive", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"error": {"code": "ResourceNotFound", "message": "User's mysite not found."}}`)
+	})
+
+	handle("/v1.0/drives/drive-docs", `{"id": "drive-docs", "name": "Documents"}`)
+	handle("/v1.0/drives/drive-docs/root", `{"id": "root-id", "sharepointIds": {"siteUrl": "https://contoso.sharepoint.com/sites/Eng"}}`)
+	handle("/v1.0/drives/drive-docs/items/root-id/children", fmt.Sprintf(`{"value": [
+		{"id": "folder-1", "name": "infra", "folder": {"childCount": 1}},
+		{"id": "file-1", "name": "config.yml", "size": 18, "file": {}, "webUrl": "https://contoso.sharepoint.com/sites/Eng/Shared%%20Documents/config.yml",
+		 "lastModifiedDateTime": "2024-05-01T10:00:00Z", "lastModifiedBy": {"user": {"displayName": "Ada", "email": "ada@contoso.com"}},
+		 "@microsoft.graph.downloadUrl": "%[1]s/config.yml?tempauth=signed"},
+		{"id": "file-2", "name": "logo.png", "size": 1024, "file": {}}
+	]}`, downloads.URL))
+	handle("/v1.0/drives/drive-docs/items/folder-1/children", fmt.Sprintf(`{"value": [
+		{"id": "file-3", "name": "nested.txt", "size": 20, "file": {}, "@microsoft.graph.downloadUrl": "%s/nested.txt?tempauth=signed"}
+	]}`, downloads.URL))
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.Sharepoint) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - sharepoint", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name string
+		conn *sourcespb.Sharepoint
+		want []string
+	}{
+		{
+			name: "all sites",
+			conn: &sourcespb.Sharepoint{},
+			want: []string{"drive-docs", "drive-sales"},
+		},
+		{
+			name: "site URL with OneDrive",
+			conn: &sourcespb.Sharepoint{SiteUrl: "https://contoso.sharepoint.com/sites/Eng/", IncludeOnedrive: true},
+			want: []string{"drive-docs", "drive-ada"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conn.Endpoint = server.URL + "/v1.0"
+			tt.conn.Credential = &sourcespb.Sharepoint_Token{Token: "graph-token"}
+			s := initSource(t, tt.conn)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+
+			var got []string
+			for _, unit := range reporter.Units {
+				id, kind := unit.SourceUnitID()
+				assert.Equal(t, unitDrive, kind)
+				got = append(got, id)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.Sharepoint{
+		Endpoint:   server.URL + "/v1.0",
+		Credential: &sourcespb.Sharepoint_Token{Token: "graph-token"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitDrive, ID: "drive-docs"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	require.Len(t, reporter.Chunks, 2)
+
+	root, nested := reporter.Chunks[0], reporter.Chunks[1]
+	assert.Equal(t, "api_key: from-root", string(root.Data))
+	meta := root.SourceMetadata.GetSharepoint()
+	assert.Equal(t, "config.yml", meta.GetTitle())
+	assert.Equal(t, "file-1", meta.GetDocid())
+	assert.Equal(t, "Documents/config.yml", meta.GetPath())
+	assert.Equal(t, "https://contoso.sharepoint.com/sites/Eng", meta.GetSite())
+	assert.Equal(t, "https://contoso.sharepoint.com/sites/Eng/Shared%20Documents/config.yml", meta.GetLink())
+	assert.Equal(t, "Ada", meta.GetAuthor())
+	assert.Equal(t, "ada@contoso.com", meta.GetEmail())
+	assert.Equal(t, "2024-05-01T10:00:00Z", meta.GetTimestamp())
+
+	assert.Equal(t, "password=from-folder", string(nested.Data))
+	assert.Equal(t, "Documents/infra/nested.txt", nested.SourceMetadata.GetSharepoint().GetPath())
+}
+
+func TestSitePath(t *testing.T) {
+	tests := []struct {
+		siteURL string
+		want    string
+		wantErr bool
+	}{
+		{siteURL: "https://contoso.sharepoint.com", want: "/sites/contoso.sharepoint.com?$select=id,displayName,webUrl"},
+		{siteURL: "https://contoso.sharepoint.com/sites/Eng/", want: "/sites/contoso.sharepoint.com:/sites/Eng?$select=id,displayName,webUrl"},
+		{siteURL: "Eng", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.siteURL, func(t *testing.T) {
+			got, err := sitePath(tt.siteURL)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
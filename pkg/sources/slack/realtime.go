@@ -0,0 +1,189 @@
+package slack
+
+import (
+	"fmt"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const RealtimeSourceType = sourcespb.SourceType_SOURCE_TYPE_SLACK_REALTIME
+
+// RealtimeSource scans Slack messages as they are posted. It receives Events
+// API events over a Socket Mode connection, which requires an app-level token
+// with the connections:write scope and a bot token to read users and files.
+type RealtimeSource struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	appToken string
+	channels *channelSelector
+	ws       *workspace
+	chunker  *chunker
+
+	sources.Progress
+}
+
+// Ensure the RealtimeSource satisfies the interface at compile time.
+var _ sources.Source = (*RealtimeSource)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *RealtimeSource) Type() sourcespb.SourceType {
+	return RealtimeSourceType
+}
+
+func (s *RealtimeSource) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *RealtimeSource) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Slack real-time source.
+func (s *RealtimeSource) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.SlackRealtime
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	tokens := conn.GetTokens()
+	if tokens.GetAppToken() == "" || tokens.GetBotToken() == "" {
+		return fmt.Errorf("an app-level token and a bot token are required")
+	}
+	s.appToken = tokens.GetAppToken()
+
+	s.ws = newWorkspace(slackapi.New(tokens.GetBotToken(), slackapi.OptionAppLevelToken(s.appToken)))
+	s.chunker = &chunker{
+		sourceType: s.Type(),
+		sourceName: s.name,
+		sourceID:   s.sourceID,
+		jobID:      s.jobID,
+		verify:     s.verify,
+		ws:         s.ws,
+	}
+
+	channels, err := newChannelSelector(conn.GetChannels(), conn.GetIgnoreList())
+	if err != nil {
+		return err
+	}
+	s.channels = channels
+
+	return nil
+}
+
+// Chunks listens for new messages and emits their chunks over a channel until
+// the context is cancelled.
+func (s *RealtimeSource) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	client := socketmode.New(s.ws.api)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.RunContext(ctx)
+	}()
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	var received int
+	for {
+		select {
+		case <-ctx.Done():
+			s.SetProgressComplete(received, received, "Stopped listening for Slack events", "")
+			return nil
+		case err := <-errCh:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("slack socket mode connection failed: %w", err)
+		case evt := <-client.Events:
+			switch evt.Type {
+			case socketmode.EventTypeConnected:
+				ctx.Logger().Info("listening for Slack events")
+			case socketmode.EventTypeConnectionError:
+				ctx.Logger().Error(fmt.Errorf("%v", evt.Data), "slack socket mode connection error, reconnecting")
+			case socketmode.EventTypeInvalidAuth:
+				return fmt.Errorf("invalid Slack app-level token")
+			case socketmode.EventTypeEventsAPI:
+				if evt.Request != nil {
+					client.Ack(*evt.Request)
+				}
+				event, ok := evt.Data.(slackevents.EventsAPIEvent)
+				if !ok {
+					continue
+				}
+				if err := s.handleEvent(ctx, event.InnerEvent.Data, reporter); err != nil {
+					return err
+				}
+				received++
+				s.SetProgressComplete(received, received, fmt.Sprintf("Received %d Slack events", received), "")
+			}
+		}
+	}
+}
+
+// handleEvent scans the message carried by an Events API event. Edited
+// messages are scanned again, while other events, and the messages of
+// conversations that aren't selected, are ignored.
+func (s *RealtimeSource) handleEvent(ctx context.Context, data any, reporter sources.ChunkReporter) error {
+	ev, ok := data.(*slackevents.MessageEvent)
+	if !ok {
+		return nil
+	}
+
+	switch ev.SubType {
+	case "message_changed":
+		if ev.Message == nil {
+			return nil
+		}
+		ev.Message.Channel = ev.Channel
+		ev = ev.Message
+	case "message_deleted":
+		return nil
+	}
+
+	ch, err := s.ws.channel(ctx, ev.Channel)
+	if err != nil {
+		// The message is still worth scanning, even without a channel name.
+		ctx.Logger().V(2).Info("could not get conversation info", "channel", ev.Channel, "error", err)
+		ch.ID = ev.Channel
+	}
+	if !s.channels.shouldScan(ch) {
+		ctx.Logger().V(3).Info("skipping message of conversation", "channel", ch.ID, "name", ch.Name)
+		return nil
+	}
+
+	location := locationMessage
+	if ev.ThreadTimeStamp != "" && ev.ThreadTimeStamp != ev.TimeStamp {
+		location = locationReply
+	}
+	return s.chunker.reportMessage(ctx, ch, ev.Channel, newEventMessage(ev), location, reporter)
+}
+
+func newEventMessage(ev *slackevents.MessageEvent) message {
+	m := slackapi.Message{Msg: slackapi.Msg{
+		User:            ev.User,
+		Text:            ev.Text,
+		Timestamp:       ev.TimeStamp,
+		ThreadTimestamp: ev.ThreadTimeStamp,
+		Attachments:     ev.Attachments,
+	}}
+	msg := newMessage(m)
+	for _, f := range ev.Files {
+		msg.files = append(msg.files, file{id: f.ID, name: f.Name, downloadURL: f.URLPrivateDownload, size: f.Size})
+	}
+	return msg
+}
@@ -0,0 +1,322 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	slackapi "github.com/slack-go/slack"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_SLACK
+
+	unitChannel sources.SourceUnitKind = "channel"
+
+	pageLimit = 200
+)
+
+// conversationTypes are the kinds of conversations scanned by the history
+// source, provided the token can read them.
+var conversationTypes = []string{"public_channel", "private_channel", "mpim", "im"}
+
+// Source scans the history of a Slack workspace.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	channels *channelSelector
+	ws       *workspace
+	chunker  *chunker
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Slack source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Slack
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	var token string
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Slack_Token:
+		token = cred.Token
+	case *sourcespb.Slack_Tokens:
+		// A user token can read every conversation the user is in, while a bot
+		// token is limited to the conversations the bot was added to.
+		token = cred.Tokens.GetClientToken()
+		if token == "" {
+			token = cred.Tokens.GetBotToken()
+		}
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if token == "" {
+		return fmt.Errorf("token is empty")
+	}
+
+	var opts []slackapi.Option
+	if endpoint := conn.GetEndpoint(); endpoint != "" {
+		opts = append(opts, slackapi.OptionAPIURL(apiURL(endpoint)))
+	}
+	s.ws = newWorkspace(slackapi.New(token, opts...))
+	s.chunker = &chunker{
+		sourceType: s.Type(),
+		sourceName: s.name,
+		sourceID:   s.sourceID,
+		jobID:      s.jobID,
+		verify:     s.verify,
+		ws:         s.ws,
+	}
+
+	channels, err := newChannelSelector(conn.GetChannels(), conn.GetIgnoreList())
+	if err != nil {
+		return err
+	}
+	s.channels = channels
+
+	return nil
+}
+
+// channelSelector selects the conversations to scan: the wanted channels, if
+// any, except the ones that match the ignore list. Channels are given by ID or
+// name, with or without the leading "#" of names.
+type channelSelector struct {
+	wanted map[string]struct{}
+	filter *glob.Filter
+}
+
+func newChannelSelector(channels, ignoreList []string) (*channelSelector, error) {
+	wanted := make(map[string]struct{}, len(channels))
+	for _, ch := range channels {
+		wanted[strings.TrimPrefix(ch, "#")] = struct{}{}
+	}
+
+	patterns := make([]string, 0, len(ignoreList))
+	for _, pattern := range ignoreList {
+		patterns = append(patterns, strings.TrimPrefix(pattern, "#"))
+	}
+	filter, err := glob.NewGlobFilter(glob.WithExcludeGlobs(patterns...))
+	if err != nil {
+		return nil, fmt.Errorf("could not compile channel patterns: %w", err)
+	}
+	return &channelSelector{wanted: wanted, filter: filter}, nil
+}
+
+// shouldScan reports whether the conversation is selected. The name of
+// conversations is empty when it couldn't be fetched.
+func (c *channelSelector) shouldScan(ch slackapi.Channel) bool {
+	if len(c.wanted) > 0 {
+		_, byID := c.wanted[ch.ID]
+		_, byName := c.wanted[ch.Name]
+		if !byID && (ch.Name == "" || !byName) {
+			return false
+		}
+	}
+	return c.filter.ShouldInclude(ch.ID) && (ch.Name == "" || c.filter.ShouldInclude(ch.Name))
+}
+
+// apiURL returns the Web API URL of a Slack endpoint.
+func apiURL(endpoint string) string {
+	endpoint = strings.TrimRight(endpoint, "/")
+	if !strings.HasSuffix(endpoint, "/api") {
+		endpoint += "/api"
+	}
+	return endpoint + "/"
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned conversation: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Slack scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every conversation visible to the token,
+// restricted to the configured channels if any. Channels can be given by ID or
+// name. Channels whose ID or name match the ignore list are skipped.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	params := &slackapi.GetConversationsParameters{Types: conversationTypes, Limit: pageLimit}
+	for {
+		var (
+			channels []slackapi.Channel
+			cursor   string
+		)
+		err := withRetry(ctx, func() (err error) {
+			channels, cursor, err = s.ws.api.GetConversationsContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("could not list conversations: %w", err)
+		}
+
+		for _, ch := range channels {
+			if !s.channels.shouldScan(ch) {
+				ctx.Logger().V(3).Info("skipping conversation", "channel", ch.ID, "name", ch.Name)
+				continue
+			}
+
+			s.ws.cacheChannel(ch)
+			if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitChannel, ID: ch.ID}); err != nil {
+				return err
+			}
+		}
+
+		if cursor == "" {
+			return nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+// ChunkUnit scans the messages, thread replies and files of a conversation.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	channelID, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "channel", channelID)
+
+	ch, err := s.ws.channel(ctx, channelID)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	ctx.Logger().V(2).Info("scanning conversation", "name", ch.Name)
+
+	params := &slackapi.GetConversationHistoryParameters{ChannelID: channelID, Limit: pageLimit}
+	for {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		var resp *slackapi.GetConversationHistoryResponse
+		err := withRetry(ctx, func() (err error) {
+			resp, err = s.ws.api.GetConversationHistoryContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("could not get conversation history: %w", err))
+		}
+
+		for _, m := range resp.Messages {
+			if err := s.chunker.reportMessage(ctx, ch, channelID, newMessage(m), locationMessage, reporter); err != nil {
+				return err
+			}
+			if m.ReplyCount > 0 {
+				if err := s.scanThread(ctx, ch, channelID, m.Timestamp, reporter); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !resp.HasMore || resp.ResponseMetaData.NextCursor == "" {
+			return nil
+		}
+		params.Cursor = resp.ResponseMetaData.NextCursor
+	}
+}
+
+// scanThread scans the replies to a message. The parent message, which is
+// part of the replies, was already scanned with the conversation history.
+func (s *Source) scanThread(ctx context.Context, ch slackapi.Channel, channelID, threadTS string, reporter sources.ChunkReporter) error {
+	params := &slackapi.GetConversationRepliesParameters{ChannelID: channelID, Timestamp: threadTS, Limit: pageLimit}
+	for {
+		var (
+			replies []slackapi.Message
+			hasMore bool
+			cursor  string
+		)
+		err := withRetry(ctx, func() (err error) {
+			replies, hasMore, cursor, err = s.ws.api.GetConversationRepliesContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("could not get replies of %s: %w", threadTS, err))
+		}
+
+		for _, m := range replies {
+			if m.Timestamp == threadTS {
+				continue
+			}
+			if err := s.chunker.reportMessage(ctx, ch, channelID, newMessage(m), locationReply, reporter); err != nil {
+				return err
+			}
+		}
+
+		if !hasMore || cursor == "" {
+			return nil
+		}
+		params.Cursor = cursor
+	}
+}
@@ -0,0 +1,256 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/auth.test", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"ok": true, "url": "https://acme.slack.com/"}`)
+	})
+	mux.HandleFunc("/api/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "xoxp-user", r.FormValue("token"))
+		if r.FormValue("cursor") == "next" {
+			_, _ = fmt.Fprint(w, `{"ok": true, "channels": [{"id": "G2", "name": "secret-ops", "is_private": true}]}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"ok": true, "channels": [
+			{"id": "C1", "name": "incidents"},
+			{"id": "C3", "name": "random"}
+		], "response_metadata": {"next_cursor": "next"}}`)
+	})
+	mux.HandleFunc("/api/conversations.info", func(w http.ResponseWriter, r *http.Request) {
+		names := map[string]string{"C1": "incidents", "C3": "random", "G2": "secret-ops"}
+		id := r.FormValue("channel")
+		_, _ = fmt.Fprintf(w, `{"ok": true, "channel": {"id": %q, "name": %q, "is_ext_shared": true}}`, id, names[id])
+	})
+	mux.HandleFunc("/api/conversations.history", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		if r.FormValue("cursor") == "page2" {
+			_, _ = fmt.Fprintf(w, `{"ok": true, "has_more": false, "messages": [
+				{"type": "message", "user": "U2", "text": "", "ts": "1700000002.000300", "files": [
+					{"id": "F1", "name": "creds.env", "size": 16, "url_private_download": "%s/files/F1/creds.env"}
+				]}
+			]}`, server.URL)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"ok": true, "has_more": true, "response_metadata": {"next_cursor": "page2"}, "messages": [
+			{"type": "message", "user": "U1", "text": "the token is abc", "ts": "1700000000.000100", "thread_ts": "1700000000.000100", "reply_count": 1,
+			 "attachments": [{"text": "attached text"}]}
+		]}`)
+	})
+	mux.HandleFunc("/api/conversations.replies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1700000000.000100", r.FormValue("ts"))
+		_, _ = fmt.Fprint(w, `{"ok": true, "has_more": false, "messages": [
+			{"type": "message", "user": "U1", "text": "the token is abc", "ts": "1700000000.000100", "thread_ts": "1700000000.000100"},
+			{"type": "message", "user": "U2", "text": "rotated", "ts": "1700000001.000200", "thread_ts": "1700000000.000100"}
+		]}`)
+	})
+	mux.HandleFunc("/api/users.info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"ok": true, "user": {"id": %[1]q, "profile": {"email": "%[1]s@example.com"}}}`, r.FormValue("user"))
+	})
+	mux.HandleFunc("/files/F1/creds.env", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer xoxp-user", r.Header.Get("Authorization"))
+		_, _ = fmt.Fprint(w, "SECRET=from-file")
+	})
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.Slack) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - slack", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func unitIDs(units []sources.SourceUnit) []string {
+	ids := make([]string, 0, len(units))
+	for _, unit := range units {
+		id, _ := unit.SourceUnitID()
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name     string
+		channels []string
+		ignore   []string
+		want     []string
+	}{
+		{name: "all", want: []string{"C1", "C3", "G2"}},
+		{name: "ignore", ignore: []string{"#rand*", "G2"}, want: []string{"C1"}},
+		{name: "by id or name", channels: []string{"#secret-ops", "C3"}, want: []string{"C3", "G2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, &sourcespb.Slack{
+				Endpoint: server.URL,
+				Credential: &sourcespb.Slack_Tokens{
+					Tokens: &credentialspb.SlackTokens{BotToken: "xoxb-bot", ClientToken: "xoxp-user"},
+				},
+				Channels:   tt.channels,
+				IgnoreList: tt.ignore,
+			})
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+			assert.Equal(t, tt.want, unitIDs(reporter.Units))
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+
+	s := initSource(t, &sourcespb.Slack{
+		Endpoint:   server.URL + "/api/",
+		Credential: &sourcespb.Slack_Token{Token: "xoxp-user"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(context.Background(), sources.CommonSourceUnit{Kind: unitChannel, ID: "C1"}, &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	require.Len(t, reporter.Chunks, 3)
+
+	var got []*source_metadatapb.Slack
+	data := make(map[string]string)
+	for _, chunk := range reporter.Chunks {
+		meta := chunk.SourceMetadata.GetSlack()
+		got = append(got, meta)
+		data[meta.GetLocation()] = string(chunk.Data)
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i].GetLink() < got[j].GetLink() })
+
+	assert.Equal(t, map[string]string{
+		locationMessage: "the token is abc\nattached text",
+		locationReply:   "rotated",
+		locationFile:    "SECRET=from-file",
+	}, data)
+
+	assert.Equal(t, "https://acme.slack.com/archives/C1/p1700000000000100", got[0].GetLink())
+	assert.Equal(t, "U1@example.com", got[0].GetEmail())
+	assert.Equal(t, "incidents", got[0].GetChannelName())
+	assert.Equal(t, source_metadatapb.Visibility_shared, got[0].GetVisibility())
+	assert.Equal(t, "2023-11-14 22:13:20 +0000 UTC", got[0].GetTimestamp())
+	assert.Equal(t, "https://acme.slack.com/archives/C1/p1700000001000200?thread_ts=1700000000.000100&cid=C1", got[1].GetLink())
+	assert.Equal(t, "https://acme.slack.com/archives/C1/p1700000002000300", got[2].GetLink())
+	assert.Equal(t, "creds.env", got[2].GetFile())
+}
+
+func TestRealtimeHandleEvent(t *testing.T) {
+	server := newTestServer(t)
+
+	s := &RealtimeSource{}
+	conn, err := anypb.New(&sourcespb.SlackRealtime{
+		Credential: &sourcespb.SlackRealtime_Tokens{
+			Tokens: &credentialspb.SlackTokens{AppToken: "xapp-app", BotToken: "xoxp-user"},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - slack realtime", 0, 0, false, conn, 1))
+	// Point the Web API client at the test server.
+	s.ws.api = newTestAPI("xoxp-user", server.URL)
+
+	events := []any{
+		&slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "new token abc", TimeStamp: "1700000000.000100"},
+		&slackevents.MessageEvent{Channel: "C1", SubType: "message_changed", Message: &slackevents.MessageEvent{
+			User: "U1", Text: "edited token def", TimeStamp: "1700000000.000100",
+		}},
+		&slackevents.MessageEvent{Channel: "C1", SubType: "message_deleted", DeletedTimeStamp: "1700000000.000100"},
+		&slackevents.AppMentionEvent{Channel: "C1", Text: "ignored"},
+	}
+
+	reporter := sourcestest.TestReporter{}
+	for _, ev := range events {
+		require.NoError(t, s.handleEvent(context.Background(), ev, &reporter))
+	}
+	require.Len(t, reporter.Chunks, 2)
+	assert.Equal(t, "new token abc", string(reporter.Chunks[0].Data))
+	assert.Equal(t, "edited token def", string(reporter.Chunks[1].Data))
+	for _, chunk := range reporter.Chunks {
+		assert.Equal(t, RealtimeSourceType, chunk.SourceType)
+		meta := chunk.SourceMetadata.GetSlack()
+		assert.Equal(t, "C1", meta.GetChannelId())
+		assert.Equal(t, "incidents", meta.GetChannelName())
+		assert.Equal(t, "https://acme.slack.com/archives/C1/p1700000000000100", meta.GetLink())
+	}
+}
+
+func TestRealtimeHandleEvent_Channels(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name     string
+		channels []string
+		ignore   []string
+		want     []string
+	}{
+		{name: "all", want: []string{"C1", "C3", "G2"}},
+		{name: "ignore", ignore: []string{"#rand*", "G2"}, want: []string{"C1"}},
+		{name: "by id or name", channels: []string{"#secret-ops", "C3"}, want: []string{"C3", "G2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &RealtimeSource{}
+			conn, err := anypb.New(&sourcespb.SlackRealtime{
+				Credential: &sourcespb.SlackRealtime_Tokens{
+					Tokens: &credentialspb.SlackTokens{AppToken: "xapp-app", BotToken: "xoxp-user"},
+				},
+				Channels:   tt.channels,
+				IgnoreList: tt.ignore,
+			})
+			require.NoError(t, err)
+			require.NoError(t, s.Init(context.Background(), "test - slack realtime", 0, 0, false, conn, 1))
+			s.ws.api = newTestAPI("xoxp-user", server.URL)
+
+			reporter := sourcestest.TestReporter{}
+			for _, channel := range []string{"C1", "C3", "G2"} {
+				ev := &slackevents.MessageEvent{Channel: channel, User: "U1", Text: "token", TimeStamp: "1700000000.000100"}
+				require.NoError(t, s.handleEvent(context.Background(), ev, &reporter))
+			}
+			var got []string
+			for _, chunk := range reporter.Chunks {
+				got = append(got, chunk.SourceMetadata.GetSlack().GetChannelId())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newTestAPI(token, endpoint string) *slackapi.Client {
+	return slackapi.New(token, slackapi.OptionAPIURL(apiURL(endpoint)))
+}
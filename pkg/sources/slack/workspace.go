@@ -0,0 +1,313 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultMaxFileSize = 250 * 1024 * 1024 // 250 MiB
+
+	// Locations of the scanned data within a conversation.
+	locationMessage = "message"
+	locationReply   = "thread_reply"
+	locationFile    = "file"
+)
+
+// message is a Slack message, as returned by the Web API or delivered by the
+// Events API.
+type message struct {
+	user     string
+	text     string
+	ts       string
+	threadTS string
+	files    []file
+}
+
+type file struct {
+	id          string
+	name        string
+	downloadURL string
+	size        int
+}
+
+func newMessage(m slackapi.Message) message {
+	text := []string{m.Text}
+	for _, a := range m.Attachments {
+		for _, part := range []string{a.Pretext, a.Title, a.Text, a.Fallback} {
+			if part != "" {
+				text = append(text, part)
+			}
+		}
+	}
+
+	msg := message{
+		user:     m.User,
+		text:     strings.TrimSpace(strings.Join(text, "\n")),
+		ts:       m.Timestamp,
+		threadTS: m.ThreadTimestamp,
+	}
+	for _, f := range m.Files {
+		msg.files = append(msg.files, file{id: f.ID, name: f.Name, downloadURL: f.URLPrivateDownload, size: f.Size})
+	}
+	return msg
+}
+
+// workspace wraps a Slack Web API client with the lookups needed to describe
+// the scanned messages, caching their results.
+type workspace struct {
+	api *slackapi.Client
+
+	urlOnce sync.Once
+	url     string
+
+	mu       sync.Mutex
+	emails   map[string]string
+	channels map[string]slackapi.Channel
+}
+
+func newWorkspace(api *slackapi.Client) *workspace {
+	return &workspace{
+		api:      api,
+		emails:   make(map[string]string),
+		channels: make(map[string]slackapi.Channel),
+	}
+}
+
+// withRetry calls fn, waiting and retrying for as long as Slack rate limits
+// the request.
+func withRetry(ctx context.Context, fn func() error) error {
+	for {
+		err := fn()
+		var rateLimited *slackapi.RateLimitedError
+		if !errors.As(err, &rateLimited) {
+			return err
+		}
+		ctx.Logger().V(3).Info("rate limited by Slack, waiting", "retry_after", rateLimited.RetryAfter)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rateLimited.RetryAfter):
+		}
+	}
+}
+
+// baseURL returns the URL of the workspace, e.g. https://acme.slack.com/. It
+// is empty if the workspace couldn't be identified.
+func (w *workspace) baseURL(ctx context.Context) string {
+	w.urlOnce.Do(func() {
+		var resp *slackapi.AuthTestResponse
+		err := withRetry(ctx, func() (err error) {
+			resp, err = w.api.AuthTestContext(ctx)
+			return err
+		})
+		if err != nil {
+			ctx.Logger().Error(err, "could not identify the Slack workspace, links will be omitted")
+			return
+		}
+		w.url = strings.TrimRight(resp.URL, "/") + "/"
+	})
+	return w.url
+}
+
+// email returns the email address of a user, if the token is allowed to read
+// it.
+func (w *workspace) email(ctx context.Context, userID string) string {
+	if userID == "" {
+		return ""
+	}
+
+	w.mu.Lock()
+	email, ok := w.emails[userID]
+	w.mu.Unlock()
+	if ok {
+		return email
+	}
+
+	var user *slackapi.User
+	err := withRetry(ctx, func() (err error) {
+		user, err = w.api.GetUserInfoContext(ctx, userID)
+		return err
+	})
+	if err != nil {
+		ctx.Logger().V(3).Info("could not get user info", "user", userID, "error", err)
+	} else {
+		email = user.Profile.Email
+	}
+
+	w.mu.Lock()
+	w.emails[userID] = email
+	w.mu.Unlock()
+	return email
+}
+
+// channel returns a conversation, from the cache when possible.
+func (w *workspace) channel(ctx context.Context, channelID string) (slackapi.Channel, error) {
+	w.mu.Lock()
+	ch, ok := w.channels[channelID]
+	w.mu.Unlock()
+	if ok {
+		return ch, nil
+	}
+
+	var info *slackapi.Channel
+	err := withRetry(ctx, func() (err error) {
+		info, err = w.api.GetConversationInfoContext(ctx, &slackapi.GetConversationInfoInput{ChannelID: channelID})
+		return err
+	})
+	if err != nil {
+		return slackapi.Channel{}, fmt.Errorf("could not get conversation %s: %w", channelID, err)
+	}
+
+	w.cacheChannel(*info)
+	return *info, nil
+}
+
+func (w *workspace) cacheChannel(ch slackapi.Channel) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.channels[ch.ID] = ch
+}
+
+// permalink crafts the link to a message, or to a reply within its thread.
+func (w *workspace) permalink(ctx context.Context, channelID, ts, threadTS string) string {
+	base := w.baseURL(ctx)
+	if base == "" || ts == "" {
+		return ""
+	}
+	link := base + "archives/" + channelID + "/p" + strings.ReplaceAll(ts, ".", "")
+	if threadTS != "" && threadTS != ts {
+		link += "?thread_ts=" + threadTS + "&cid=" + channelID
+	}
+	return link
+}
+
+// visibility returns who can see the messages of a conversation.
+func visibility(ch slackapi.Channel) source_metadatapb.Visibility {
+	switch {
+	case ch.ID == "":
+		return source_metadatapb.Visibility_unknown
+	case ch.IsExtShared:
+		return source_metadatapb.Visibility_shared
+	case ch.IsPrivate || ch.IsIM || ch.IsMpIM:
+		return source_metadatapb.Visibility_private
+	default:
+		return source_metadatapb.Visibility_public
+	}
+}
+
+// messageTime converts a message timestamp to a readable time.
+func messageTime(ts string) string {
+	seconds, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return ts
+	}
+	return time.Unix(int64(seconds), 0).UTC().String()
+}
+
+// chunker turns Slack messages and files into chunks. It is shared by the
+// history and real-time sources.
+type chunker struct {
+	sourceType sourcespb.SourceType
+	sourceName string
+	sourceID   sources.SourceID
+	jobID      sources.JobID
+	verify     bool
+	ws         *workspace
+}
+
+func (c *chunker) metadata(ctx context.Context, ch slackapi.Channel, channelID string, msg message, location string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Slack{
+			Slack: &source_metadatapb.Slack{
+				ChannelId:   channelID,
+				ChannelName: sanitizer.UTF8(ch.Name),
+				Timestamp:   messageTime(msg.ts),
+				UserId:      msg.user,
+				Email:       sanitizer.UTF8(c.ws.email(ctx, msg.user)),
+				Link:        c.ws.permalink(ctx, channelID, msg.ts, msg.threadTS),
+				Visibility:  visibility(ch),
+				Location:    location,
+			},
+		},
+	}
+}
+
+// reportMessage reports the text of a message and scans its files.
+func (c *chunker) reportMessage(ctx context.Context, ch slackapi.Channel, channelID string, msg message, location string, reporter sources.ChunkReporter) error {
+	if msg.text != "" {
+		chunk := sources.Chunk{
+			SourceType:     c.sourceType,
+			SourceName:     c.sourceName,
+			SourceID:       c.sourceID,
+			JobID:          c.jobID,
+			Data:           []byte(msg.text),
+			SourceMetadata: c.metadata(ctx, ch, channelID, msg, location),
+			Verify:         c.verify,
+		}
+		if err := reporter.ChunkOk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range msg.files {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := c.scanFile(ctx, ch, channelID, msg, f, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *chunker) scanFile(ctx context.Context, ch slackapi.Channel, channelID string, msg message, f file, reporter sources.ChunkReporter) error {
+	if f.downloadURL == "" {
+		return nil
+	}
+	if f.size > defaultMaxFileSize {
+		ctx.Logger().V(3).Info("skipping file over the size limit", "file", f.name, "size", f.size)
+		return nil
+	}
+	ctx.Logger().V(3).Info("scanning file", "file", f.name)
+
+	metadata := c.metadata(ctx, ch, channelID, msg, locationFile)
+	metadata.GetSlack().File = sanitizer.UTF8(f.name)
+	chunkSkel := &sources.Chunk{
+		SourceType:     c.sourceType,
+		SourceName:     c.sourceName,
+		SourceID:       c.sourceID,
+		JobID:          c.jobID,
+		SourceMetadata: metadata,
+		Verify:         c.verify,
+	}
+
+	// Stream the download into the handlers rather than buffering the file.
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(c.ws.api.GetFileContext(ctx, f.downloadURL, pw))
+	}()
+	defer pr.Close()
+
+	if err := handlers.HandleFile(ctx, pr, chunkSkel, reporter); err != nil {
+		return fmt.Errorf("could not scan file %q: %w", f.name, err)
+	}
+	return nil
+}
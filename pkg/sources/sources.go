@@ -144,6 +144,208 @@ type SourceUnit interface {
 	Display() string
 }
 
+// ArtifactoryConfig defines the optional configuration for an Artifactory source.
+type ArtifactoryConfig struct {
+	// Endpoint is the base URL of the Artifactory instance, including its context path.
+	Endpoint string
+	// Token is the access token to authenticate with.
+	Token string
+	// Username is the username to authenticate with.
+	Username string
+	// Password is the password or API key to use with the username.
+	Password string
+	// Repos is the list of repositories to scan. All visible repositories are scanned when empty.
+	Repos []string
+	// IncludeRepos is a list of repository globs to include in the scan.
+	IncludeRepos []string
+	// ExcludeRepos is a list of repository globs to exclude from the scan.
+	ExcludeRepos []string
+	// IncludePaths is a list of artifact path globs to include in the scan.
+	IncludePaths []string
+	// ExcludePaths is a list of artifact path globs to exclude from the scan.
+	ExcludePaths []string
+	// MaxArtifactSize is the maximum size of artifacts to scan.
+	MaxArtifactSize int64
+	// SkipArchives allows skipping archived artifacts from the scan.
+	SkipArchives bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// AzureReposConfig defines the optional configuration for an Azure Repos source.
+type AzureReposConfig struct {
+	// Endpoint is the endpoint of the source. Azure DevOps Services is used when empty.
+	Endpoint string
+	// Token is the personal access token to authenticate with.
+	Token string
+	// Organizations is the list of organizations (or Azure DevOps Server collections) to scan.
+	Organizations []string
+	// Projects is the list of projects to scan. All visible projects are scanned when empty.
+	Projects []string
+	// Repos is the list of repositories to scan.
+	Repos []string
+	// IncludeProjects is a list of project globs to include in the scan.
+	IncludeProjects []string
+	// ExcludeProjects is a list of project globs to exclude from the scan.
+	ExcludeProjects []string
+	// IncludeRepos is a list of repository globs to include in the scan.
+	IncludeRepos []string
+	// ExcludeRepos is a list of repository globs to exclude from the scan.
+	ExcludeRepos []string
+	// IncludeForks indicates whether to include forked repositories in the scan.
+	IncludeForks bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// GiteaConfig defines the optional configuration for a Gitea source.
+type GiteaConfig struct {
+	// Endpoint is the endpoint of the Gitea (or Forgejo) instance.
+	Endpoint string
+	// Token is the access token to authenticate with. Public repositories are scanned when empty.
+	Token string
+	// Repos is the list of repositories to scan.
+	Repos []string
+	// Organizations is the list of organizations to scan.
+	Organizations []string
+	// Users is the list of users whose repositories to scan.
+	Users []string
+	// IncludeRepos is a list of repository globs to include in the scan.
+	IncludeRepos []string
+	// ExcludeRepos is a list of repository globs to exclude from the scan.
+	ExcludeRepos []string
+	// IncludeForks indicates whether to include forked repositories in the scan.
+	IncludeForks bool
+	// IncludeIssueComments indicates whether to include issues and their comments in the scan.
+	IncludeIssueComments bool
+	// IncludePRComments indicates whether to include pull requests and their comments in the scan.
+	IncludePRComments bool
+	// IncludeWikis indicates whether to include repository wikis in the scan.
+	IncludeWikis bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// AzureStorageConfig defines the optional configuration for an Azure Blob Storage source.
+type AzureStorageConfig struct {
+	// ConnectionString is the storage account connection string to authenticate with.
+	ConnectionString string
+	// AccountName is the name of the storage account.
+	AccountName string
+	// AccountKey is the shared key of the storage account.
+	AccountKey string
+	// SASToken is the shared access signature to authenticate with.
+	SASToken string
+	// TenantID is the tenant of the service principal to authenticate with.
+	TenantID string
+	// ClientID is the client ID of the service principal to authenticate with.
+	ClientID string
+	// ClientSecret is the client secret of the service principal to authenticate with.
+	ClientSecret string
+	// WithoutAuth is a flag to indicate whether to scan public containers without authentication.
+	WithoutAuth bool
+	// Endpoint overrides the blob service URL of the storage account.
+	Endpoint string
+	// Containers is the list of containers to scan. All containers are scanned when empty.
+	Containers []string
+	// IncludeContainers is a list of container globs to include in the scan.
+	IncludeContainers []string
+	// ExcludeContainers is a list of container globs to exclude from the scan.
+	ExcludeContainers []string
+	// IncludeBlobs is a list of blob globs to include in the scan.
+	IncludeBlobs []string
+	// ExcludeBlobs is a list of blob globs to exclude from the scan.
+	ExcludeBlobs []string
+	// MaxObjectSize is the maximum blob size to scan.
+	MaxObjectSize int64
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// BitbucketConfig defines the optional configuration for a Bitbucket source.
+type BitbucketConfig struct {
+	// Endpoint is the endpoint of the source. Bitbucket Cloud is used when empty.
+	Endpoint string
+	// Token is the access token to use to authenticate with the source.
+	Token string
+	// Username is the username to use with an app password.
+	Username string
+	// Password is the app password (or HTTP access token) to use with the username.
+	Password string
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+	// Repos is the list of repositories to scan.
+	Repos []string
+	// Workspaces is the list of Bitbucket Cloud workspaces to scan.
+	Workspaces []string
+	// Projects is the list of Bitbucket Data Center project keys to scan.
+	Projects []string
+	// IncludeRepos is a list of repository globs to include in the scan.
+	IncludeRepos []string
+	// ExcludeRepos is a list of repository globs to exclude from the scan.
+	ExcludeRepos []string
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// GerritConfig defines the optional configuration for a Gerrit source.
+type GerritConfig struct {
+	// Endpoint is the base URL of the Gerrit instance.
+	Endpoint string
+	// Username is the username to authenticate with.
+	Username string
+	// Password is the HTTP password to authenticate with.
+	Password string
+	// Projects is the list of projects to scan. All visible projects are scanned when empty.
+	Projects []string
+	// IncludeChanges scans the patchsets of open changes.
+	IncludeChanges bool
+	// IncludeComments scans the review comments and messages of open changes.
+	IncludeComments bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// ConfluenceConfig defines the optional configuration for a Confluence source.
+type ConfluenceConfig struct {
+	// Endpoint is the base URL of the Confluence instance, including the /wiki context path for Confluence Cloud.
+	Endpoint string
+	// Username is the username (or account email for Confluence Cloud) to authenticate with.
+	Username string
+	// Password is the password (or API token for Confluence Cloud) to use with the username.
+	Password string
+	// Token is the Confluence Data Center personal access token to authenticate with.
+	Token string
+	// Spaces is the list of space keys to scan. All spaces in SpacesScope are scanned when empty.
+	Spaces []string
+	// IgnoreSpaces is a list of space key globs to exclude from the scan.
+	IgnoreSpaces []string
+	// SpacesScope limits the enumerated spaces to "global" or "personal" spaces. All spaces are enumerated when empty.
+	SpacesScope string
+	// IncludeAttachments scans the attachments of pages and blog posts.
+	IncludeAttachments bool
+	// SkipHistory skips scanning the previous versions of pages and blog posts.
+	SkipHistory bool
+	// InsecureSkipVerifyTLS disables TLS certificate verification.
+	InsecureSkipVerifyTLS bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
 // DockerConfig defines the optional configuration for a Docker source.
 type DockerConfig struct {
 	// Images is the list of images to scan.
@@ -154,6 +356,55 @@ type DockerConfig struct {
 	UseDockerKeychain bool
 }
 
+// ECRConfig defines the optional configuration for an ECR source.
+type ECRConfig struct {
+	// Key is the AWS access key ID to authenticate with. The AWS SDK
+	// credential chain is used when empty.
+	Key,
+	// Secret is the AWS secret access key to authenticate with.
+	Secret,
+	// SessionToken is the session token of temporary credentials.
+	SessionToken string
+	// Regions is the list of regions to scan. The default region of the AWS
+	// configuration is used when empty.
+	Regions []string
+	// Registries is the list of registry (account) IDs to scan. The registry
+	// of the authenticated account is used when empty.
+	Registries []string
+	// Roles is the list of IAM roles to assume.
+	Roles []string
+	// IncludeRepositories is the list of repository glob patterns to scan.
+	IncludeRepositories []string
+	// ExcludeRepositories is the list of repository glob patterns to skip.
+	ExcludeRepositories []string
+	// MaxTags limits the scan to the images of the most recently pushed tags
+	// of each repository. All images are scanned when zero.
+	MaxTags int64
+	// Concurrency is the number of concurrent workers to use to scan layers.
+	Concurrency int
+}
+
+// KubernetesConfig defines the optional configuration for a Kubernetes source.
+type KubernetesConfig struct {
+	// Kubeconfig is the path of the kubeconfig file. $HOME/.kube/config is
+	// used when empty.
+	Kubeconfig string
+	// Context is the kubeconfig context to use. The current context is used
+	// when empty.
+	Context string
+	// Namespaces is the list of namespaces to scan. All namespaces are
+	// scanned when empty.
+	Namespaces []string
+	// ExcludeNamespaces is the list of namespace glob patterns to skip.
+	ExcludeNamespaces []string
+	// IncludeSecrets enables scanning the values of Secrets.
+	IncludeSecrets bool
+	// InCluster uses the service account of the pod the scan runs in.
+	InCluster bool
+	// Concurrency is the number of namespaces to scan concurrently.
+	Concurrency int
+}
+
 // GCSConfig defines the optional configuration for a GCS source.
 type GCSConfig struct {
 	// CloudCred determines whether to use cloud credentials.
@@ -266,6 +517,32 @@ type GitlabConfig struct {
 	SkipBinaries bool
 }
 
+// JiraConfig defines the optional configuration for a Jira source.
+type JiraConfig struct {
+	// Endpoint is the base URL of the Jira instance.
+	Endpoint string
+	// Username is the username (or account email for Jira Cloud) to authenticate with.
+	Username string
+	// Password is the password (or API token for Jira Cloud) to use with the username.
+	Password string
+	// Token is the Jira Data Center personal access token to authenticate with.
+	Token string
+	// JQL is the query selecting the issues to scan. All accessible issues are scanned when empty.
+	JQL string
+	// Projects is the list of project keys to scan.
+	Projects []string
+	// IgnoreProjects is the list of project keys to exclude from the scan.
+	IgnoreProjects []string
+	// SkipAttachments skips scanning issue attachments.
+	SkipAttachments bool
+	// MaxAttachmentSize is the maximum size of attachments to scan.
+	MaxAttachmentSize int64
+	// InsecureSkipVerifyTLS disables TLS certificate verification.
+	InsecureSkipVerifyTLS bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
 // FilesystemConfig defines the optional configuration for a filesystem source.
 type FilesystemConfig struct {
 	// Paths is the list of files and directories to scan.
@@ -276,6 +553,12 @@ type FilesystemConfig struct {
 	ExcludePathsFile string
 }
 
+// StdinConfig defines the optional configuration for a stdin source.
+type StdinConfig struct {
+	// Name is an optional label for the piped data, reported in the results.
+	Name string
+}
+
 // S3Config defines the optional configuration for an S3 source.
 type S3Config struct {
 	// CloudCred determines whether to use cloud credentials.
@@ -295,6 +578,122 @@ type S3Config struct {
 	Roles []string
 	// MaxObjectSize is the maximum object size to scan.
 	MaxObjectSize int64
+	// Endpoint is the URL of an S3-compatible service, such as MinIO or Ceph.
+	// AWS is used when empty.
+	Endpoint string
+	// Region is the region to sign requests for.
+	Region string
+	// ForcePathStyle addresses buckets in the path rather than the hostname.
+	ForcePathStyle bool
+	// InsecureSkipVerifyTLS disables the verification of the endpoint's TLS
+	// certificate.
+	InsecureSkipVerifyTLS bool
+}
+
+// SlackConfig defines the optional configuration for a Slack source.
+type SlackConfig struct {
+	// Endpoint is the Slack API endpoint. https://slack.com is used when empty.
+	Endpoint string
+	// Token is the bot or user token to authenticate with.
+	Token string
+	// AppToken is the app-level token used to receive events in real-time mode.
+	AppToken string
+	// Channels is the list of channel IDs or names to scan. All visible conversations are scanned when empty.
+	Channels []string
+	// IgnoreChannels is a list of channel ID or name globs to exclude from the scan.
+	IgnoreChannels []string
+	// Realtime scans new messages as they are posted rather than the history of the workspace.
+	Realtime bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// TeamsConfig defines the optional configuration for a Microsoft Teams source.
+type TeamsConfig struct {
+	// TenantID, ClientID and ClientSecret identify the app registration to authenticate as.
+	TenantID,
+	ClientID,
+	ClientSecret string
+	// Token is a Microsoft Graph access token, used instead of the app registration.
+	Token string
+	// TeamIDs is the list of teams to scan. All the teams of the tenant are scanned when empty.
+	TeamIDs []string
+	// Channels is the list of channel IDs or names to scan. All channels are scanned when empty.
+	Channels []string
+	// IgnoreChannels is a list of channel ID or name globs to exclude from the scan.
+	IgnoreChannels []string
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// BuildkiteConfig defines the optional configuration for a Buildkite source.
+type BuildkiteConfig struct {
+	// Token is the API access token to authenticate with.
+	Token string
+	// Organizations is the list of organization slugs to scan. All accessible organizations are scanned when empty.
+	Organizations,
+	// Pipelines is the list of pipeline slugs to scan, optionally prefixed by their organization slug.
+	Pipelines []string
+	// IncludeArtifacts also scans the artifacts of the builds.
+	IncludeArtifacts bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// NPMConfig defines the optional configuration for an npm source.
+type NPMConfig struct {
+	// Registry is the URL of the registry. The public npm registry is used when empty.
+	Registry string
+	// Packages is the list of packages to scan.
+	Packages,
+	// Maintainers is the list of users whose packages are scanned.
+	Maintainers,
+	// Organizations is the list of organizations (scopes) whose packages are scanned.
+	Organizations []string
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// PyPIConfig defines the optional configuration for a PyPI source.
+type PyPIConfig struct {
+	// Registry is the URL of the registry. The public PyPI registry is used when empty.
+	Registry string
+	// Packages is the list of packages to scan.
+	Packages,
+	// Maintainers is the list of users whose packages are scanned.
+	Maintainers,
+	// Organizations is the list of organizations whose packages are scanned.
+	Organizations []string
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// GoogleDriveConfig defines the optional configuration for a Google Drive source.
+type GoogleDriveConfig struct {
+	// ServiceAccount is the path to the JSON key of the service account to authenticate as.
+	ServiceAccount string
+	// Users is the list of users to impersonate with domain-wide delegation.
+	Users []string
+	// IncludeRevisions scans the previous revisions of the files.
+	IncludeRevisions bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
+}
+
+// SharePointConfig defines the optional configuration for a SharePoint source.
+type SharePointConfig struct {
+	// TenantID, ClientID and ClientSecret identify the app registration to authenticate as.
+	TenantID,
+	ClientID,
+	ClientSecret string
+	// Token is a Microsoft Graph access token, used instead of the app registration.
+	Token string
+	// SiteURL is the URL of the site to scan. All the sites of the tenant are scanned when empty.
+	SiteURL string
+	// IncludeOneDrive also scans the OneDrive of every user.
+	IncludeOneDrive bool
+	// Concurrency is the number of concurrent workers to use to scan the source.
+	Concurrency int
 }
 
 // SyslogConfig defines the optional configuration for a syslog source.
@@ -313,6 +712,23 @@ type SyslogConfig struct {
 	Concurrency int
 }
 
+// WebhookConfig defines the optional configuration for a webhook source.
+type WebhookConfig struct {
+	// ListenAddress is the address the HTTP server listens on.
+	ListenAddress string
+	// HeaderName is the name of the header holding the shared secret.
+	HeaderName string
+	// HeaderValue is the shared secret requests must provide in the header.
+	HeaderValue string
+	// HMACSecret is the secret requests must sign their body with.
+	HMACSecret string
+	// SyncResponse determines whether requests are answered with the results
+	// found in their payload, once it is scanned.
+	SyncResponse bool
+	// MaxBodySize is the maximum size of a request body.
+	MaxBodySize int64
+}
+
 // PostmanConfig defines the optional configuration for a Postman source.
 type PostmanConfig struct {
 	// Workspace UUID(s) or file path(s) to Postman workspace (.zip)
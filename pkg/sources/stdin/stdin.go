@@ -0,0 +1,107 @@
+package stdin
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_STDIN
+
+// Source scans the data piped into the standard input. The data is streamed
+// through the handlers in memory only, so archives and compressed streams are
+// unpacked without being written to disk. Only the start of the input is
+// buffered, to detect its type; archives that must be read backwards, such as
+// zip archives, are therefore scanned only when they fit in that buffer.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	// label is an optional name for the data, reported in the metadata.
+	label  string
+	reader io.Reader
+
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized stdin source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.Stdin
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+	s.label = conn.GetName()
+	if s.reader == nil {
+		// Hide the Seek method of os.Stdin: it fails when the input is a pipe,
+		// so the handlers must buffer the stream instead.
+		s.reader = struct{ io.Reader }{os.Stdin}
+	}
+
+	return nil
+}
+
+// InjectReader sets the reader to scan instead of the standard input.
+func (s *Source) InjectReader(r io.Reader) {
+	s.reader = r
+}
+
+// Chunks reads the standard input until EOF and emits its chunks over a
+// channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	s.SetProgressComplete(0, 1, "Scanning standard input", "")
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.sourceID,
+		JobID:      s.jobID,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Stdin{
+				Stdin: &source_metadatapb.Stdin{Name: sanitizer.UTF8(s.label)},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	if err := handlers.HandleFile(ctx, s.reader, chunkSkel, reporter, handlers.WithMemoryOnly(true)); err != nil {
+		return fmt.Errorf("error scanning standard input: %w", err)
+	}
+
+	s.SetProgressComplete(1, 1, "Completed scanning standard input", "")
+	return nil
+}
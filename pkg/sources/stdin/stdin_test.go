@@ -0,0 +1,111 @@
+package stdin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func gzipped(t *testing.T, data string) io.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return &buf
+}
+
+func TestSource_Chunks(t *testing.T) {
+	tests := []struct {
+		name   string
+		label  string
+		reader io.Reader
+		want   string
+	}{
+		{
+			name:   "plain text",
+			label:  "ci-build-42",
+			reader: strings.NewReader("export AWS_SECRET=abc123\n"),
+			want:   "export AWS_SECRET=abc123\n",
+		},
+		{
+			name:   "gzip stream",
+			reader: gzipped(t, "password=hunter2\n"),
+			want:   "password=hunter2\n",
+		},
+		{
+			name:   "empty",
+			reader: strings.NewReader(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			conn, err := anypb.New(&sourcespb.Stdin{Name: tt.label})
+			require.NoError(t, err)
+
+			s := &Source{}
+			s.InjectReader(tt.reader)
+			require.NoError(t, s.Init(ctx, "test - stdin", 0, 0, false, conn, 1))
+
+			chunksCh := make(chan *sources.Chunk, 16)
+			require.NoError(t, s.Chunks(ctx, chunksCh))
+			close(chunksCh)
+
+			var data []byte
+			for chunk := range chunksCh {
+				assert.Equal(t, SourceType, chunk.SourceType)
+				assert.Equal(t, tt.label, chunk.SourceMetadata.GetStdin().GetName())
+				data = append(data, chunk.Data...)
+			}
+			assert.Equal(t, tt.want, string(data))
+			assert.Equal(t, int64(100), s.GetProgress().PercentComplete)
+		})
+	}
+}
+
+func TestSource_Chunks_MemoryOnly(t *testing.T) {
+	// Creating a temporary file fails, so the input is only scanned to its end
+	// if it is never buffered to disk.
+	tmpDir := filepath.Join(t.TempDir(), "missing")
+	t.Setenv("TMPDIR", tmpDir)
+
+	ctx := context.Background()
+	conn, err := anypb.New(&sourcespb.Stdin{})
+	require.NoError(t, err)
+
+	// The input is larger than the 16MB the handlers keep in memory.
+	input := strings.Repeat("lorem ipsum dolor sit amet\n", (1<<24)/20) + "password=hunter2\n"
+	s := &Source{}
+	s.InjectReader(struct{ io.Reader }{strings.NewReader(input)})
+	require.NoError(t, s.Init(ctx, "test - stdin", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(chunksCh)
+		errCh <- s.Chunks(ctx, chunksCh)
+	}()
+
+	var last []byte
+	for chunk := range chunksCh {
+		last = chunk.Data
+	}
+	require.NoError(t, <-errCh)
+	assert.True(t, bytes.HasSuffix(last, []byte("password=hunter2\n")))
+	assert.NoDirExists(t, tmpDir)
+}
@@ -0,0 +1,212 @@
+package teams
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// Locations of the scanned data within a channel.
+	locationMessage = "message"
+	locationReply   = "reply"
+	locationFile    = "file"
+
+	// Attachments of this content type are links to files stored in SharePoint
+	// or OneDrive.
+	referenceAttachment = "reference"
+)
+
+type team struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type channel struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type chatMessage struct {
+	ID              string               `json:"id"`
+	MessageType     string               `json:"messageType"`
+	CreatedDateTime string               `json:"createdDateTime"`
+	DeletedDateTime *string              `json:"deletedDateTime"`
+	Subject         string               `json:"subject"`
+	WebURL          string               `json:"webUrl"`
+	From            *msgraph.IdentitySet `json:"from"`
+	Body            struct {
+		ContentType string `json:"contentType"`
+		Content     string `json:"content"`
+	} `json:"body"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	ID          string `json:"id"`
+	ContentType string `json:"contentType"`
+	ContentURL  string `json:"contentUrl"`
+	Content     string `json:"content"`
+	Name        string `json:"name"`
+}
+
+// text returns the subject and body of a message, along with the content of
+// inline attachments such as cards.
+func (m chatMessage) text() string {
+	body := m.Body.Content
+	if strings.EqualFold(m.Body.ContentType, "html") {
+		body = htmlToText(body)
+	}
+
+	parts := []string{m.Subject, body}
+	for _, a := range m.Attachments {
+		if a.ContentType != referenceAttachment {
+			parts = append(parts, a.Content)
+		}
+	}
+
+	var text []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			text = append(text, part)
+		}
+	}
+	return strings.Join(text, "\n")
+}
+
+func (m chatMessage) userID() string {
+	if m.From == nil || m.From.User == nil {
+		return ""
+	}
+	return m.From.User.ID
+}
+
+func (s *Source) metadata(ctx context.Context, t team, ch channel, msg chatMessage, location string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Teams{
+			Teams: &source_metadatapb.Teams{
+				TeamId:      t.ID,
+				TeamName:    sanitizer.UTF8(t.DisplayName),
+				ChannelId:   ch.ID,
+				ChannelName: sanitizer.UTF8(ch.DisplayName),
+				Timestamp:   msg.CreatedDateTime,
+				UserId:      msg.userID(),
+				Email:       sanitizer.UTF8(s.email(ctx, msg.userID())),
+				Link:        sanitizer.UTF8(msg.WebURL),
+				Location:    location,
+			},
+		},
+	}
+}
+
+// reportMessage reports the text of a message and scans its attached files.
+// System events and deleted messages are skipped.
+func (s *Source) reportMessage(ctx context.Context, t team, ch channel, msg chatMessage, location string, reporter sources.ChunkReporter) error {
+	if msg.MessageType != "message" || msg.DeletedDateTime != nil {
+		return nil
+	}
+
+	if text := msg.text(); text != "" {
+		chunk := sources.Chunk{
+			SourceType:     s.Type(),
+			SourceName:     s.name,
+			SourceID:       s.sourceID,
+			JobID:          s.jobID,
+			Data:           []byte(text),
+			SourceMetadata: s.metadata(ctx, t, ch, msg, location),
+			Verify:         s.verify,
+		}
+		if err := reporter.ChunkOk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range msg.Attachments {
+		if a.ContentType != referenceAttachment || a.ContentURL == "" {
+			continue
+		}
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.scanFile(ctx, t, ch, msg, a, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanFile downloads a file shared in a message through its sharing link.
+func (s *Source) scanFile(ctx context.Context, t team, ch channel, msg chatMessage, a attachment, reporter sources.ChunkReporter) error {
+	if common.SkipFile(a.Name) {
+		ctx.Logger().V(5).Info("skipping file with incompatible extension", "file", a.Name)
+		return nil
+	}
+	ctx.Logger().V(3).Info("scanning file", "file", a.Name)
+
+	metadata := s.metadata(ctx, t, ch, msg, locationFile)
+	metadata.GetTeams().File = sanitizer.UTF8(a.Name)
+	metadata.GetTeams().Link = sanitizer.UTF8(a.ContentURL)
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+
+	content, err := s.client.Open(ctx, msgraph.SharingPath(a.ContentURL)+"/content")
+	if err != nil {
+		return fmt.Errorf("could not download file %q: %w", a.Name, err)
+	}
+	defer content.Close()
+
+	if err := handlers.HandleFile(ctx, content, chunkSkel, reporter); err != nil {
+		return fmt.Errorf("could not scan file %q: %w", a.Name, err)
+	}
+	return nil
+}
+
+// blockElements end a line of text.
+var blockElements = map[string]bool{
+	"br": true, "div": true, "p": true, "li": true, "tr": true, "pre": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// htmlToText extracts the text of an HTML message body. Link targets are kept,
+// as they may differ from the link text.
+func htmlToText(body string) string {
+	var sb strings.Builder
+	z := html.NewTokenizer(strings.NewReader(body))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(sb.String())
+		case html.TextToken:
+			sb.Write(z.Text())
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			tok := z.Token()
+			if tok.Type == html.StartTagToken && tok.Data == "a" {
+				for _, attr := range tok.Attr {
+					if attr.Key == "href" {
+						sb.WriteString(attr.Val + " ")
+					}
+				}
+			}
+			if blockElements[tok.Data] && (tok.Type != html.StartTagToken || tok.Data == "br") {
+				sb.WriteString("\n")
+			}
+		}
+	}
+}
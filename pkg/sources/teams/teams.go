@@ -0,0 +1,363 @@
+package teams
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common/glob"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_TEAMS
+
+	unitChannel sources.SourceUnitKind = "channel"
+
+	// messagePageLimit is the largest page size allowed for channel messages.
+	messagePageLimit = 50
+)
+
+// Source scans the messages, replies and attached files of Microsoft Teams
+// channels, using the Microsoft Graph API. Reading channel messages requires
+// the ChannelMessage.Read.All permission.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	client        *msgraph.Client
+	teamIDs       []string
+	channels      []string
+	channelFilter *glob.Filter
+
+	mu           sync.Mutex
+	teamCache    map[string]team
+	channelCache map[string]channel
+	emails       map[string]string
+
+	jobPool *errgroup.Group
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Teams source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Teams
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	var tokens oauth2.TokenSource
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Teams_Token:
+		if cred.Token == "" {
+			return fmt.Errorf("token is empty")
+		}
+		tokens = msgraph.StaticToken(cred.Token)
+	case *sourcespb.Teams_Authenticated:
+		var err error
+		if tokens, err = msgraph.ClientCredentials(ctx, cred.Authenticated); err != nil {
+			return err
+		}
+	case *sourcespb.Teams_Oauth:
+		tokens = msgraph.OAuth(ctx, cred.Oauth)
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	client, err := msgraph.NewClient(ctx, conn.GetEndpoint(), tokens)
+	if err != nil {
+		return err
+	}
+	s.client = client
+
+	channelFilter, err := glob.NewGlobFilter(glob.WithExcludeGlobs(conn.GetIgnoreList()...))
+	if err != nil {
+		return fmt.Errorf("could not compile channel patterns: %w", err)
+	}
+	s.channelFilter = channelFilter
+	s.teamIDs = conn.GetTeamIds()
+	s.channels = conn.GetChannels()
+
+	s.teamCache = make(map[string]team)
+	s.channelCache = make(map[string]channel)
+	s.emails = make(map[string]string)
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating Teams channels")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+
+	var scanned int32
+	scanErrs := sources.NewScanErrors()
+	for _, unit := range units {
+		unit := unit
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			chunkReporter := sources.ChanReporter{Ch: chunksChan}
+			if err := s.ChunkUnit(ctx, unit, chunkReporter); err != nil {
+				scanErrs.Add(err)
+			}
+			n := atomic.AddInt32(&scanned, 1)
+			s.SetProgressComplete(int(n), len(units), fmt.Sprintf("Scanned channel: %s", unit.Display()), "")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed Teams scan", "")
+
+	return nil
+}
+
+// Enumerate reports a unit for every channel of the configured teams, or of
+// every team in the tenant. Channels can be selected by ID or name, and those
+// matching the ignore list are skipped.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	teams, err := s.listTeams(ctx)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]struct{}, len(s.channels))
+	for _, ch := range s.channels {
+		wanted[ch] = struct{}{}
+	}
+
+	for _, t := range teams {
+		err := msgraph.List(ctx, s.client, "/teams/"+url.PathEscape(t.ID)+"/channels", func(ch channel) error {
+			if len(wanted) > 0 {
+				_, byID := wanted[ch.ID]
+				_, byName := wanted[ch.DisplayName]
+				if !byID && !byName {
+					return nil
+				}
+			}
+			if !s.channelFilter.ShouldInclude(ch.ID) || !s.channelFilter.ShouldInclude(ch.DisplayName) {
+				ctx.Logger().V(3).Info("skipping channel", "team", t.DisplayName, "channel", ch.DisplayName)
+				return nil
+			}
+
+			s.cacheChannel(t.ID, ch)
+			return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: unitChannel, ID: unitID(t.ID, ch.ID)})
+		})
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("could not list the channels of team %q: %w", t.DisplayName, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listTeams returns the configured teams, or every team in the tenant.
+func (s *Source) listTeams(ctx context.Context) ([]team, error) {
+	var teams []team
+	if len(s.teamIDs) > 0 {
+		for _, id := range s.teamIDs {
+			t, err := s.team(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			teams = append(teams, t)
+		}
+		return teams, nil
+	}
+
+	err := msgraph.List(ctx, s.client, "/teams?$select=id,displayName", func(t team) error {
+		s.mu.Lock()
+		s.teamCache[t.ID] = t
+		s.mu.Unlock()
+		teams = append(teams, t)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list teams: %w", err)
+	}
+	return teams, nil
+}
+
+// unitID identifies a channel within its team.
+func unitID(teamID, channelID string) string {
+	return teamID + "/" + channelID
+}
+
+func parseUnitID(id string) (teamID, channelID string, err error) {
+	teamID, channelID, ok := strings.Cut(id, "/")
+	if !ok || teamID == "" || channelID == "" {
+		return "", "", fmt.Errorf("invalid Teams channel unit %q", id)
+	}
+	return teamID, channelID, nil
+}
+
+// ChunkUnit scans the messages of a channel, along with their replies and
+// attached files.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	id, _ := unit.SourceUnitID()
+	teamID, channelID, err := parseUnitID(id)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+
+	t, err := s.team(ctx, teamID)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	ch, err := s.channel(ctx, teamID, channelID)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	ctx = context.WithValues(ctx, "team", t.DisplayName, "channel", ch.DisplayName)
+	ctx.Logger().V(2).Info("scanning channel")
+
+	messagesPath := fmt.Sprintf("/teams/%s/channels/%s/messages", url.PathEscape(teamID), url.PathEscape(channelID))
+	return msgraph.List(ctx, s.client, fmt.Sprintf("%s?$top=%d", messagesPath, messagePageLimit), func(msg chatMessage) error {
+		if err := s.reportMessage(ctx, t, ch, msg, locationMessage, reporter); err != nil {
+			return err
+		}
+		if msg.MessageType != "message" {
+			// System events, such as members joining, have no replies.
+			return nil
+		}
+
+		repliesPath := fmt.Sprintf("%s/%s/replies?$top=%d", messagesPath, url.PathEscape(msg.ID), messagePageLimit)
+		err := msgraph.List(ctx, s.client, repliesPath, func(reply chatMessage) error {
+			return s.reportMessage(ctx, t, ch, reply, locationReply, reporter)
+		})
+		if err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("could not list the replies of message %s: %w", msg.ID, err))
+		}
+		return nil
+	})
+}
+
+func (s *Source) team(ctx context.Context, teamID string) (team, error) {
+	s.mu.Lock()
+	t, ok := s.teamCache[teamID]
+	s.mu.Unlock()
+	if ok {
+		return t, nil
+	}
+
+	if err := s.client.Get(ctx, "/teams/"+url.PathEscape(teamID)+"?$select=id,displayName", &t); err != nil {
+		return team{}, fmt.Errorf("could not get team %s: %w", teamID, err)
+	}
+	s.mu.Lock()
+	s.teamCache[teamID] = t
+	s.mu.Unlock()
+	return t, nil
+}
+
+func (s *Source) channel(ctx context.Context, teamID, channelID string) (channel, error) {
+	s.mu.Lock()
+	ch, ok := s.channelCache[unitID(teamID, channelID)]
+	s.mu.Unlock()
+	if ok {
+		return ch, nil
+	}
+
+	path := fmt.Sprintf("/teams/%s/channels/%s", url.PathEscape(teamID), url.PathEscape(channelID))
+	if err := s.client.Get(ctx, path, &ch); err != nil {
+		return channel{}, fmt.Errorf("could not get channel %s: %w", channelID, err)
+	}
+	s.cacheChannel(teamID, ch)
+	return ch, nil
+}
+
+func (s *Source) cacheChannel(teamID string, ch channel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.channelCache[unitID(teamID, ch.ID)] = ch
+}
+
+// email returns the email address of a user, if the app is allowed to read
+// it.
+func (s *Source) email(ctx context.Context, userID string) string {
+	if userID == "" {
+		return ""
+	}
+
+	s.mu.Lock()
+	email, ok := s.emails[userID]
+	s.mu.Unlock()
+	if ok {
+		return email
+	}
+
+	var u struct {
+		Mail              string `json:"mail"`
+		UserPrincipalName string `json:"userPrincipalName"`
+	}
+	if err := s.client.Get(ctx, "/users/"+url.PathEscape(userID)+"?$select=mail,userPrincipalName", &u); err != nil {
+		ctx.Logger().V(3).Info("could not get user", "user", userID, "error", err)
+	} else {
+		email = u.Mail
+		if email == "" {
+			email = u.UserPrincipalName
+		}
+	}
+
+	s.mu.Lock()
+	s.emails[userID] = email
+	s.mu.Unlock()
+	return email
+}
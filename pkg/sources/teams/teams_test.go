@@ -0,0 +1,189 @@
+package teams
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+const sharedFileURL = "https://contoso.sharepoint.com/sites/Eng/Shared Documents/deploy.env"
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer graph-token", r.Header.Get("Authorization"))
+			if r.URL.Query().Get("$skiptoken") != "" {
+				_, _ = fmt.Fprint(w, `{"value": []}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, body)
+		})
+	}
+
+	handle("/v1.0/teams", `{"value": [{"id": "team-1", "displayName": "Engineering"}, {"id": "team-2", "displayName": "Sales"}]}`)
+	handle("/v1.0/teams/team-1", `{"id": "team-1", "displayName": "Engineering"}`)
+	handle("/v1.0/teams/team-1/channels", `{"value": [
+		{"id": "19:general@thread.tacv2", "displayName": "General"},
+		{"id": "19:ops@thread.tacv2", "displayName": "ops-alerts"}
+	]}`)
+	handle("/v1.0/teams/team-1/channels/19:general@thread.tacv2", `{"id": "19:general@thread.tacv2", "displayName": "General"}`)
+	handle("/v1.0/teams/team-2/channels", `{"value": [{"id": "19:deals@thread.tacv2", "displayName": "Deals"}]}`)
+	handle("/v1.0/teams/team-1/channels/19:general@thread.tacv2/messages", fmt.Sprintf(`{"value": [
+		{
+			"id": "100", "messageType": "message", "createdDateTime": "2024-05-01T10:00:00Z",
+			"webUrl": "https://teams.microsoft.com/l/message/19%%3Ageneral%%40thread.tacv2/100",
+			"from": {"user": {"id": "user-1", "displayName": "Ada"}},
+			"body": {"contentType": "html", "content": "<p>db password:&nbsp;<b>hunter2</b></p><p><a href=\"https://example.com/?token=abc\">link</a></p>"},
+			"attachments": [{"id": "att-1", "contentType": "reference", "contentUrl": %q, "name": "deploy.env"}]
+		},
+		{"id": "101", "messageType": "systemEventMessage", "body": {"contentType": "html", "content": "<systemEventMessage/>"}},
+		{"id": "102", "messageType": "message", "deletedDateTime": "2024-05-02T10:00:00Z", "body": {"contentType": "text", "content": "deleted"}}
+	], "@odata.nextLink": "%s/v1.0/teams/team-1/channels/19:general@thread.tacv2/messages?$skiptoken=2"}`, sharedFileURL, server.URL))
+	handle("/v1.0/teams/team-1/channels/19:general@thread.tacv2/messages/100/replies", `{"value": [
+		{"id": "103", "messageType": "message", "createdDateTime": "2024-05-01T11:00:00Z", "replyToId": "100",
+		 "from": {"user": {"id": "user-2"}}, "body": {"contentType": "text", "content": "rotated it"}}
+	]}`)
+	// Deleted messages keep their replies.
+	handle("/v1.0/teams/team-1/channels/19:general@thread.tacv2/messages/102/replies", `{"value": []}`)
+	handle("/v1.0/users/user-1", `{"mail": "ada@contoso.com"}`)
+	handle("/v1.0/users/user-2", `{"mail": null, "userPrincipalName": "grace@contoso.com"}`)
+	handle("/v1.0"+msgraph.SharingPath(sharedFileURL)+"/content", "AWS_SECRET=from-file")
+
+	return server
+}
+
+func initSource(t *testing.T, conn *sourcespb.Teams) *Source {
+	t.Helper()
+
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - teams", 0, 0, false, anyConn, 1))
+	return s
+}
+
+func TestEnumerate(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name     string
+		teamIDs  []string
+		channels []string
+		ignore   []string
+		want     []string
+	}{
+		{
+			name: "all teams",
+			want: []string{"team-1/19:general@thread.tacv2", "team-1/19:ops@thread.tacv2", "team-2/19:deals@thread.tacv2"},
+		},
+		{
+			name:    "selected team, ignored channel",
+			teamIDs: []string{"team-1"},
+			ignore:  []string{"ops-*"},
+			want:    []string{"team-1/19:general@thread.tacv2"},
+		},
+		{
+			name:     "channels by name or ID",
+			channels: []string{"Deals", "19:ops@thread.tacv2"},
+			want:     []string{"team-1/19:ops@thread.tacv2", "team-2/19:deals@thread.tacv2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, &sourcespb.Teams{
+				Endpoint:   server.URL + "/v1.0",
+				Credential: &sourcespb.Teams_Token{Token: "graph-token"},
+				TeamIds:    tt.teamIDs,
+				Channels:   tt.channels,
+				IgnoreList: tt.ignore,
+			})
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), &reporter))
+			assert.Empty(t, reporter.UnitErrs)
+
+			var got []string
+			for _, unit := range reporter.Units {
+				id, kind := unit.SourceUnitID()
+				assert.Equal(t, unitChannel, kind)
+				got = append(got, id)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChunkUnit(t *testing.T) {
+	server := newTestServer(t)
+	s := initSource(t, &sourcespb.Teams{
+		Endpoint:   server.URL + "/v1.0",
+		Credential: &sourcespb.Teams_Token{Token: "graph-token"},
+	})
+
+	reporter := sourcestest.TestReporter{}
+	unit := sources.CommonSourceUnit{Kind: unitChannel, ID: "team-1/19:general@thread.tacv2"}
+	require.NoError(t, s.ChunkUnit(context.Background(), unit, &reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	require.Len(t, reporter.Chunks, 3)
+
+	sort.Slice(reporter.Chunks, func(i, j int) bool {
+		return reporter.Chunks[i].SourceMetadata.GetTeams().GetLocation() < reporter.Chunks[j].SourceMetadata.GetTeams().GetLocation()
+	})
+
+	file, message, reply := reporter.Chunks[0], reporter.Chunks[1], reporter.Chunks[2]
+	assert.Equal(t, "AWS_SECRET=from-file", string(file.Data))
+	assert.Equal(t, "deploy.env", file.SourceMetadata.GetTeams().GetFile())
+	assert.Equal(t, sharedFileURL, file.SourceMetadata.GetTeams().GetLink())
+
+	assert.Equal(t, "db password: hunter2\nhttps://example.com/?token=abc link", string(message.Data))
+	meta := message.SourceMetadata.GetTeams()
+	assert.Equal(t, "team-1", meta.GetTeamId())
+	assert.Equal(t, "Engineering", meta.GetTeamName())
+	assert.Equal(t, "19:general@thread.tacv2", meta.GetChannelId())
+	assert.Equal(t, "General", meta.GetChannelName())
+	assert.Equal(t, "user-1", meta.GetUserId())
+	assert.Equal(t, "ada@contoso.com", meta.GetEmail())
+	assert.Equal(t, "2024-05-01T10:00:00Z", meta.GetTimestamp())
+	assert.Equal(t, "https://teams.microsoft.com/l/message/19%3Ageneral%40thread.tacv2/100", meta.GetLink())
+
+	assert.Equal(t, "rotated it", string(reply.Data))
+	assert.Equal(t, "grace@contoso.com", reply.SourceMetadata.GetTeams().GetEmail())
+}
+
+func TestHTMLToText(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{name: "plain", html: "just text", want: "just text"},
+		{name: "line breaks", html: "<div>first<br>second</div><div>third</div>", want: "first\nsecond\nthird"},
+		{name: "entities", html: "a &amp; b &lt;c&gt;", want: "a & b <c>"},
+		{name: "link target", html: `<a href="https://x.test/?key=1">here</a>`, want: "https://x.test/?key=1 here"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, htmlToText(tt.html))
+		})
+	}
+}
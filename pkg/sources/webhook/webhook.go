@@ -0,0 +1,520 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	SourceType = sourcespb.SourceType_SOURCE_TYPE_WEBHOOK
+
+	// SignatureHeader holds the HMAC-SHA256 of the request body, as
+	// "sha256=<hex digest>", when the source is configured with an HMAC secret.
+	SignatureHeader = "X-Trufflehog-Signature"
+
+	defaultMaxBodySize = 50 * 1024 * 1024
+	// maxSignedBodySize caps the body of requests authenticated with an HMAC
+	// signature, since it is read before the request is authenticated.
+	maxSignedBodySize = 5 * 1024 * 1024
+	shutdownTimeout   = 10 * time.Second
+
+	// A synchronous response is sent once no result is found for a request
+	// for syncIdleTimeout, or once syncTimeout elapses.
+	syncIdleTimeout = 3 * time.Second
+	syncTimeout     = 30 * time.Second
+)
+
+// Source is an HTTP server scanning the payloads posted to it. Raw bodies,
+// JSON documents and the files and fields of multipart forms are scanned.
+// Every request must be authenticated, with a shared secret header or an HMAC
+// signature of its body.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	conn        sourcespb.Webhook
+	maxBodySize int64
+	dispatcher  *Dispatcher
+
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized webhook source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	if err := anypb.UnmarshalTo(connection, &s.conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	switch cred := s.conn.GetCredential().(type) {
+	case *sourcespb.Webhook_Header:
+		if cred.Header.GetKey() == "" || cred.Header.GetValue() == "" {
+			return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+		}
+	case *sourcespb.Webhook_HmacSecret:
+		if cred.HmacSecret == "" {
+			return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+		}
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if s.conn.GetListenAddress() == "" {
+		return fmt.Errorf("no listen address given")
+	}
+
+	s.maxBodySize = s.conn.GetMaxBodySize()
+	if s.maxBodySize <= 0 {
+		s.maxBodySize = defaultMaxBodySize
+	}
+
+	return nil
+}
+
+// SetDispatcher sets the dispatcher of the engine, through which the
+// results of the requests are collected to respond synchronously.
+func (s *Source) SetDispatcher(d *Dispatcher) {
+	s.dispatcher = d
+}
+
+// Chunks serves webhook requests until the context is cancelled, emitting
+// the chunks of their payloads over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	if s.conn.GetSyncResponse() && s.dispatcher == nil {
+		return fmt.Errorf("responding synchronously requires the results to be dispatched with a webhook.Dispatcher")
+	}
+	lis, err := net.Listen("tcp", s.conn.GetListenAddress())
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", s.conn.GetListenAddress(), err)
+	}
+	return s.serve(ctx, lis, chunksChan)
+}
+
+func (s *Source) serve(ctx context.Context, lis net.Listener, chunksChan chan *sources.Chunk) error {
+	srv := &http.Server{
+		Handler:           &handler{source: s, ctx: ctx, chunksChan: chunksChan},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			ctx.Logger().Error(err, "error shutting down webhook server")
+		}
+	}()
+
+	ctx.Logger().Info("listening for webhook requests", "address", lis.Addr().String())
+	if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("webhook server failed: %w", err)
+	}
+	return nil
+}
+
+// authenticateHeader checks the credential of a request before its body is
+// read. The shared secret header is checked, while only the presence of the
+// HMAC signature is, since it is checked against the body by verifySignature.
+func (s *Source) authenticateHeader(header http.Header) bool {
+	switch cred := s.conn.GetCredential().(type) {
+	case *sourcespb.Webhook_Header:
+		got := header.Get(cred.Header.GetKey())
+		return subtle.ConstantTimeCompare([]byte(got), []byte(cred.Header.GetValue())) == 1
+	case *sourcespb.Webhook_HmacSecret:
+		_, ok := signature(header)
+		return ok
+	default:
+		return false
+	}
+}
+
+// verifySignature checks the HMAC signature of a request body, when the
+// source is configured with an HMAC secret.
+func (s *Source) verifySignature(header http.Header, body []byte) bool {
+	cred, ok := s.conn.GetCredential().(*sourcespb.Webhook_HmacSecret)
+	if !ok {
+		return true
+	}
+	got, ok := signature(header)
+	return ok && hmac.Equal(got, Sign([]byte(cred.HmacSecret), body))
+}
+
+// signature returns the HMAC-SHA256 given in the SignatureHeader of a request.
+func signature(header http.Header) ([]byte, bool) {
+	sig, ok := strings.CutPrefix(header.Get(SignatureHeader), "sha256=")
+	if !ok {
+		return nil, false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil || len(got) != sha256.Size {
+		return nil, false
+	}
+	return got, true
+}
+
+// bodyLimit returns the maximum size of the body of a request. Signed bodies
+// are read before the request is authenticated, so they are capped further.
+func (s *Source) bodyLimit() int64 {
+	if _, ok := s.conn.GetCredential().(*sourcespb.Webhook_HmacSecret); ok {
+		return min(s.maxBodySize, maxSignedBodySize)
+	}
+	return s.maxBodySize
+}
+
+// Sign returns the HMAC-SHA256 of a request body. Its hex encoding, prefixed
+// by "sha256=", is the value of the SignatureHeader of the request.
+func Sign(secret, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// handler scans the payloads of the webhook requests.
+type handler struct {
+	source     *Source
+	ctx        context.Context
+	chunksChan chan *sources.Chunk
+}
+
+// response is the body of the responses to webhook requests. The results
+// are only included when the source responds synchronously.
+type response struct {
+	RequestID string
+	Results   []result `json:",omitempty"`
+}
+
+type result struct {
+	// File is the file or the form field the result was found in, if any.
+	File string `json:",omitempty"`
+	// DetectorName is the string name of the DetectorType.
+	DetectorName string
+	// DecoderName is the string name of the DecoderType.
+	DecoderName       string
+	Verified          bool
+	VerificationError string `json:",omitempty"`
+	// Raw contains the raw secret data.
+	Raw string
+	// RawV2 contains the raw secret identifier that is a combination of both the ID and the secret.
+	RawV2 string `json:",omitempty"`
+	// Redacted contains the redacted version of the raw secret identification data for display purposes.
+	Redacted  string            `json:",omitempty"`
+	ExtraData map[string]string `json:",omitempty"`
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !h.source.authenticateHeader(r.Header) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.source.bodyLimit()))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "could not read request body", http.StatusBadRequest)
+		return
+	}
+	if !h.source.verifySignature(r.Header, body) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	req := &request{
+		id:            uuid.NewString(),
+		contentType:   r.Header.Get("Content-Type"),
+		remoteAddress: r.RemoteAddr,
+		timestamp:     time.Now().UTC().Format(time.RFC3339),
+		found:         make(chan struct{}, 1),
+	}
+	// The payload is scanned with the context of the source, so that it is
+	// not interrupted when an asynchronous request is answered.
+	ctx := context.WithValues(h.ctx, "request_id", req.id)
+
+	if h.source.conn.GetSyncResponse() {
+		h.source.dispatcher.register(req)
+		defer h.source.dispatcher.unregister(req)
+	}
+	if err := h.source.scanPayload(ctx, req, body, sources.ChanReporter{Ch: h.chunksChan}); err != nil {
+		ctx.Logger().Error(err, "error scanning webhook payload")
+		http.Error(w, fmt.Sprintf("could not scan payload: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	if !h.source.conn.GetSyncResponse() {
+		writeJSON(w, http.StatusAccepted, response{RequestID: req.id})
+		return
+	}
+
+	if !req.wait(r.Context().Done()) {
+		// The client is gone.
+		return
+	}
+	writeJSON(w, http.StatusOK, response{RequestID: req.id, Results: req.collected()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// scanPayload reports the chunks of a request body. The files and fields of
+// multipart forms are scanned separately, and the string values of JSON
+// documents are unescaped before they are scanned.
+func (s *Source) scanPayload(ctx context.Context, req *request, body []byte, reporter sources.ChunkReporter) error {
+	mediaType, params, _ := mime.ParseMediaType(req.contentType)
+	switch {
+	case mediaType == "multipart/form-data":
+		mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("invalid multipart form: %w", err)
+			}
+			file := part.FileName()
+			if file == "" {
+				file = part.FormName()
+			}
+			err = handlers.HandleFile(ctx, part, s.chunkSkel(req, file, part.Header.Get("Content-Type")), reporter)
+			_ = part.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var doc any
+		if err := json.Unmarshal(body, &doc); err == nil {
+			var text bytes.Buffer
+			writeJSONText(&text, "", doc)
+			body = text.Bytes()
+		}
+	}
+	return handlers.HandleFile(ctx, bytes.NewReader(body), s.chunkSkel(req, "", mediaType), reporter)
+}
+
+// writeJSONText writes the string values of a JSON document, one per line,
+// prefixed by their key when they have one.
+func writeJSONText(w *bytes.Buffer, key string, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			writeJSONText(w, k, v[k])
+		}
+	case []any:
+		for _, elem := range v {
+			writeJSONText(w, key, elem)
+		}
+	case string:
+		if key != "" {
+			w.WriteString(key)
+			w.WriteString(": ")
+		}
+		w.WriteString(v)
+		w.WriteByte('\n')
+	}
+}
+
+func (s *Source) chunkSkel(req *request, file, contentType string) *sources.Chunk {
+	return &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.sourceID,
+		JobID:      s.jobID,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Webhook{
+				Webhook: &source_metadatapb.Webhook{
+					RequestId:     req.id,
+					File:          sanitizer.UTF8(file),
+					ContentType:   sanitizer.UTF8(contentType),
+					RemoteAddress: req.remoteAddress,
+					Timestamp:     req.timestamp,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+}
+
+// request is a webhook request being scanned. It collects the results found
+// in its payload to respond synchronously.
+type request struct {
+	id            string
+	contentType   string
+	remoteAddress string
+	timestamp     string
+
+	// found is signalled when a result is collected.
+	found   chan struct{}
+	mu      sync.Mutex
+	results []result
+}
+
+func (r *request) collect(res detectors.ResultWithMetadata) {
+	var verificationErr string
+	if err := res.VerificationError(); err != nil {
+		verificationErr = err.Error()
+	}
+
+	r.mu.Lock()
+	r.results = append(r.results, result{
+		File:              res.SourceMetadata.GetWebhook().GetFile(),
+		DetectorName:      res.DetectorType.String(),
+		DecoderName:       res.DecoderType.String(),
+		Verified:          res.Verified,
+		VerificationError: verificationErr,
+		Raw:               string(res.Raw),
+		RawV2:             string(res.RawV2),
+		Redacted:          res.Redacted,
+		ExtraData:         res.ExtraData,
+	})
+	r.mu.Unlock()
+
+	select {
+	case r.found <- struct{}{}:
+	default:
+	}
+}
+
+// wait waits for the results of the request, until none is found for
+// syncIdleTimeout or syncTimeout elapses. It returns false if cancel is
+// closed first.
+func (r *request) wait(cancel <-chan struct{}) bool {
+	idle := time.NewTimer(syncIdleTimeout)
+	defer idle.Stop()
+	timeout := time.NewTimer(syncTimeout)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-r.found:
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(syncIdleTimeout)
+		case <-idle.C:
+			return true
+		case <-timeout.C:
+			return true
+		case <-cancel:
+			return false
+		}
+	}
+}
+
+func (r *request) collected() []result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]result(nil), r.results...)
+}
+
+// ResultsDispatcher dispatches the results of the engine. It is satisfied by
+// engine.ResultsDispatcher.
+type ResultsDispatcher interface {
+	Dispatch(ctx context.Context, result detectors.ResultWithMetadata) error
+}
+
+// Dispatcher dispatches the results of the engine to another dispatcher,
+// and to the webhook requests waiting for them, by their request ID. The
+// engine must dispatch its results with it for a source to respond
+// synchronously.
+type Dispatcher struct {
+	next ResultsDispatcher
+
+	mu       sync.Mutex
+	requests map[string]*request
+}
+
+// NewDispatcher creates a Dispatcher forwarding the results to next.
+func NewDispatcher(next ResultsDispatcher) *Dispatcher {
+	return &Dispatcher{next: next, requests: make(map[string]*request)}
+}
+
+// Dispatch sends the result to the next dispatcher, and to the request it
+// was found for, if it is waiting for it.
+func (d *Dispatcher) Dispatch(ctx context.Context, result detectors.ResultWithMetadata) error {
+	if id := result.SourceMetadata.GetWebhook().GetRequestId(); id != "" {
+		d.mu.Lock()
+		req := d.requests[id]
+		d.mu.Unlock()
+		if req != nil {
+			req.collect(result)
+		}
+	}
+	return d.next.Dispatch(ctx, result)
+}
+
+func (d *Dispatcher) register(req *request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests[req.id] = req
+}
+
+func (d *Dispatcher) unregister(req *request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.requests, req.id)
+}
@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// startSource serves the source on a random port, with the dispatcher if
+// any, and returns its URL and the channel of its chunks.
+func startSource(t *testing.T, conn *sourcespb.Webhook, dispatcher *Dispatcher) (string, chan *sources.Chunk) {
+	t.Helper()
+
+	conn.ListenAddress = "127.0.0.1:0"
+	s := &Source{}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, s.Init(context.Background(), "test - webhook", 0, 0, false, anyConn, 1))
+	if dispatcher != nil {
+		s.SetDispatcher(dispatcher)
+	}
+
+	lis, err := net.Listen("tcp", conn.ListenAddress)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chunksChan := make(chan *sources.Chunk, 16)
+	done := make(chan error)
+	go func() { done <- s.serve(ctx, lis, chunksChan) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	return "http://" + lis.Addr().String(), chunksChan
+}
+
+func headerConn() *sourcespb.Webhook {
+	return &sourcespb.Webhook{
+		Credential: &sourcespb.Webhook_Header{Header: &credentialspb.Header{Key: "X-Secret", Value: "s3cr3t"}},
+	}
+}
+
+func post(t *testing.T, url, contentType string, body []byte, header http.Header) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header = header
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestAuthentication(t *testing.T) {
+	body := []byte("AWS_SECRET_ACCESS_KEY=from-webhook")
+	signature := "sha256=" + hex.EncodeToString(Sign([]byte("hmac-key"), body))
+
+	tests := []struct {
+		name       string
+		conn       *sourcespb.Webhook
+		header     http.Header
+		wantStatus int
+	}{
+		{
+			name:       "shared secret",
+			conn:       headerConn(),
+			header:     http.Header{"X-Secret": {"s3cr3t"}},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "wrong shared secret",
+			conn:       headerConn(),
+			header:     http.Header{"X-Secret": {"guess"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing shared secret",
+			conn:       headerConn(),
+			header:     http.Header{},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "HMAC signature",
+			conn:       &sourcespb.Webhook{Credential: &sourcespb.Webhook_HmacSecret{HmacSecret: "hmac-key"}},
+			header:     http.Header{SignatureHeader: {signature}},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "wrong HMAC signature",
+			conn:       &sourcespb.Webhook{Credential: &sourcespb.Webhook_HmacSecret{HmacSecret: "other-key"}},
+			header:     http.Header{SignatureHeader: {signature}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "truncated HMAC signature",
+			conn:       &sourcespb.Webhook{Credential: &sourcespb.Webhook_HmacSecret{HmacSecret: "hmac-key"}},
+			header:     http.Header{SignatureHeader: {signature[:20]}},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, chunksChan := startSource(t, tt.conn, nil)
+
+			resp := post(t, url, "text/plain", body, tt.header)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantStatus != http.StatusAccepted {
+				assert.Empty(t, chunksChan)
+				return
+			}
+
+			var got response
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+			assert.NotEmpty(t, got.RequestID)
+			require.Len(t, chunksChan, 1)
+			chunk := <-chunksChan
+			assert.Equal(t, string(body), string(chunk.Data))
+			assert.Equal(t, got.RequestID, chunk.SourceMetadata.GetWebhook().GetRequestId())
+		})
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	var form bytes.Buffer
+	mw := multipart.NewWriter(&form)
+	require.NoError(t, mw.WriteField("title", "token ghp_fromfield"))
+	fw, err := mw.CreateFormFile("attachment", "deploy.env")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("AWS_SECRET_ACCESS_KEY=from-file"))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		wantData    []string
+		wantFiles   []string
+	}{
+		{
+			name:        "raw body",
+			contentType: "text/plain",
+			body:        []byte("password=hunter2"),
+			wantData:    []string{"password=hunter2"},
+			wantFiles:   []string{""},
+		},
+		{
+			name:        "JSON",
+			contentType: "application/json; charset=utf-8",
+			body:        []byte(`{"paste": {"title": "keys", "lines": ["-----BEGIN KEY-----\nabc\n-----END KEY-----"]}, "id": 7}`),
+			wantData:    []string{"lines: -----BEGIN KEY-----\nabc\n-----END KEY-----\ntitle: keys\n"},
+			wantFiles:   []string{""},
+		},
+		{
+			name:        "multipart form",
+			contentType: mw.FormDataContentType(),
+			body:        form.Bytes(),
+			wantData:    []string{"token ghp_fromfield", "AWS_SECRET_ACCESS_KEY=from-file"},
+			wantFiles:   []string{"title", "deploy.env"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, chunksChan := startSource(t, headerConn(), nil)
+
+			resp := post(t, url, tt.contentType, tt.body, http.Header{"X-Secret": {"s3cr3t"}})
+			require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+			var data, files []string
+			for len(chunksChan) > 0 {
+				chunk := <-chunksChan
+				data = append(data, string(chunk.Data))
+				files = append(files, chunk.SourceMetadata.GetWebhook().GetFile())
+			}
+			assert.Equal(t, tt.wantData, data)
+			assert.Equal(t, tt.wantFiles, files)
+		})
+	}
+}
+
+func TestRequestLimits(t *testing.T) {
+	conn := headerConn()
+	conn.MaxBodySize = 8
+	url, _ := startSource(t, conn, nil)
+
+	resp := post(t, url, "text/plain", []byte("more than eight bytes"), http.Header{"X-Secret": {"s3cr3t"}})
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	// The body of an unauthenticated request is not read.
+	resp = post(t, url, "text/plain", []byte("more than eight bytes"), http.Header{"X-Secret": {"guess"}})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// Signed bodies are read before they are authenticated, so they are capped
+	// below the maximum body size.
+	url, _ = startSource(t, &sourcespb.Webhook{Credential: &sourcespb.Webhook_HmacSecret{HmacSecret: "hmac-key"}}, nil)
+	body := bytes.Repeat([]byte("a"), maxSignedBodySize+1)
+	signature := "sha256=" + hex.EncodeToString(Sign([]byte("hmac-key"), body))
+	resp = post(t, url, "text/plain", body, http.Header{SignatureHeader: {signature}})
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestSyncResponse(t *testing.T) {
+	conn := headerConn()
+	conn.SyncResponse = true
+	dispatcher := NewDispatcher(discardDispatcher{})
+	url, chunksChan := startSource(t, conn, dispatcher)
+
+	// Stand in for the engine, dispatching a result for every chunk.
+	go func() {
+		for chunk := range chunksChan {
+			res := detectors.CopyMetadata(chunk, detectors.Result{
+				DetectorType: detectorspb.DetectorType_AWS,
+				Raw:          []byte(strings.TrimPrefix(string(chunk.Data), "key=")),
+			})
+			assert.NoError(t, dispatcher.Dispatch(context.Background(), res))
+		}
+	}()
+
+	resp := post(t, url, "text/plain", []byte("key=AKIAEXAMPLE"), http.Header{"X-Secret": {"s3cr3t"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.NotEmpty(t, got.RequestID)
+	assert.Equal(t, []result{{DetectorName: "AWS", DecoderName: "UNKNOWN", Raw: "AKIAEXAMPLE"}}, got.Results)
+	assert.Empty(t, dispatcher.requests)
+}
+
+type discardDispatcher struct{}
+
+func (discardDispatcher) Dispatch(context.Context, detectors.ResultWithMetadata) error { return nil }